@@ -176,9 +176,12 @@ func createProxyHandler(ctx context.Context, config envConfig, logger *zap.Sugar
 		}
 	}
 
+	authVerifier := auth.NewVerifier(ctx, nil, nil, nil, configMapWatcher)
+	authVerifier.SetAuditSender(kncloudevents.NewDispatcher(eventingtls.ClientConfig{}, auth.NewOIDCTokenProvider(ctx)).AuditSender())
+
 	handler := &ProxyHandler{
 		kubeClient:   kubeclient.Get(ctx),
-		authVerifier: auth.NewVerifier(ctx, nil, nil, configMapWatcher),
+		authVerifier: authVerifier,
 		config:       config,
 		authSubjects: authSubjects,
 	}
@@ -340,5 +343,6 @@ func getServerTLSConfig(serverTLSCertificatePath, serverTLSCertificateKeyPath st
 
 		return &cert, nil
 	}
+	serverTLSConfig.RequestClientCertificates = true
 	return eventingtls.GetTLSServerConfig(serverTLSConfig)
 }
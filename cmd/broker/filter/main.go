@@ -21,6 +21,7 @@ import (
 	"log"
 	"time"
 
+	clustereventpolicyinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy"
 	eventpolicyinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
 	"knative.dev/eventing/pkg/observability/otel"
 	"knative.dev/eventing/pkg/requestreply"
@@ -152,7 +153,7 @@ func main() {
 	oidcTokenProvider := auth.NewOIDCTokenProvider(ctx)
 	// We are running both the receiver (takes messages in from the Broker) and the dispatcher (send
 	// the messages to the triggers' subscribers) in this binary.
-	authVerifier := auth.NewVerifier(ctx, eventpolicyinformer.Get(ctx).Lister(), trustBundleConfigMapLister, configMapWatcher)
+	authVerifier := auth.NewVerifier(ctx, eventpolicyinformer.Get(ctx).Lister(), clustereventpolicyinformer.Get(ctx).Lister(), trustBundleConfigMapLister, configMapWatcher)
 	handler, err = filter.NewHandler(
 		logger,
 		authVerifier,
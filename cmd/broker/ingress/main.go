@@ -45,6 +45,7 @@ import (
 	"knative.dev/eventing/pkg/broker/ingress"
 	eventingclient "knative.dev/eventing/pkg/client/injection/client"
 	brokerinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1/broker"
+	clustereventpolicyinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy"
 	eventpolicyinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
 	eventtypeinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1beta3/eventtype"
 	"knative.dev/eventing/pkg/eventingtls"
@@ -75,6 +76,12 @@ type envConfig struct {
 	MaxTTL        int32  `envconfig:"MAX_TTL" default:"255"`
 	HTTPPort      int    `envconfig:"INGRESS_PORT" default:"8080"`
 	HTTPSPort     int    `envconfig:"INGRESS_PORT_HTTPS" default:"8443"`
+	// MQTTPort is the port of the MQTT listener, disabled when 0.
+	MQTTPort int `envconfig:"INGRESS_PORT_MQTT" default:"0"`
+	// MQTTMaxPacketSize is the size in bytes of the largest packet accepted by the MQTT listener.
+	MQTTMaxPacketSize uint32 `envconfig:"INGRESS_MQTT_MAX_PACKET_SIZE" default:"1048576"`
+	// MQTTMaxConnections is the number of clients served by the MQTT listener at a time.
+	MQTTMaxConnections int `envconfig:"INGRESS_MQTT_MAX_CONNECTIONS" default:"1000"`
 }
 
 func main() {
@@ -166,7 +173,7 @@ func main() {
 	}
 
 	oidcTokenProvider := auth.NewOIDCTokenProvider(ctx)
-	authVerifier := auth.NewVerifier(ctx, eventpolicyinformer.Get(ctx).Lister(), trustBundleConfigMapLister, configMapWatcher)
+	authVerifier := auth.NewVerifier(ctx, eventpolicyinformer.Get(ctx).Lister(), clustereventpolicyinformer.Get(ctx).Lister(), trustBundleConfigMapLister, configMapWatcher)
 	handler, err = ingress.NewHandler(
 		logger,
 		broker.TTLDefaulter(logger, env.MaxTTL),
@@ -218,6 +225,16 @@ func main() {
 		logger.Fatal("Failed to start informers", zap.Error(err))
 	}
 
+	if env.MQTTPort > 0 {
+		mqttServer := ingress.NewMQTTServer(ctx, handler, env.MQTTPort, env.MQTTMaxPacketSize, env.MQTTMaxConnections)
+		go func() {
+			logger.Info("Starting the MQTT listener", zap.Int("port", env.MQTTPort))
+			if err := mqttServer.Start(ctx); err != nil {
+				logger.Fatal("MQTT listener returned an error", zap.Error(err))
+			}
+		}()
+	}
+
 	// Start the servers
 	logger.Info("Ingress starting...")
 	err = serverManager.StartServers(ctx)
@@ -31,6 +31,7 @@ import (
 	"knative.dev/eventing/pkg/apis/sinks"
 	"knative.dev/eventing/pkg/auth"
 	"knative.dev/eventing/pkg/eventingtls"
+	"knative.dev/eventing/pkg/reconciler/clustereventpolicy"
 	"knative.dev/eventing/pkg/reconciler/eventpolicy"
 	"knative.dev/eventing/pkg/reconciler/eventtransform"
 	"knative.dev/eventing/pkg/reconciler/jobsink"
@@ -42,13 +43,17 @@ import (
 	"knative.dev/eventing/pkg/reconciler/eventtype"
 	integrationsink "knative.dev/eventing/pkg/reconciler/integration/sink"
 	integrationsource "knative.dev/eventing/pkg/reconciler/integration/source"
+	"knative.dev/eventing/pkg/reconciler/mqttsource"
 	"knative.dev/eventing/pkg/reconciler/parallel"
 	"knative.dev/eventing/pkg/reconciler/pingsource"
+	"knative.dev/eventing/pkg/reconciler/pullsink"
 	"knative.dev/eventing/pkg/reconciler/sequence"
 	sourcecrd "knative.dev/eventing/pkg/reconciler/source/crd"
+	"knative.dev/eventing/pkg/reconciler/streamsink"
 	"knative.dev/eventing/pkg/reconciler/subscription"
 	sugarnamespace "knative.dev/eventing/pkg/reconciler/sugar/namespace"
 	sugartrigger "knative.dev/eventing/pkg/reconciler/sugar/trigger"
+	"knative.dev/eventing/pkg/reconciler/websocketsource"
 )
 
 func main() {
@@ -58,6 +63,7 @@ func main() {
 		auth.OIDCLabelSelector,
 		eventingtls.TrustBundleLabelSelector,
 		sinks.JobSinkJobsLabelSelector,
+		sinks.JobSinkQueuedLabelSelector,
 		eventtransform.JsonataResourcesSelector,
 		certificates.SecretLabelSelectorPair,
 		requestreply.SecretLabelSelector,
@@ -75,6 +81,7 @@ func main() {
 		// Eventing
 		eventtype.NewController,
 		eventpolicy.NewController,
+		clustereventpolicy.NewController,
 
 		// Flows
 		parallel.NewController,
@@ -85,12 +92,16 @@ func main() {
 		pingsource.NewController,
 		containersource.NewController,
 		integrationsource.NewController,
+		mqttsource.NewController,
+		websocketsource.NewController,
 
 		// Sources CRD
 		sourcecrd.NewController,
 
 		// Sinks
 		jobsink.NewController,
+		streamsink.NewController,
+		pullsink.NewController,
 		integrationsink.NewController,
 
 		// Sugar
@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"knative.dev/eventing/pkg/auth"
+)
+
+// eventpolicy_simulator asks the event policy simulator endpoint of the eventing controller
+// whether an event sent by a subject to a target resource would be allowed, e.g. after
+// kubectl -n knative-eventing port-forward deployment/eventing-controller 8081:8081
+// The endpoint is disabled by default, it is enabled by setting EVENT_POLICY_SIMULATOR_PORT
+// on the eventing controller and only listens on localhost of the controller pod.
+func main() {
+	var (
+		endpoint   = flag.String("endpoint", "http://localhost:8081", "Address of the event policy simulator endpoint of the eventing controller")
+		apiVersion = flag.String("api-version", "eventing.knative.dev/v1", "API version of the target resource")
+		kind       = flag.String("kind", "Broker", "Kind of the target resource")
+		name       = flag.String("name", "", "Name of the target resource")
+		namespace  = flag.String("namespace", "default", "Namespace of the target resource")
+		sub        = flag.String("sub", "", "OIDC identity sending the event, e.g. system:serviceaccount:my-ns:my-sa")
+		eventFile  = flag.String("event", "", "File holding the sample event in structured JSON format, or - to read it from stdin")
+	)
+	flag.Parse()
+
+	if *name == "" || *sub == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	request := &auth.SimulationRequest{
+		Target: auth.SimulationTarget{
+			APIVersion: *apiVersion,
+			Kind:       *kind,
+			Name:       *name,
+			Namespace:  *namespace,
+		},
+		Sub: *sub,
+	}
+
+	if *eventFile != "" {
+		event, err := readEvent(*eventFile)
+		if err != nil {
+			log.Fatal("Failed to read event: ", err)
+		}
+		request.Event = event
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		log.Fatal("Failed to marshal simulation request: ", err)
+	}
+
+	resp, err := http.Post(strings.TrimSuffix(*endpoint, "/")+auth.SimulationPath, "application/json", bytes.NewReader(body))
+	if err != nil {
+		log.Fatal("Failed to send simulation request: ", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Fatal("Failed to read simulation result: ", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("Simulation failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	result := &auth.SimulationResult{}
+	if err := json.Unmarshal(respBody, result); err != nil {
+		log.Fatal("Failed to decode simulation result: ", err)
+	}
+
+	printResult(result)
+	if !result.Allowed {
+		os.Exit(1)
+	}
+}
+
+func readEvent(path string) (*cloudevents.Event, error) {
+	var (
+		b   []byte
+		err error
+	)
+	if path == "-" {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	event := cloudevents.NewEvent()
+	if err := json.Unmarshal(b, &event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func printResult(result *auth.SimulationResult) {
+	if result.Allowed {
+		fmt.Println("ALLOWED:", result.Reason)
+	} else {
+		fmt.Println("DENIED:", result.Reason)
+	}
+
+	if len(result.Policies) > 0 {
+		fmt.Println("\nApplying event policies:")
+		for _, p := range result.Policies {
+			name := p.Name
+			if p.Kind != "" {
+				name = p.Kind + "/" + p.Name
+			}
+			if p.Applied {
+				fmt.Printf("  %s\n", name)
+			} else {
+				fmt.Printf("  %s (not ready, not enforced)\n", name)
+			}
+		}
+	}
+
+	if len(result.Rules) > 0 {
+		fmt.Println("\nEvaluated rules:")
+		for _, r := range result.Rules {
+			ruleType := "allow"
+			if r.Deny {
+				ruleType = "deny"
+			}
+			fmt.Printf("  %s %s: subject matched=%t, matched=%t\n", r.Policy, ruleType, r.SubjectMatched, r.Matched())
+			for _, f := range r.FailedFilters {
+				b, _ := json.Marshal(f)
+				fmt.Printf("    failed filter: %s\n", b)
+			}
+			if r.SignatureError != "" {
+				fmt.Printf("    invalid signature: %s\n", r.SignatureError)
+			}
+		}
+	}
+}
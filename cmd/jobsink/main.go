@@ -21,6 +21,7 @@ import (
 	"crypto/md5" //nolint:gosec
 	"crypto/tls"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -32,6 +33,7 @@ import (
 	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered"
 	filteredFactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/cloudevents/sdk-go/v2/binding"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
 	"go.uber.org/zap"
@@ -49,7 +51,6 @@ import (
 	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/logging"
 	k8sruntime "knative.dev/pkg/observability/runtime/k8s"
-	"knative.dev/pkg/ptr"
 	"knative.dev/pkg/system"
 
 	"knative.dev/pkg/signals"
@@ -57,8 +58,8 @@ import (
 	cmdbroker "knative.dev/eventing/cmd/broker"
 	"knative.dev/eventing/pkg/apis/feature"
 	"knative.dev/eventing/pkg/apis/sinks"
-	sinksv "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
 	"knative.dev/eventing/pkg/auth"
+	clustereventpolicyinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy"
 	eventpolicyinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
 	"knative.dev/eventing/pkg/client/injection/informers/sinks/v1alpha1/jobsink"
 	sinkslister "knative.dev/eventing/pkg/client/listers/sinks/v1alpha1"
@@ -67,6 +68,7 @@ import (
 	"knative.dev/eventing/pkg/observability"
 	o11yconfigmap "knative.dev/eventing/pkg/observability/configmap"
 	"knative.dev/eventing/pkg/observability/otel"
+	"knative.dev/eventing/pkg/reconciler/jobsink/resources"
 	eventingtracing "knative.dev/eventing/pkg/tracing"
 	"knative.dev/eventing/pkg/utils"
 )
@@ -138,11 +140,21 @@ func main() {
 		return logging.WithLogger(featureStore.ToContext(ctx), sl)
 	}
 
+	authVerifier := auth.NewVerifier(ctx, eventpolicyinformer.Get(ctx).Lister(), clustereventpolicyinformer.Get(ctx).Lister(), trustBundleConfigMapLister, configMapWatcher)
+	authVerifier.SetAuditSender(kncloudevents.NewDispatcher(
+		eventingtls.ClientConfig{
+			TrustBundleConfigMapLister: trustBundleConfigMapLister,
+		},
+		auth.NewOIDCTokenProvider(ctx),
+		kncloudevents.WithMeterProvider(mp),
+		kncloudevents.WithTraceProvider(tp),
+	).AuditSender())
+
 	h = &Handler{
 		k8s:          kubeclient.Get(ctx),
 		lister:       jobsink.Get(ctx).Lister(),
 		withContext:  ctxFunc,
-		authVerifier: auth.NewVerifier(ctx, eventpolicyinformer.Get(ctx).Lister(), trustBundleConfigMapLister, configMapWatcher),
+		authVerifier: authVerifier,
 	}
 
 	meter := mp.Meter(ScopeName)
@@ -303,60 +315,43 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	js = js.DeepCopy() // Do not modify informer copy.
 	js.SetDefaults(ctx)
 
-	job := js.Spec.Job.DeepCopy()
-	job.Name = jobName
-	if job.Labels == nil {
-		job.Labels = make(map[string]string, 4)
-	}
-	job.Labels[sinks.JobSinkIDLabel] = jobName
-	job.Labels[sinks.JobSinkNameLabel] = ref.Name
-	job.OwnerReferences = append(job.OwnerReferences, metav1.OwnerReference{
-		APIVersion:         sinksv.SchemeGroupVersion.String(),
-		Kind:               sinks.JobSinkResource.Resource,
-		Name:               js.GetName(),
-		UID:                js.GetUID(),
-		Controller:         ptr.Bool(true),
-		BlockOwnerDeletion: ptr.Bool(false),
-	})
-	var mountPathName string
-	for i := range job.Spec.Template.Spec.Containers {
-		found := false
-		for j := range job.Spec.Template.Spec.Containers[i].VolumeMounts {
-			if job.Spec.Template.Spec.Containers[i].VolumeMounts[j].Name == "jobsink-event" {
-				found = true
-				mountPathName = job.Spec.Template.Spec.Containers[i].VolumeMounts[j].MountPath
-				break
-			}
-		}
-		if !found {
-			job.Spec.Template.Spec.Containers[i].VolumeMounts = append(job.Spec.Template.Spec.Containers[i].VolumeMounts, corev1.VolumeMount{
-				Name:      "jobsink-event",
-				ReadOnly:  true,
-				MountPath: "/etc/jobsink-event",
-			})
-			mountPathName = "/etc/jobsink-event"
-		}
-		job.Spec.Template.Spec.Containers[i].Env = append(job.Spec.Template.Spec.Containers[i].Env, corev1.EnvVar{
-			Name:  "K_EVENT_PATH",
-			Value: mountPathName,
-		})
+	if js.Spec.Batching != nil && resources.BatchEventSize(eventBytes) > resources.MaxBatchSize {
+		logger.Info("Event too large for a batch", zap.Int("size", len(eventBytes)))
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
 	}
 
-	found := false
-	for i := range job.Spec.Template.Spec.Volumes {
-		if job.Spec.Template.Spec.Volumes[i].Name == "jobsink-event" {
-			found = true
-			break
+	eventRef := resources.EventRef{ID: event.ID(), Source: event.Source()}
+
+	if js.Spec.MaxConcurrentJobs != nil || js.Spec.Batching != nil {
+		// The controller creates the Job once the JobSink runs fewer Jobs
+		// than its limit, or once the batch of the event is complete.
+		secret := resources.MakeEventSecret(js, jobName, eventRef, eventBytes, nil)
+
+		logger.Debug("Queueing event",
+			zap.String("URI", r.RequestURI),
+			zap.String("jobName", jobName),
+			zap.Any("secret.metadata", secret.ObjectMeta),
+		)
+
+		_, err = h.k8s.CoreV1().Secrets(ref.Namespace).Create(r.Context(), secret, metav1.CreateOptions{})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			logger.Warn("Failed to create secret", zap.Error(err))
+
+			w.Header().Add("Reason", err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
 		}
+		if apierrors.IsAlreadyExists(err) {
+			logger.Debug("Secret already exists", zap.String("URI", r.RequestURI), zap.String("jobName", jobName))
+		}
+
+		w.Header().Add("Location", locationHeader(ref, event.Source(), event.ID()))
+		w.WriteHeader(http.StatusAccepted)
+		return
 	}
-	if !found {
-		job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, corev1.Volume{
-			Name: "jobsink-event",
-			VolumeSource: corev1.VolumeSource{
-				Secret: &corev1.SecretVolumeSource{SecretName: jobName},
-			},
-		})
-	}
+
+	job := resources.MakeJob(js, jobName, eventRef)
 
 	logger.Debug("Creating job for event",
 		zap.String("URI", r.RequestURI),
@@ -376,30 +371,7 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		logger.Debug("Job already exists", zap.String("URI", r.RequestURI), zap.String("jobName", jobName))
 	}
 
-	secret := &corev1.Secret{
-		TypeMeta: metav1.TypeMeta{},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      jobName,
-			Namespace: ref.Namespace,
-			Labels: map[string]string{
-				sinks.JobSinkIDLabel:   jobName,
-				sinks.JobSinkNameLabel: ref.Name,
-			},
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion:         "batch/v1",
-					Kind:               "Job",
-					Name:               createdJob.Name,
-					UID:                createdJob.UID,
-					Controller:         ptr.Bool(true),
-					BlockOwnerDeletion: ptr.Bool(false),
-				},
-			},
-		},
-		Immutable: ptr.Bool(true),
-		Data:      map[string][]byte{"event": eventBytes},
-		Type:      corev1.SecretTypeOpaque,
-	}
+	secret := resources.MakeEventSecret(js, jobName, eventRef, eventBytes, createdJob)
 
 	logger.Debug("Creating secret for event",
 		zap.String("URI", r.RequestURI),
@@ -446,7 +418,7 @@ func (h *Handler) handleGet(ctx context.Context, w http.ResponseWriter, r *http.
 
 	logger.Debug("Handling GET request", zap.String("URI", r.RequestURI))
 
-	err = h.authVerifier.VerifyRequest(ctx, feature.FromContext(ctx), js.Status.Address.Audience, js.Namespace, js.Status.Policies, r, w)
+	err = h.authVerifier.VerifyReadRequest(ctx, feature.FromContext(ctx), js.Status.Address.Audience, js.Namespace, js.Status.Policies, r, w)
 	if err != nil {
 		logger.Warn("Failed to verify AuthN and AuthZ.", zap.Error(err))
 		return
@@ -457,7 +429,13 @@ func (h *Handler) handleGet(ctx context.Context, w http.ResponseWriter, r *http.
 
 	jobName := toJobName(ref.Name, eventSource, eventID)
 
-	job, err := h.k8s.BatchV1().Jobs(ref.Namespace).Get(r.Context(), jobName, metav1.GetOptions{})
+	job, err := h.getJob(r.Context(), ref.Namespace, jobName)
+	if errors.Is(err, errQueued) {
+		w.Header().Add("Reason", "Queued")
+		w.Header().Add("Location", locationHeader(ref, eventSource, eventID))
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		return
@@ -467,14 +445,14 @@ func (h *Handler) handleGet(ctx context.Context, w http.ResponseWriter, r *http.
 		if c.Type == batchv1.JobFailed {
 			if c.Status == corev1.ConditionTrue {
 				w.Header().Add("Reason", "Failed")
-				w.WriteHeader(http.StatusBadRequest)
+				writeCompletionEvent(ctx, w, job, http.StatusBadRequest)
 				return
 			}
 		}
 		if c.Type == batchv1.JobComplete {
 			if c.Status == corev1.ConditionTrue {
 				w.Header().Add("Reason", "Complete")
-				w.WriteHeader(http.StatusOK)
+				writeCompletionEvent(ctx, w, job, http.StatusOK)
 				return
 			}
 		}
@@ -484,6 +462,53 @@ func (h *Handler) handleGet(ctx context.Context, w http.ResponseWriter, r *http.
 	w.WriteHeader(http.StatusAccepted)
 }
 
+// errQueued is returned by getJob when the Job of the event is not created
+// yet.
+var errQueued = errors.New("event queued")
+
+// getJob returns the Job named jobName, or, when the event of the Job was
+// collected into a batch, the batch Job.
+func (h *Handler) getJob(ctx context.Context, namespace, jobName string) (*batchv1.Job, error) {
+	job, err := h.k8s.BatchV1().Jobs(namespace).Get(ctx, jobName, metav1.GetOptions{})
+	if !apierrors.IsNotFound(err) {
+		return job, err
+	}
+
+	secret, secretErr := h.k8s.CoreV1().Secrets(namespace).Get(ctx, jobName, metav1.GetOptions{})
+	if secretErr != nil {
+		return nil, err
+	}
+	if secret.Labels[sinks.JobSinkQueuedLabel] == "true" {
+		return nil, errQueued
+	}
+	batch, ok := secret.Labels[sinks.JobSinkBatchLabel]
+	if !ok {
+		return nil, err
+	}
+	return h.k8s.BatchV1().Jobs(namespace).Get(ctx, batch, metav1.GetOptions{})
+}
+
+// writeCompletionEvent replies with the completion event of the finished job,
+// when the JobSink emits one and it has been sent, or with an empty body.
+func writeCompletionEvent(ctx context.Context, w http.ResponseWriter, job *batchv1.Job, status int) {
+	data, ok := job.Annotations[sinks.JobSinkCompletionEventAnnotation]
+	if !ok {
+		w.WriteHeader(status)
+		return
+	}
+
+	event := cloudevents.NewEvent()
+	if err := event.UnmarshalJSON([]byte(data)); err != nil {
+		logging.FromContext(ctx).Warnw("Failed to unmarshal completion event", zap.String("job", job.Name), zap.Error(err))
+		w.WriteHeader(status)
+		return
+	}
+
+	if err := cehttp.WriteResponseWriter(ctx, binding.ToMessage(&event), status, w); err != nil {
+		logging.FromContext(ctx).Warnw("Failed to write completion event", zap.String("job", job.Name), zap.Error(err))
+	}
+}
+
 func flush(logger *zap.SugaredLogger) {
 	_ = logger.Sync()
 }
@@ -496,6 +521,7 @@ func getServerTLSConfig(ctx context.Context) (*tls.Config, error) {
 
 	serverTLSConfig := eventingtls.NewDefaultServerConfig()
 	serverTLSConfig.GetCertificate = eventingtls.GetCertificateFromSecret(ctx, secretinformer.Get(ctx), kubeclient.Get(ctx), secret)
+	serverTLSConfig.RequestClientCertificates = true
 	return eventingtls.GetTLSServerConfig(serverTLSConfig)
 }
 
@@ -17,10 +17,50 @@ limitations under the License.
 package main
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	filteredconfigmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered/fake"
+	filteredFactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
+	_ "knative.dev/pkg/client/injection/kube/informers/factory/filtered/fake"
+	"knative.dev/pkg/configmap"
+	reconcilertesting "knative.dev/pkg/reconciler/testing"
+	"knative.dev/pkg/system"
+	_ "knative.dev/pkg/system/testing"
 
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	eventingv1alpha1 "knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/eventing/pkg/apis/sinks"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/auth"
+	clustereventpolicyinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy/fake"
+	eventpolicyinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy/fake"
+	jobsinkinformerfake "knative.dev/eventing/pkg/client/injection/informers/sinks/v1alpha1/jobsink/fake"
+	"knative.dev/eventing/pkg/eventingtls"
+	"knative.dev/eventing/pkg/reconciler/jobsink/resources"
 	"knative.dev/eventing/pkg/utils"
 )
 
@@ -104,3 +144,200 @@ func FuzzToJobName(f *testing.F) {
 		}
 	})
 }
+
+func TestGetJob(t *testing.T) {
+	js := &sinksv1alpha1.JobSink{
+		ObjectMeta: metav1.ObjectMeta{Name: "js", Namespace: "ns"},
+		Spec:       sinksv1alpha1.JobSinkSpec{Job: &batchv1.Job{}},
+	}
+	ref := resources.EventRef{ID: "id", Source: "source"}
+
+	queued := resources.MakeEventSecret(js, "queued", ref, []byte("{}"), nil)
+
+	batch := resources.MakeBatchJob(js, "batch", 1)
+	batched := resources.MakeEventSecret(js, "batched", ref, []byte("{}"), batch)
+	batched.Labels[sinks.JobSinkBatchLabel] = batch.Name
+
+	single := resources.MakeJob(js, "single", ref)
+
+	h := &Handler{k8s: fake.NewSimpleClientset(queued, batch, batched, single)}
+
+	tests := []struct {
+		name    string
+		jobName string
+		want    string
+		wantErr error
+	}{{
+		name:    "job of the event",
+		jobName: "single",
+		want:    "single",
+	}, {
+		name:    "batch job of the event",
+		jobName: "batched",
+		want:    "batch",
+	}, {
+		name:    "queued event",
+		jobName: "queued",
+		wantErr: errQueued,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			job, err := h.getJob(context.Background(), "ns", tc.jobName)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("getJob() error = %v, want %v", err, tc.wantErr)
+			}
+			if tc.wantErr == nil && job.Name != tc.want {
+				t.Errorf("getJob() = %q, want %q", job.Name, tc.want)
+			}
+		})
+	}
+
+	if _, err := h.getJob(context.Background(), "ns", "unknown"); !apierrors.IsNotFound(err) {
+		t.Errorf("getJob() error = %v, want not found", err)
+	}
+}
+
+func TestHandleGetIsNotRateLimited(t *testing.T) {
+	ctx, _ := reconcilertesting.SetupFakeContext(t, func(ctx context.Context) context.Context {
+		return filteredFactory.WithSelectors(ctx, eventingtls.TrustBundleLabelSelector)
+	})
+	ctx = feature.ToContext(ctx, feature.Flags{
+		feature.OIDCAuthentication:  feature.Enabled,
+		feature.TransportEncryption: feature.Strict,
+	})
+
+	// The client authenticates with the SPIFFE ID of its certificate.
+	ca, caKey := newTestCertificate(t, nil, nil, "")
+	clientCert, _ := newTestCertificate(t, ca, caKey, "spiffe://cluster.local/ns/ns/sa/client")
+	trustBundle := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "trust-bundle",
+			Namespace: system.Namespace(),
+			Labels:    map[string]string{eventingtls.TrustBundleLabelKey: eventingtls.TrustBundleLabelValue},
+		},
+		Data: map[string]string{
+			"ca.crt": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw})),
+		},
+	}
+	trustBundleInformer := filteredconfigmapinformer.Get(ctx, eventingtls.TrustBundleLabelSelector)
+	if err := trustBundleInformer.Informer().GetIndexer().Add(trustBundle); err != nil {
+		t.Fatal(err)
+	}
+
+	policy := &eventingv1alpha1.EventPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "ns"},
+		Spec: eventingv1alpha1.EventPolicySpec{
+			From:      []eventingv1alpha1.EventPolicySpecFrom{{SpiffeID: ptr.To("spiffe://cluster.local/ns/ns/sa/client")}},
+			RateLimit: &eventingv1alpha1.EventPolicySpecRateLimit{EventsPerSecond: 1},
+		},
+		Status: eventingv1alpha1.EventPolicyStatus{
+			From: []string{"spiffe://cluster.local/ns/ns/sa/client"},
+		},
+	}
+	if err := eventpolicyinformerfake.Get(ctx).Informer().GetIndexer().Add(policy); err != nil {
+		t.Fatal(err)
+	}
+
+	js := &sinksv1alpha1.JobSink{
+		ObjectMeta: metav1.ObjectMeta{Name: "js", Namespace: "ns"},
+		Spec:       sinksv1alpha1.JobSinkSpec{Job: &batchv1.Job{}},
+		Status: sinksv1alpha1.JobSinkStatus{
+			AppliedEventPoliciesStatus: eventingduckv1.AppliedEventPoliciesStatus{
+				Policies: []eventingduckv1.AppliedEventPolicyRef{{APIVersion: eventingv1alpha1.SchemeGroupVersion.String(), Name: policy.Name}},
+			},
+		},
+	}
+	js.Status.Address = &duckv1.Addressable{Audience: ptr.To("js-audience")}
+	if err := jobsinkinformerfake.Get(ctx).Informer().GetIndexer().Add(js); err != nil {
+		t.Fatal(err)
+	}
+
+	ref := types.NamespacedName{Namespace: js.Namespace, Name: js.Name}
+	job := resources.MakeJob(js, toJobName(js.Name, "source", "id"), resources.EventRef{ID: "id", Source: "source"})
+
+	h := &Handler{
+		k8s:    fake.NewSimpleClientset(job),
+		lister: jobsinkinformerfake.Get(ctx).Lister(),
+		authVerifier: auth.NewVerifier(ctx, eventpolicyinformerfake.Get(ctx).Lister(), clustereventpolicyinformerfake.Get(ctx).Lister(), trustBundleInformer.Lister().ConfigMaps(system.Namespace()), configmap.NewStaticWatcher(
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "config-features",
+					Namespace: system.Namespace(),
+				},
+			},
+		)),
+	}
+
+	// Polling the status of the Job more often than the rate limit of the
+	// event policy allows events doesn't use up the events of the client.
+	for i := 0; i < 3; i++ {
+		r := httptest.NewRequest(http.MethodGet, locationHeader(ref, "source", "id"), nil)
+		r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{clientCert}}
+		w := httptest.NewRecorder()
+
+		h.handleGet(ctx, w, r)
+
+		if w.Code != http.StatusAccepted {
+			t.Fatalf("handleGet() #%d status = %d, want %d", i, w.Code, http.StatusAccepted)
+		}
+	}
+
+	// The events sent afterwards are still limited.
+	for i, want := range []int{http.StatusOK, http.StatusTooManyRequests} {
+		r := httptest.NewRequest(http.MethodPost, "/ns/js", nil)
+		r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{clientCert}}
+		r.Header.Set("Ce-Specversion", "1.0")
+		r.Header.Set("Ce-Id", "id")
+		r.Header.Set("Ce-Source", "source")
+		r.Header.Set("Ce-Type", "type")
+		w := httptest.NewRecorder()
+
+		err := h.authVerifier.VerifyRequest(ctx, feature.FromContext(ctx), js.Status.Address.Audience, js.Namespace, js.Status.Policies, r, w)
+		if (err == nil) != (want == http.StatusOK) || (err != nil && w.Code != want) {
+			t.Errorf("VerifyRequest() #%d = %d, %v, want %d", i, w.Code, err, want)
+		}
+	}
+}
+
+// newTestCertificate creates a CA certificate when parent is nil, otherwise a client
+// certificate signed by parent with the given SPIFFE ID as URI SAN.
+func newTestCertificate(t *testing.T, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, spiffeID string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	} else {
+		template.KeyUsage = x509.KeyUsageDigitalSignature
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+		uri, err := url.Parse(spiffeID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		template.URIs = []*url.URL{uri}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"log"
+	"time"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/types"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered"
+	filteredFactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
+	configmap "knative.dev/pkg/configmap/informer"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	secretinformer "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret"
+	"knative.dev/pkg/logging"
+	k8sruntime "knative.dev/pkg/observability/runtime/k8s"
+	"knative.dev/pkg/signals"
+	"knative.dev/pkg/system"
+
+	cmdbroker "knative.dev/eventing/cmd/broker"
+	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/eventing/pkg/auth"
+	clustereventpolicyinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy"
+	eventpolicyinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
+	pullsinkinformer "knative.dev/eventing/pkg/client/injection/informers/sinks/v1alpha1/pullsink"
+	"knative.dev/eventing/pkg/eventingtls"
+	"knative.dev/eventing/pkg/kncloudevents"
+	o11yconfigmap "knative.dev/eventing/pkg/observability/configmap"
+	"knative.dev/eventing/pkg/observability/otel"
+	"knative.dev/eventing/pkg/pullsink"
+)
+
+const (
+	component = "pull_sink"
+)
+
+func main() {
+	ctx := signals.NewContext()
+
+	cfg := injection.ParseAndGetRESTConfigOrDie()
+	ctx = injection.WithConfig(ctx, cfg)
+	ctx = filteredFactory.WithSelectors(ctx,
+		eventingtls.TrustBundleLabelSelector,
+	)
+
+	ctx, informers := injection.Default.SetupInformers(ctx, cfg)
+	ctx = injection.WithConfig(ctx, cfg)
+
+	loggingConfig, err := cmdbroker.GetLoggingConfig(ctx, system.Namespace(), logging.ConfigMapName())
+	if err != nil {
+		log.Fatal("Error loading/parsing logging configuration:", err)
+	}
+	sl, atomicLevel := logging.NewLoggerFromConfig(loggingConfig, component)
+	logger := sl.Desugar()
+	defer flush(sl)
+
+	pprof := k8sruntime.NewProfilingServer(sl.Named("pprof"))
+
+	mp, tp := otel.SetupObservabilityOrDie(ctx, "pullsink", sl, pprof)
+
+	defer func() {
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+
+		if err := mp.Shutdown(ctx); err != nil {
+			sl.Errorw("Error flushing metrics", zap.Error(err))
+		}
+
+		if err := tp.Shutdown(ctx); err != nil {
+			sl.Errorw("Error flushing traces", zap.Error(err))
+		}
+	}()
+
+	// Watch the logging config map and dynamically update logging levels.
+	configMapWatcher := configmap.NewInformedWatcher(kubeclient.Get(ctx), system.Namespace())
+	// Watch the observability config map and dynamically update metrics exporter.
+	configMapWatcher.Watch(o11yconfigmap.Name(), pprof.UpdateFromConfigMap)
+	// Watch the observability config map and dynamically update request logs.
+	configMapWatcher.Watch(logging.ConfigMapName(), logging.UpdateLevelFromConfigMap(sl, atomicLevel, component))
+
+	logger.Info("Starting the PullSink Ingress")
+
+	trustBundleConfigMapLister := configmapinformer.Get(ctx, eventingtls.TrustBundleLabelSelector).Lister().ConfigMaps(system.Namespace())
+
+	featureStore := feature.NewStore(logging.FromContext(ctx).Named("feature-config-store"))
+	featureStore.WatchConfigs(configMapWatcher)
+
+	// Decorate contexts with the current state of the feature config.
+	ctxFunc := func(ctx context.Context) context.Context {
+		return logging.WithLogger(featureStore.ToContext(ctx), sl)
+	}
+
+	dispatcher := kncloudevents.NewDispatcher(
+		eventingtls.ClientConfig{
+			TrustBundleConfigMapLister: trustBundleConfigMapLister,
+		},
+		auth.NewOIDCTokenProvider(ctx),
+		kncloudevents.WithMeterProvider(mp),
+		kncloudevents.WithTraceProvider(tp),
+	)
+
+	authVerifier := auth.NewVerifier(ctx, eventpolicyinformer.Get(ctx).Lister(), clustereventpolicyinformer.Get(ctx).Lister(), trustBundleConfigMapLister, configMapWatcher)
+	authVerifier.SetAuditSender(dispatcher.AuditSender())
+
+	h := pullsink.NewHandler(
+		pullsinkinformer.Get(ctx).Lister(),
+		authVerifier,
+		dispatcher,
+		ctxFunc,
+	)
+
+	handler := otel.NewHandler(h, "receive", mp, tp)
+
+	tlsConfig, err := getServerTLSConfig(ctx)
+	if err != nil {
+		log.Fatal("Failed to get TLS config", err)
+	}
+
+	sm, err := eventingtls.NewServerManager(ctx,
+		kncloudevents.NewHTTPEventReceiver(8080),
+		kncloudevents.NewHTTPEventReceiver(8443,
+			kncloudevents.WithTLSConfig(tlsConfig)),
+		handler,
+		configMapWatcher,
+	)
+	if err != nil {
+		logger.Fatal("failed to start eventingtls server", zap.Error(err))
+	}
+
+	// configMapWatcher does not block, so start it first.
+	logger.Info("Starting ConfigMap watcher")
+	if err = configMapWatcher.Start(ctx.Done()); err != nil {
+		logger.Fatal("Failed to start ConfigMap watcher", zap.Error(err))
+	}
+
+	// Start informers and wait for them to sync.
+	logger.Info("Starting informers.")
+	if err := controller.StartInformers(ctx.Done(), informers...); err != nil {
+		logger.Fatal("Failed to start informers", zap.Error(err))
+	}
+
+	// Expire the leases in the background.
+	go h.Start(ctx)
+
+	// Start the servers
+	logger.Info("Starting...")
+	if err = sm.StartServers(ctx); err != nil {
+		logger.Fatal("StartServers() returned an error", zap.Error(err))
+	}
+	logger.Info("Exiting...")
+}
+
+func flush(logger *zap.SugaredLogger) {
+	_ = logger.Sync()
+}
+
+func getServerTLSConfig(ctx context.Context) (*tls.Config, error) {
+	secret := types.NamespacedName{
+		Namespace: system.Namespace(),
+		Name:      eventingtls.PullSinkDispatcherServerTLSSecretName,
+	}
+
+	serverTLSConfig := eventingtls.NewDefaultServerConfig()
+	serverTLSConfig.GetCertificate = eventingtls.GetCertificateFromSecret(ctx, secretinformer.Get(ctx), kubeclient.Get(ctx), secret)
+	serverTLSConfig.RequestClientCertificates = true
+	return eventingtls.GetTLSServerConfig(serverTLSConfig)
+}
@@ -30,6 +30,7 @@ import (
 	"knative.dev/eventing/pkg/requestreply"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered"
+	secretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/filtered"
 	filteredfactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
 	configmap "knative.dev/pkg/configmap/informer"
 	"knative.dev/pkg/controller"
@@ -42,10 +43,13 @@ import (
 )
 
 type envConfig struct {
-	HttpPort    int    `envconfig:"HTTP_PORT" default:"8080"`
-	HttpsPort   int    `envconfig:"HTTPS_PORT" default:"8443"`
-	PodIdx      int    `envconfig:"POD_INDEX" required:"true"`
-	SecretsPath string `envconfig:"SECRETS_PATH" required:"true"`
+	HttpPort  int `envconfig:"HTTP_PORT" default:"8080"`
+	HttpsPort int `envconfig:"HTTPS_PORT" default:"8443"`
+	PodIdx    int `envconfig:"POD_INDEX" required:"true"`
+	// StatefulSetName and ReplicasServiceName are used to address the other replicas, to forward reply events to the replica
+	// holding the original request
+	StatefulSetName     string `envconfig:"STATEFULSET_NAME" default:"request-reply"`
+	ReplicasServiceName string `envconfig:"REPLICAS_SERVICE_NAME" default:"request-reply-replicas"`
 }
 
 func main() {
@@ -61,6 +65,7 @@ func main() {
 
 	ctx = filteredfactory.WithSelectors(ctx,
 		eventingtls.TrustBundleLabelSelector,
+		requestreply.KeySecretLabelSelector,
 	)
 
 	log.Printf("Registering %d clients", len(injection.Default.GetClients()))
@@ -95,11 +100,7 @@ func main() {
 		Logger: sl.Named("key-store"),
 	}
 
-	err = keyStore.WatchPath(env.SecretsPath)
-	if err != nil {
-		logger.Fatal("failed to watch secrets file path", zap.Error(err))
-	}
-	defer keyStore.StopWatch()
+	secretinformer.Get(ctx, requestreply.KeySecretLabelSelector).Informer().AddEventHandler(keyStore.SecretEventHandler())
 
 	handler := requestreply.NewHandler(
 		logger,
@@ -107,6 +108,7 @@ func main() {
 		trustBundleConfigMapLister,
 		keyStore,
 		env.PodIdx,
+		requestreply.StatefulSetReplicaAddress(env.StatefulSetName, env.ReplicasServiceName, system.Namespace(), env.HttpPort),
 	)
 
 	sm, err := eventingtls.NewServerManager(ctx,
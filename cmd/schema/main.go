@@ -44,6 +44,8 @@ func main() {
 
 	// Sinks
 	registry.Register(&sinksv1alpha1.JobSink{})
+	registry.Register(&sinksv1alpha1.StreamSink{})
+	registry.Register(&sinksv1alpha1.PullSink{})
 	registry.Register(&sinksv1alpha1.IntegrationSink{})
 
 	// Sources
@@ -51,6 +53,8 @@ func main() {
 	registry.Register(&sourcesv1.SinkBinding{})
 	registry.Register(&sourcesv1.ContainerSource{}) // WARNING: THIS DOES NOT WORK OUT OF THE BOX: See https://github.com/knative/eventing/issues/5353.
 	registry.Register(&sourcesv1alpha1.IntegrationSource{})
+	registry.Register(&sourcesv1alpha1.MQTTSource{})
+	registry.Register(&sourcesv1alpha1.WebSocketSource{})
 
 	// Flows
 	registry.Register(&flowsv1.Sequence{})
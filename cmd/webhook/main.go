@@ -37,6 +37,7 @@ import (
 	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
 	"knative.dev/eventing/pkg/auth"
 	"knative.dev/eventing/pkg/eventingtls"
+	"knative.dev/eventing/pkg/eventsigning"
 
 	filteredFactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
 	"knative.dev/pkg/configmap"
@@ -55,6 +56,7 @@ import (
 	"knative.dev/pkg/webhook/resourcesemantics/defaulting"
 	"knative.dev/pkg/webhook/resourcesemantics/validation"
 
+	"knative.dev/eventing/pkg/adapter/apiserver/predicate"
 	defaultconfig "knative.dev/eventing/pkg/apis/config"
 	"knative.dev/eventing/pkg/apis/eventing"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
@@ -81,9 +83,10 @@ func init() {
 var ourTypes = map[schema.GroupVersionKind]resourcesemantics.GenericCRD{
 	// For group eventing.knative.dev.
 	// v1alpha1
-	eventingv1alpha1.SchemeGroupVersion.WithKind("EventPolicy"):    &eventingv1alpha1.EventPolicy{},
-	eventingv1alpha1.SchemeGroupVersion.WithKind("EventTransform"): &eventingv1alpha1.EventTransform{},
-	eventingv1alpha1.SchemeGroupVersion.WithKind("RequestReply"):   &eventingv1alpha1.RequestReply{},
+	eventingv1alpha1.SchemeGroupVersion.WithKind("ClusterEventPolicy"): &eventingv1alpha1.ClusterEventPolicy{},
+	eventingv1alpha1.SchemeGroupVersion.WithKind("EventPolicy"):        &eventingv1alpha1.EventPolicy{},
+	eventingv1alpha1.SchemeGroupVersion.WithKind("EventTransform"):     &eventingv1alpha1.EventTransform{},
+	eventingv1alpha1.SchemeGroupVersion.WithKind("RequestReply"):       &eventingv1alpha1.RequestReply{},
 	// v1beta1
 	eventingv1beta1.SchemeGroupVersion.WithKind("EventType"): &eventingv1beta1.EventType{},
 	// v1beta2
@@ -100,6 +103,8 @@ var ourTypes = map[schema.GroupVersionKind]resourcesemantics.GenericCRD{
 	// For group sources.knative.dev.
 	// v1alpha1
 	sourcesv1alpha1.SchemeGroupVersion.WithKind("IntegrationSource"): &sourcesv1alpha1.IntegrationSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("MQTTSource"):        &sourcesv1alpha1.MQTTSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("WebSocketSource"):   &sourcesv1alpha1.WebSocketSource{},
 	// v1beta2
 	sourcesv1beta2.SchemeGroupVersion.WithKind("PingSource"): &sourcesv1beta2.PingSource{},
 	// v1
@@ -111,6 +116,8 @@ var ourTypes = map[schema.GroupVersionKind]resourcesemantics.GenericCRD{
 	// For group sinks.knative.dev.
 	// v1alpha1
 	sinksv1alpha1.SchemeGroupVersion.WithKind("JobSink"):         &sinksv1alpha1.JobSink{},
+	sinksv1alpha1.SchemeGroupVersion.WithKind("StreamSink"):      &sinksv1alpha1.StreamSink{},
+	sinksv1alpha1.SchemeGroupVersion.WithKind("PullSink"):        &sinksv1alpha1.PullSink{},
 	sinksv1alpha1.SchemeGroupVersion.WithKind("IntegrationSink"): &sinksv1alpha1.IntegrationSink{},
 
 	// For group flows.knative.dev
@@ -174,13 +181,15 @@ func NewValidationAdmissionController(ctx context.Context, cmw configmap.Watcher
 
 	// Decorate contexts with the current state of the config.
 	ctxFunc := func(ctx context.Context) context.Context {
-		return sinks.WithConfig(
+		ctx = sinks.WithConfig(
 			featureStore.ToContext(
 				channelStore.ToContext(
 					pingstore.ToContext(store.ToContext(ctx)))),
 			&sinks.Config{
 				KubeClient: k8s,
 			})
+		ctx = eventingv1alpha1.WithKeySetValidator(ctx, eventsigning.ValidateKeySet)
+		return sourcesv1.WithPredicateValidator(ctx, predicate.Validate)
 	}
 
 	if err := requestreply.RegisterCESQLVerifyCorrelationIdFilter(ctx); err != nil {
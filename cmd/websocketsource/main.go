@@ -17,76 +17,17 @@ limitations under the License.
 package main
 
 import (
-	"context"
-	"flag"
-	"log"
-	"os"
+	"knative.dev/pkg/signals"
 
-	cloudevents "github.com/cloudevents/sdk-go/v2"
-	"github.com/gorilla/websocket"
+	"knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/adapter/websocket"
 )
 
-var (
-	sink   string
-	source string
-
-	// CloudEvents specific parameters
-	eventType   string
-	eventSource string
+const (
+	component = "websocketsource"
 )
 
-func init() {
-	flag.StringVar(&sink, "sink", "", "the host url to send messages to")
-	flag.StringVar(&source, "source", "", "the url to get messages from")
-	flag.StringVar(&eventType, "eventType", "websocket-event", "the event-type (CloudEvents)")
-	flag.StringVar(&eventSource, "eventSource", "", "the event-source (CloudEvents)")
-}
-
 func main() {
-	flag.Parse()
-
-	//nolint:staticcheck
-	k_sink := os.Getenv("K_SINK")
-	if k_sink != "" {
-		sink = k_sink
-	}
-
-	// "source" flag must not be empty for operation.
-	if source == "" {
-		log.Fatal("A valid source url must be defined.")
-	}
-
-	// The event's source defaults to the URL of where it was taken from.
-	if eventSource == "" {
-		eventSource = source
-	}
-
-	ce, err := cloudevents.NewClientHTTP()
-	if err != nil {
-		log.Fatalf("Failed to create a http cloudevent client: %s", err.Error())
-	}
-
-	ws, _, err := websocket.DefaultDialer.Dial(source, nil)
-	if err != nil {
-		log.Fatal("error connecting:", err)
-	}
-
-	ctx := cloudevents.ContextWithTarget(context.Background(), sink)
-
-	for {
-		_, message, err := ws.ReadMessage()
-		if err != nil {
-			// TODO(markusthoemmes): Handle failures and reconnect
-			log.Println("error while reading message:", err)
-			return
-		}
-
-		event := cloudevents.NewEvent(cloudevents.VersionV1)
-		event.SetType(eventType)
-		event.SetSource(eventSource)
-		_ = event.SetData(cloudevents.ApplicationJSON, message)
-		if result := ce.Send(ctx, event); !cloudevents.IsACK(result) {
-			log.Printf("sending event to channel failed: %v", result)
-		}
-	}
+	ctx := websocket.WithReadiness(signals.NewContext())
+	adapter.MainWithContext(ctx, component, websocket.NewEnvConfig, websocket.NewAdapter)
 }
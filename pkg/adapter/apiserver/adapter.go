@@ -23,17 +23,21 @@ import (
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/cel-go/cel"
 	"go.uber.org/zap"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
 
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
 
+	"knative.dev/eventing/pkg/adapter/apiserver/predicate"
 	"knative.dev/eventing/pkg/adapter/v2"
 	v1 "knative.dev/eventing/pkg/apis/sources/v1"
 	"knative.dev/eventing/pkg/eventfilter/subscriptionsapi"
@@ -52,10 +56,14 @@ type apiServerAdapter struct {
 
 	config Config
 
-	discover discovery.DiscoveryInterface
-	k8s      dynamic.Interface
-	source   string // TODO: who dis?
-	name     string // TODO: who dis?
+	discover   discovery.DiscoveryInterface
+	k8s        dynamic.Interface
+	configMaps corev1client.ConfigMapInterface
+	source     string // TODO: who dis?
+	name       string // TODO: who dis?
+
+	// checkpoints is set when the checkpoints of the watches are persisted.
+	checkpoints *checkpointer
 }
 
 type resourceWatchMatch struct {
@@ -65,6 +73,8 @@ type resourceWatchMatch struct {
 	apiResource *metav1.APIResource
 	// resourceInterfaces are the dynamic interfaces that are matched to the api resource and namespace configuration in the resource watch.
 	resourceInterfaces []dynamic.ResourceInterface
+	// predicate is the compiled predicate of the resource watch, if any.
+	predicate cel.Program
 }
 
 func (a *apiServerAdapter) Start(ctx context.Context) error {
@@ -81,6 +91,26 @@ func (a *apiServerAdapter) start(ctx context.Context, stopCh <-chan struct{}) er
 		return fmt.Errorf("failed to collect resource matches: %v", err)
 	}
 
+	if a.config.Checkpoint != nil {
+		checkpoints, err := loadCheckpointer(ctx, a.configMaps, a.config.Checkpoint.ConfigMapName, a.logger)
+		if err != nil {
+			return err
+		}
+		a.checkpoints = checkpoints
+
+		checkpointCtx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checkpoints.run(checkpointCtx, checkpointFlushInterval)
+		}()
+		defer func() {
+			cancel()
+			wg.Wait()
+		}()
+	}
+
 	// we have two modes of operation for the ApiServerSource adapter:
 	// 1. Resilient Mode (Default): The adapter uses `reflector.Run()` to continuously retry establishing watches
 	//    on resources, making it resilient to transient errors or delayed permission grants.
@@ -113,12 +143,13 @@ func (a *apiServerAdapter) startResilient(ctx context.Context, stopCh <-chan str
 		}
 		for i, res := range match.resourceInterfaces {
 			lw := &cache.ListWatch{
-				ListFunc:  asUnstructuredLister(ctx, res.List, match.resourceWatch.LabelSelector),
-				WatchFunc: asUnstructuredWatcher(ctx, res.Watch, match.resourceWatch.LabelSelector),
+				ListFunc:  asUnstructuredLister(ctx, res.List, match.resourceWatch.LabelSelector, match.resourceWatch.FieldSelector),
+				WatchFunc: asUnstructuredWatcher(ctx, res.Watch, match.resourceWatch.LabelSelector, match.resourceWatch.FieldSelector),
 			}
 
 			reflectorName := a.buildReflectorName(match.apiResource.Namespaced, match.resourceWatch.GVR.String(), i)
-			reflector := cache.NewNamedReflector(reflectorName, lw, &unstructured.Unstructured{}, delegate, resyncPeriod)
+			store := a.withCheckpoint(a.withFilter(delegate, match), match.apiResource.Namespaced, match.resourceWatch.GVR, i)
+			reflector := cache.NewNamedReflector(reflectorName, lw, &unstructured.Unstructured{}, store, resyncPeriod)
 			go reflector.Run(stop)
 		}
 	}
@@ -150,12 +181,13 @@ func (a *apiServerAdapter) startFailFast(ctx context.Context, stopCh <-chan stru
 		}
 		for i, res := range match.resourceInterfaces {
 			lw := &cache.ListWatch{
-				ListFunc:  asUnstructuredLister(watchCtx, res.List, match.resourceWatch.LabelSelector),
-				WatchFunc: asUnstructuredWatcher(watchCtx, res.Watch, match.resourceWatch.LabelSelector),
+				ListFunc:  asUnstructuredLister(watchCtx, res.List, match.resourceWatch.LabelSelector, match.resourceWatch.FieldSelector),
+				WatchFunc: asUnstructuredWatcher(watchCtx, res.Watch, match.resourceWatch.LabelSelector, match.resourceWatch.FieldSelector),
 			}
 
 			reflectorName := a.buildReflectorName(match.apiResource.Namespaced, match.resourceWatch.GVR.String(), i)
-			reflector := cache.NewNamedReflector(reflectorName, lw, &unstructured.Unstructured{}, delegate, resyncPeriod)
+			store := a.withCheckpoint(a.withFilter(delegate, match), match.apiResource.Namespaced, match.resourceWatch.GVR, i)
+			reflector := cache.NewNamedReflector(reflectorName, lw, &unstructured.Unstructured{}, store, resyncPeriod)
 			wg.Add(1)
 			go func() {
 				defer wg.Done()
@@ -193,7 +225,7 @@ func (a *apiServerAdapter) startFailFast(ctx context.Context, stopCh <-chan stru
 }
 
 func (a *apiServerAdapter) setupDelegate() cache.Store {
-	var delegate cache.Store = &resourceDelegate{
+	resources := &resourceDelegate{
 		ce:                  a.ce,
 		source:              a.source,
 		logger:              a.logger,
@@ -201,17 +233,35 @@ func (a *apiServerAdapter) setupDelegate() cache.Store {
 		apiServerSourceName: a.name,
 		filter:              subscriptionsapi.NewAllFilter(subscriptionsapi.MaterializeFiltersList(a.logger.Desugar(), a.config.Filters)...),
 	}
+
+	if a.config.EventMode == v1.DiffMode {
+		return newDiffDelegate(resources, a.config.Diff)
+	}
 	if a.config.ResourceOwner != nil {
 		a.logger.Infow("will be filtered",
 			zap.String("APIVersion", a.config.ResourceOwner.APIVersion),
 			zap.String("Kind", a.config.ResourceOwner.Kind))
-		delegate = &controllerFilter{
-			apiVersion: a.config.ResourceOwner.APIVersion,
-			kind:       a.config.ResourceOwner.Kind,
-			delegate:   delegate,
-		}
 	}
-	return delegate
+	return resources
+}
+
+// withFilter wraps the delegate of a watch to filter resources by owner and
+// predicate, when any is configured.
+func (a *apiServerAdapter) withFilter(delegate cache.Store, match resourceWatchMatch) cache.Store {
+	if a.config.ResourceOwner == nil && match.predicate == nil {
+		return delegate
+	}
+
+	filter := &controllerFilter{
+		predicate: match.predicate,
+		delegate:  delegate,
+		logger:    a.logger,
+	}
+	if a.config.ResourceOwner != nil {
+		filter.apiVersion = a.config.ResourceOwner.APIVersion
+		filter.kind = a.config.ResourceOwner.Kind
+	}
+	return filter
 }
 
 // buildReflectorName builds the reflector name with resource GVR and namespace.
@@ -225,6 +275,34 @@ func (a *apiServerAdapter) buildReflectorName(namespaced bool, gvr string, names
 	return gvr
 }
 
+// withCheckpoint wraps the delegate of a watch to record its checkpoint, when
+// checkpoints are persisted.
+func (a *apiServerAdapter) withCheckpoint(delegate cache.Store, namespaced bool, gvr schema.GroupVersionResource, namespaceIndex int) cache.Store {
+	if a.checkpoints == nil {
+		return delegate
+	}
+	return &checkpointDelegate{
+		Store:       delegate,
+		key:         a.buildCheckpointKey(namespaced, gvr, namespaceIndex),
+		checkpoints: a.checkpoints,
+		logger:      a.logger,
+	}
+}
+
+// buildCheckpointKey builds the ConfigMap key of the checkpoint of a watch,
+// like "deployments.v1.apps" or "deployments.v1.apps_default" when only the
+// "default" namespace is watched.
+func (a *apiServerAdapter) buildCheckpointKey(namespaced bool, gvr schema.GroupVersionResource, namespaceIndex int) string {
+	key := gvr.Resource + "." + gvr.Version
+	if gvr.Group != "" {
+		key += "." + gvr.Group
+	}
+	if namespaced && !a.config.AllNamespaces {
+		key += "_" + a.config.Namespaces[namespaceIndex]
+	}
+	return key
+}
+
 func (a *apiServerAdapter) collectResourceMatches() ([]resourceWatchMatch, error) {
 	matches := make([]resourceWatchMatch, 0, len(a.config.Resources))
 
@@ -239,6 +317,12 @@ func (a *apiServerAdapter) collectResourceMatches() ([]resourceWatchMatch, error
 			apiResource:        nil,
 			resourceInterfaces: make([]dynamic.ResourceInterface, 0),
 		}
+		if configRes.Predicate != "" {
+			match.predicate, err = predicate.Compile(configRes.Predicate)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compile predicate of resource %s: %v", configRes.GVR.String(), err)
+			}
+		}
 		for _, apires := range resources.APIResources {
 			if apires.Name == configRes.GVR.Resource {
 				match.apiResource = &apires
@@ -267,11 +351,14 @@ func (a *apiServerAdapter) collectResourceMatches() ([]resourceWatchMatch, error
 
 type unstructuredLister func(context.Context, metav1.ListOptions) (*unstructured.UnstructuredList, error)
 
-func asUnstructuredLister(ctx context.Context, ulist unstructuredLister, selector string) cache.ListFunc {
+func asUnstructuredLister(ctx context.Context, ulist unstructuredLister, selector, fieldSelector string) cache.ListFunc {
 	return func(opts metav1.ListOptions) (runtime.Object, error) {
 		if selector != "" && opts.LabelSelector == "" {
 			opts.LabelSelector = selector
 		}
+		if fieldSelector != "" && opts.FieldSelector == "" {
+			opts.FieldSelector = fieldSelector
+		}
 		ul, err := ulist(ctx, opts)
 		if err != nil {
 			return nil, err
@@ -282,11 +369,14 @@ func asUnstructuredLister(ctx context.Context, ulist unstructuredLister, selecto
 
 type structuredWatcher func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
 
-func asUnstructuredWatcher(ctx context.Context, wf structuredWatcher, selector string) cache.WatchFunc {
+func asUnstructuredWatcher(ctx context.Context, wf structuredWatcher, selector, fieldSelector string) cache.WatchFunc {
 	return func(lo metav1.ListOptions) (watch.Interface, error) {
 		if selector != "" && lo.LabelSelector == "" {
 			lo.LabelSelector = selector
 		}
+		if fieldSelector != "" && lo.FieldSelector == "" {
+			lo.FieldSelector = fieldSelector
+		}
 		return wf(ctx, lo)
 	}
 }
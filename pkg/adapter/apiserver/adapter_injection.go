@@ -67,12 +67,13 @@ func NewAdapter(ctx context.Context, processed adapter.EnvConfigAccessor, ceClie
 	}
 
 	return &apiServerAdapter{
-		discover: kubeclient.Get(ctx).Discovery(),
-		k8s:      dynamicclient.Get(ctx),
-		ce:       ceClient,
-		source:   Get(ctx),
-		name:     env.Name,
-		config:   config,
+		discover:   kubeclient.Get(ctx).Discovery(),
+		k8s:        dynamicclient.Get(ctx),
+		configMaps: kubeclient.Get(ctx).CoreV1().ConfigMaps(env.Namespace),
+		ce:         ceClient,
+		source:     Get(ctx),
+		name:       env.Name,
+		config:     config,
 
 		logger: logger,
 	}
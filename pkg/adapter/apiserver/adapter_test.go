@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
 	discoveryfake "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/dynamic"
@@ -380,3 +381,32 @@ func TestAdapter_FailFast(t *testing.T) {
 		})
 	}
 }
+
+func TestAsUnstructuredSelectors(t *testing.T) {
+	var listOpts, watchOpts metav1.ListOptions
+
+	lister := asUnstructuredLister(context.Background(), func(_ context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+		listOpts = opts
+		return &unstructured.UnstructuredList{}, nil
+	}, "app=web", "status.phase=Running")
+	watcher := asUnstructuredWatcher(context.Background(), func(_ context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+		watchOpts = opts
+		return watch.NewFake(), nil
+	}, "app=web", "status.phase=Running")
+
+	if _, err := lister(metav1.ListOptions{}); err != nil {
+		t.Fatal("lister() =", err)
+	}
+	if _, err := watcher(metav1.ListOptions{}); err != nil {
+		t.Fatal("watcher() =", err)
+	}
+
+	for _, opts := range []metav1.ListOptions{listOpts, watchOpts} {
+		if opts.LabelSelector != "app=web" {
+			t.Errorf("Expected label selector %q, got %q", "app=web", opts.LabelSelector)
+		}
+		if opts.FieldSelector != "status.phase=Running" {
+			t.Errorf("Expected field selector %q, got %q", "status.phase=Running", opts.FieldSelector)
+		}
+	}
+}
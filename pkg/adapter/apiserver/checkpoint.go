@@ -0,0 +1,288 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// checkpointFlushInterval is how often changed checkpoints are persisted.
+	checkpointFlushInterval = 10 * time.Second
+	// checkpointFlushTimeout bounds the last flush done when the adapter stops.
+	checkpointFlushTimeout = 5 * time.Second
+)
+
+// checkpoint is the last seen resource version of every resource of a watch,
+// by UID. Resource versions are opaque, they are only compared for equality.
+type checkpoint struct {
+	ResourceVersions map[types.UID]string `json:"resourceVersions"`
+}
+
+// checkpointer keeps the checkpoints of all the watches of the adapter and
+// periodically persists them in a ConfigMap.
+type checkpointer struct {
+	configMaps corev1client.ConfigMapInterface
+	name       string
+	logger     *zap.SugaredLogger
+
+	mu          sync.Mutex
+	checkpoints map[string]checkpoint
+	dirty       bool
+}
+
+// loadCheckpointer returns a checkpointer initialized from the ConfigMap name,
+// which doesn't need to exist yet.
+func loadCheckpointer(ctx context.Context, configMaps corev1client.ConfigMapInterface, name string, logger *zap.SugaredLogger) (*checkpointer, error) {
+	c := &checkpointer{
+		configMaps:  configMaps,
+		name:        name,
+		logger:      logger,
+		checkpoints: make(map[string]checkpoint),
+	}
+
+	cm, err := configMaps.Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checkpoint ConfigMap %q: %w", name, err)
+	}
+
+	for key, value := range cm.Data {
+		var cp checkpoint
+		if err := json.Unmarshal([]byte(value), &cp); err != nil || cp.ResourceVersions == nil {
+			logger.Warnw("ignoring invalid checkpoint", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		c.checkpoints[key] = cp
+	}
+	return c, nil
+}
+
+// has returns whether there is a checkpoint for the watch key.
+func (c *checkpointer) has(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, ok := c.checkpoints[key]
+	return ok
+}
+
+// resourceVersion returns the last seen resource version of the resource uid
+// of the watch key.
+func (c *checkpointer) resourceVersion(key string, uid types.UID) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rv, ok := c.checkpoints[key].ResourceVersions[uid]
+	return rv, ok
+}
+
+// record sets the last seen resource version of the resource uid of the watch key.
+func (c *checkpointer) record(key string, uid types.UID, resourceVersion string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cp, ok := c.checkpoints[key]
+	if !ok {
+		cp = checkpoint{ResourceVersions: make(map[types.UID]string)}
+		c.checkpoints[key] = cp
+	}
+	if cp.ResourceVersions[uid] == resourceVersion {
+		return
+	}
+	cp.ResourceVersions[uid] = resourceVersion
+	c.dirty = true
+}
+
+// forget removes the resource uid from the checkpoint of the watch key.
+func (c *checkpointer) forget(key string, uid types.UID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.checkpoints[key].ResourceVersions[uid]; ok {
+		delete(c.checkpoints[key].ResourceVersions, uid)
+		c.dirty = true
+	}
+}
+
+// reset sets the checkpoint of the watch key to the resources of list.
+func (c *checkpointer) reset(key string, list []interface{}) {
+	cp := checkpoint{ResourceVersions: make(map[types.UID]string, len(list))}
+	for _, obj := range list {
+		if o, err := meta.Accessor(obj); err == nil {
+			cp.ResourceVersions[o.GetUID()] = o.GetResourceVersion()
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.checkpoints[key] = cp
+	c.dirty = true
+}
+
+// run persists the changed checkpoints every interval until ctx is done, and
+// one last time before returning.
+func (c *checkpointer) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := c.flush(ctx); err != nil {
+				c.logger.Warnw("failed to persist checkpoints", zap.Error(err))
+			}
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), checkpointFlushTimeout)
+			defer cancel()
+			if err := c.flush(flushCtx); err != nil {
+				c.logger.Warnw("failed to persist checkpoints", zap.Error(err))
+			}
+			return
+		}
+	}
+}
+
+// flush writes the checkpoints to the ConfigMap if they changed since the
+// last successful flush.
+func (c *checkpointer) flush(ctx context.Context) error {
+	c.mu.Lock()
+	if !c.dirty {
+		c.mu.Unlock()
+		return nil
+	}
+	data := make(map[string]string, len(c.checkpoints))
+	for key, cp := range c.checkpoints {
+		b, err := json.Marshal(cp)
+		if err != nil {
+			c.mu.Unlock()
+			return fmt.Errorf("failed to marshal checkpoint %q: %w", key, err)
+		}
+		data[key] = string(b)
+	}
+	c.dirty = false
+	c.mu.Unlock()
+
+	err := c.write(ctx, data)
+	if err != nil {
+		// Try again on the next flush.
+		c.mu.Lock()
+		c.dirty = true
+		c.mu.Unlock()
+	}
+	return err
+}
+
+func (c *checkpointer) write(ctx context.Context, data map[string]string) error {
+	cm, err := c.configMaps.Get(ctx, c.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = c.configMaps.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: c.name},
+			Data:       data,
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	cm = cm.DeepCopy()
+	cm.Data = data
+	_, err = c.configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+	return err
+}
+
+// checkpointDelegate records the resource versions seen by a single watch.
+// When the watch lists the resources again, after a restart of the adapter
+// or an expired watch, it only sends events for the resources changed since
+// its checkpoint: an add for the ones it didn't see before, an update for the
+// ones with a different resource version. Deletions made in the meantime
+// can't be detected.
+type checkpointDelegate struct {
+	cache.Store
+
+	key         string
+	checkpoints *checkpointer
+	logger      *zap.SugaredLogger
+}
+
+var _ cache.Store = (*checkpointDelegate)(nil)
+
+func (c *checkpointDelegate) Add(obj interface{}) error {
+	defer c.observe(obj)
+	return c.Store.Add(obj)
+}
+
+func (c *checkpointDelegate) Update(obj interface{}) error {
+	defer c.observe(obj)
+	return c.Store.Update(obj)
+}
+
+func (c *checkpointDelegate) Delete(obj interface{}) error {
+	if o, err := meta.Accessor(obj); err == nil {
+		c.checkpoints.forget(c.key, o.GetUID())
+	}
+	return c.Store.Delete(obj)
+}
+
+func (c *checkpointDelegate) Replace(list []interface{}, resourceVersion string) error {
+	if c.checkpoints.has(c.key) {
+		for _, obj := range list {
+			c.replay(obj)
+		}
+	}
+	c.checkpoints.reset(c.key, list)
+	return c.Store.Replace(list, resourceVersion)
+}
+
+// replay sends an event for obj if it changed since the checkpoint.
+func (c *checkpointDelegate) replay(obj interface{}) {
+	o, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+
+	rv, ok := c.checkpoints.resourceVersion(c.key, o.GetUID())
+	switch {
+	case !ok:
+		_ = c.Store.Add(obj)
+	case rv != o.GetResourceVersion():
+		_ = c.Store.Update(obj)
+	}
+}
+
+func (c *checkpointDelegate) observe(obj interface{}) {
+	if o, err := meta.Accessor(obj); err == nil {
+		c.checkpoints.record(c.key, o.GetUID(), o.GetResourceVersion())
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+func TestCheckpointReplace(t *testing.T) {
+	r, ce := makeResourceAndTestingClient()
+	logger := zap.NewExample().Sugar()
+
+	checkpoints := &checkpointer{
+		logger: logger,
+		checkpoints: map[string]checkpoint{
+			"pods.v1_test": {ResourceVersions: map[types.UID]string{
+				"unchanged": "5",
+				"updated":   "10",
+				"deleted":   "7",
+			}},
+		},
+	}
+	d := &checkpointDelegate{Store: r, key: "pods.v1_test", checkpoints: checkpoints, logger: logger}
+
+	list := []interface{}{
+		podWithVersion("unchanged", "5"),
+		podWithVersion("updated", "abc"),
+		podWithVersion("created", "3"),
+	}
+	if err := d.Replace(list, "20"); err != nil {
+		t.Fatal("Replace() =", err)
+	}
+
+	sent := ce.Sent()
+	if got := len(sent); got != 2 {
+		t.Fatal("Expected 2 events to be sent, got:", got)
+	}
+	if got := sent[0].Type(); got != sources.ApiServerSourceUpdateEventType {
+		t.Errorf("Expected %q event to be sent, got %q", sources.ApiServerSourceUpdateEventType, got)
+	}
+	if got := sent[1].Type(); got != sources.ApiServerSourceAddEventType {
+		t.Errorf("Expected %q event to be sent, got %q", sources.ApiServerSourceAddEventType, got)
+	}
+
+	want := map[types.UID]string{"unchanged": "5", "updated": "abc", "created": "3"}
+	if diff := cmp.Diff(want, checkpoints.checkpoints["pods.v1_test"].ResourceVersions); diff != "" {
+		t.Error("Unexpected checkpoint (-want, +got):", diff)
+	}
+}
+
+func TestCheckpointReplaceWithoutCheckpoint(t *testing.T) {
+	r, ce := makeResourceAndTestingClient()
+	logger := zap.NewExample().Sugar()
+
+	checkpoints := &checkpointer{logger: logger, checkpoints: map[string]checkpoint{}}
+	d := &checkpointDelegate{Store: r, key: "pods.v1", checkpoints: checkpoints, logger: logger}
+
+	if err := d.Replace([]interface{}{podWithVersion("pod", "5")}, "7"); err != nil {
+		t.Fatal("Replace() =", err)
+	}
+	validateNotSent(t, ce, sources.ApiServerSourceAddEventType)
+
+	d.Update(podWithVersion("pod", "9"))
+	validateSent(t, ce, sources.ApiServerSourceUpdateEventType)
+
+	if rv, _ := checkpoints.resourceVersion("pods.v1", "pod"); rv != "9" {
+		t.Errorf("Expected checkpoint to be 9, got %q", rv)
+	}
+
+	d.Delete(podWithVersion("pod", "9"))
+	if rv, ok := checkpoints.resourceVersion("pods.v1", "pod"); ok {
+		t.Errorf("Expected deleted pod to be removed from the checkpoint, got %q", rv)
+	}
+}
+
+func TestCheckpointerPersistence(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewExample().Sugar()
+	configMaps := kubefake.NewSimpleClientset().CoreV1().ConfigMaps("test")
+
+	checkpoints, err := loadCheckpointer(ctx, configMaps, "checkpoints", logger)
+	if err != nil {
+		t.Fatal("loadCheckpointer() =", err)
+	}
+	if len(checkpoints.checkpoints) != 0 {
+		t.Error("Expected no checkpoints, got", checkpoints.checkpoints)
+	}
+
+	// Create the ConfigMap, then update it.
+	for _, rv := range []string{"10", "12"} {
+		checkpoints.record("pods.v1_test", "pod", rv)
+		if err := checkpoints.flush(ctx); err != nil {
+			t.Fatal("flush() =", err)
+		}
+	}
+
+	loaded, err := loadCheckpointer(ctx, configMaps, "checkpoints", logger)
+	if err != nil {
+		t.Fatal("loadCheckpointer() =", err)
+	}
+	if rv, _ := loaded.resourceVersion("pods.v1_test", "pod"); rv != "12" {
+		t.Errorf("Expected persisted checkpoint to be 12, got %q", rv)
+	}
+}
+
+func TestBuildCheckpointKey(t *testing.T) {
+	a := &apiServerAdapter{config: Config{Namespaces: []string{"default", "test"}}}
+
+	deployments := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	if got, want := a.buildCheckpointKey(true, deployments, 1), "deployments.v1.apps_test"; got != want {
+		t.Errorf("buildCheckpointKey() = %q, want %q", got, want)
+	}
+
+	namespaces := schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	if got, want := a.buildCheckpointKey(false, namespaces, 0), "namespaces.v1"; got != want {
+		t.Errorf("buildCheckpointKey() = %q, want %q", got, want)
+	}
+}
+
+func podWithVersion(name, resourceVersion string) *unstructured.Unstructured {
+	pod := simplePod(name, "test")
+	pod.SetUID(types.UID(name))
+	pod.SetResourceVersion(resourceVersion)
+	return pod
+}
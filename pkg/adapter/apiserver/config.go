@@ -30,6 +30,16 @@ type ResourceWatch struct {
 	// label selector.
 	// +optional
 	LabelSelector string `json:"selector,omitempty"`
+
+	// FieldSelector filters this source to objects to those resources pass the
+	// field selector.
+	// +optional
+	FieldSelector string `json:"fieldSelector,omitempty"`
+
+	// Predicate is a CEL expression filtering this source to the resources for
+	// which it evaluates to true.
+	// +optional
+	Predicate string `json:"predicate,omitempty"`
 }
 
 type Config struct {
@@ -55,10 +65,20 @@ type Config struct {
 	// EventMode controls the format of the event.
 	// `Reference` sends a dataref event type for the resource under watch.
 	// `Resource` send the full resource lifecycle event.
+	// `Diff` sends the changes made to the resource for updates.
 	// Defaults to `Reference`
 	// +optional
 	EventMode string `json:"mode,omitempty"`
 
+	// Diff configures the events sent in the `Diff` event mode.
+	// +optional
+	Diff *v1.ApiServerSourceDiff `json:"diff,omitempty"`
+
+	// Checkpoint configures where the last seen resource versions of the
+	// watches are persisted.
+	// +optional
+	Checkpoint *v1.ApiServerSourceCheckpoint `json:"checkpoint,omitempty"`
+
 	// Filters is an experimental field that conforms to the CNCF CloudEvents Subscriptions
 	// API. It's an array of filter expressions that evaluate to true or false.
 	// If any filter expression in the array evaluates to false, the event MUST
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"gomodules.xyz/jsonpatch/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/eventing/pkg/adapter/apiserver/events"
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+// diffDelegate sends the changes made to resources by updates. It keeps the
+// last seen version of every watched resource to compute these changes.
+type diffDelegate struct {
+	*resourceDelegate
+
+	jsonPatch   bool
+	ignorePaths [][]string
+
+	mu      sync.Mutex
+	objects map[string]*unstructured.Unstructured
+}
+
+var _ cache.Store = (*diffDelegate)(nil)
+
+func newDiffDelegate(delegate *resourceDelegate, cfg *v1.ApiServerSourceDiff) *diffDelegate {
+	d := &diffDelegate{
+		resourceDelegate: delegate,
+		objects:          make(map[string]*unstructured.Unstructured),
+	}
+	if cfg != nil {
+		d.jsonPatch = cfg.Format == v1.DiffFormatJSONPatch
+		for _, p := range cfg.IgnorePaths {
+			d.ignorePaths = append(d.ignorePaths, parseJSONPointer(p))
+		}
+	}
+	return d
+}
+
+func (d *diffDelegate) Add(obj interface{}) error {
+	if u, ok := obj.(*unstructured.Unstructured); ok && u != nil {
+		d.swap(u)
+	}
+	return d.resourceDelegate.Add(obj)
+}
+
+func (d *diffDelegate) Update(obj interface{}) error {
+	newObj, ok := obj.(*unstructured.Unstructured)
+	if !ok || newObj == nil {
+		return d.resourceDelegate.Update(obj)
+	}
+
+	oldObj := d.swap(newObj)
+
+	patch, err := d.diff(oldObj, newObj)
+	if err != nil {
+		d.logger.Infow("diff creation failed", "error", err)
+		return err
+	}
+	if oldObj != nil && len(patch) == 0 {
+		d.logger.Debugf("update of %s only changed ignored fields", objectKey(newObj))
+		return nil
+	}
+	if !d.jsonPatch {
+		patch = nil
+	}
+
+	return d.handleKubernetesObject(func(source, name string, _ interface{}, _ bool) (context.Context, cloudevents.Event, error) {
+		return events.MakeDiffEvent(source, name, oldObj, newObj, patch)
+	}, obj)
+}
+
+func (d *diffDelegate) Delete(obj interface{}) error {
+	if u, ok := obj.(*unstructured.Unstructured); ok && u != nil {
+		d.mu.Lock()
+		delete(d.objects, objectKey(u))
+		d.mu.Unlock()
+	}
+	return d.resourceDelegate.Delete(obj)
+}
+
+// Replace records the listed resources without sending events, so that the
+// changes made by later updates are computed from their current version.
+func (d *diffDelegate) Replace(list []interface{}, _ string) error {
+	for _, obj := range list {
+		if u, ok := obj.(*unstructured.Unstructured); ok && u != nil {
+			d.swap(u)
+		}
+	}
+	return nil
+}
+
+// swap records obj as the last seen version of the resource and returns the
+// previous one, if any.
+func (d *diffDelegate) swap(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	key := objectKey(obj)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	old := d.objects[key]
+	d.objects[key] = obj.DeepCopy()
+	return old
+}
+
+// diff returns the JSON Patch from oldObj to newObj, leaving out the ignored fields.
+func (d *diffDelegate) diff(oldObj, newObj *unstructured.Unstructured) ([]jsonpatch.Operation, error) {
+	oldJSON := []byte("{}")
+	if oldObj != nil {
+		b, err := json.Marshal(d.withoutIgnoredPaths(oldObj).Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal old resource: %w", err)
+		}
+		oldJSON = b
+	}
+
+	newJSON, err := json.Marshal(d.withoutIgnoredPaths(newObj).Object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal new resource: %w", err)
+	}
+
+	return jsonpatch.CreatePatch(oldJSON, newJSON)
+}
+
+func (d *diffDelegate) withoutIgnoredPaths(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	if len(d.ignorePaths) == 0 {
+		return obj
+	}
+
+	obj = obj.DeepCopy()
+	for _, fields := range d.ignorePaths {
+		unstructured.RemoveNestedField(obj.Object, fields...)
+	}
+	return obj
+}
+
+func objectKey(obj *unstructured.Unstructured) string {
+	return obj.GetAPIVersion() + "/" + obj.GetKind() + "/" + obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// parseJSONPointer returns the fields referenced by an RFC 6901 JSON Pointer.
+func parseJSONPointer(pointer string) []string {
+	fields := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, f := range fields {
+		fields[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(f)
+	}
+	return fields
+}
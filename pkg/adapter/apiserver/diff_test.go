@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gomodules.xyz/jsonpatch/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/eventing/pkg/adapter/apiserver/events"
+	"knative.dev/eventing/pkg/apis/sources"
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+func TestDiffAddAndDeleteEvents(t *testing.T) {
+	r, ce := makeResourceAndTestingClient()
+	d := newDiffDelegate(r, &v1.ApiServerSourceDiff{Format: v1.DiffFormatObjects})
+
+	d.Add(simplePod("unit", "test"))
+	validateSent(t, ce, sources.ApiServerSourceAddEventType)
+
+	d.Delete(simplePod("unit", "test"))
+	if got := ce.Sent()[1].Type(); got != sources.ApiServerSourceDeleteEventType {
+		t.Errorf("Expected %q event to be sent, got %q", sources.ApiServerSourceDeleteEventType, got)
+	}
+	if len(d.objects) != 0 {
+		t.Error("Expected deleted resource to be forgotten, got", d.objects)
+	}
+}
+
+func TestDiffUpdateObjects(t *testing.T) {
+	r, ce := makeResourceAndTestingClient()
+	d := newDiffDelegate(r, &v1.ApiServerSourceDiff{Format: v1.DiffFormatObjects})
+
+	oldPod := simplePod("unit", "test")
+	newPod := podWithLabels("unit", "test", map[string]interface{}{"app": "web"})
+
+	d.Replace([]interface{}{oldPod}, "")
+	d.Update(newPod)
+	validateSent(t, ce, sources.ApiServerSourceDiffEventType)
+
+	var got events.Diff
+	if err := json.Unmarshal(ce.Sent()[0].Data(), &got); err != nil {
+		t.Fatal("Failed to unmarshal diff:", err)
+	}
+	if diff := cmp.Diff(events.Diff{Old: oldPod, New: newPod}, got); diff != "" {
+		t.Error("Unexpected diff (-want, +got):", diff)
+	}
+}
+
+func TestDiffUpdateJSONPatch(t *testing.T) {
+	r, ce := makeResourceAndTestingClient()
+	d := newDiffDelegate(r, &v1.ApiServerSourceDiff{
+		Format:      v1.DiffFormatJSONPatch,
+		IgnorePaths: []string{"/metadata/resourceVersion", "/status"},
+	})
+
+	oldPod := simplePod("unit", "test")
+	oldPod.SetResourceVersion("1")
+	d.Add(oldPod)
+
+	newPod := podWithLabels("unit", "test", map[string]interface{}{"app": "web"})
+	newPod.SetResourceVersion("2")
+	d.Update(newPod)
+
+	if got := len(ce.Sent()); got != 2 {
+		t.Fatal("Expected 2 events to be sent, got:", got)
+	}
+	event := ce.Sent()[1]
+	if got := event.Type(); got != sources.ApiServerSourceDiffEventType {
+		t.Errorf("Expected %q event to be sent, got %q", sources.ApiServerSourceDiffEventType, got)
+	}
+	if got := event.DataContentType(); got != events.JSONPatchContentType {
+		t.Errorf("Expected content type %q, got %q", events.JSONPatchContentType, got)
+	}
+
+	var got []jsonpatch.Operation
+	if err := json.Unmarshal(event.Data(), &got); err != nil {
+		t.Fatal("Failed to unmarshal patch:", err)
+	}
+	want := []jsonpatch.Operation{{
+		Operation: "add",
+		Path:      "/metadata/labels",
+		Value:     map[string]interface{}{"app": "web"},
+	}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error("Unexpected patch (-want, +got):", diff)
+	}
+}
+
+func TestDiffUpdateOnlyIgnoredPaths(t *testing.T) {
+	r, ce := makeResourceAndTestingClient()
+	d := newDiffDelegate(r, &v1.ApiServerSourceDiff{
+		Format:      v1.DiffFormatObjects,
+		IgnorePaths: []string{"/metadata/resourceVersion", "/status"},
+	})
+
+	oldPod := simplePod("unit", "test")
+	oldPod.SetResourceVersion("1")
+	d.Replace([]interface{}{oldPod}, "")
+
+	newPod := simplePod("unit", "test")
+	newPod.SetResourceVersion("2")
+	unstructured.SetNestedField(newPod.Object, "Running", "status", "phase")
+	d.Update(newPod)
+
+	validateNotSent(t, ce, sources.ApiServerSourceDiffEventType)
+}
+
+func TestParseJSONPointer(t *testing.T) {
+	got := parseJSONPointer("/metadata/annotations/example.com~1name~0x")
+	want := []string{"metadata", "annotations", "example.com/name~x"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error("Unexpected fields (-want, +got):", diff)
+	}
+}
+
+func podWithLabels(name, namespace string, labels map[string]interface{}) *unstructured.Unstructured {
+	pod := simplePod(name, namespace)
+	unstructured.SetNestedMap(pod.Object, labels, "metadata", "labels")
+	return pod
+}
@@ -25,6 +25,7 @@ import (
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	ceobs "github.com/cloudevents/sdk-go/v2/observability"
 	"go.opentelemetry.io/otel/trace"
+	"gomodules.xyz/jsonpatch/v2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -35,6 +36,9 @@ import (
 
 const (
 	resourceGroup = "apiserversources.sources.knative.dev"
+
+	// JSONPatchContentType is the content type of RFC 6902 JSON Patch payloads.
+	JSONPatchContentType = "application/json-patch+json"
 )
 
 // MakeAddEvent returns a cloudevent when a k8s api event is created.
@@ -54,7 +58,7 @@ func MakeAddEvent(source string, apiServerSourceName string, obj interface{}, re
 		eventType = sources.ApiServerSourceAddEventType
 	}
 
-	return makeEvent(source, apiServerSourceName, eventType, object, data)
+	return makeEvent(source, apiServerSourceName, eventType, object, cloudevents.ApplicationJSON, data)
 }
 
 // MakeUpdateEvent returns a cloudevent when a k8s api event is updated.
@@ -74,7 +78,7 @@ func MakeUpdateEvent(source string, apiServerSourceName string, obj interface{},
 		eventType = sources.ApiServerSourceUpdateEventType
 	}
 
-	return makeEvent(source, apiServerSourceName, eventType, object, data)
+	return makeEvent(source, apiServerSourceName, eventType, object, cloudevents.ApplicationJSON, data)
 }
 
 // MakeDeleteEvent returns a cloudevent when a k8s api event is deleted.
@@ -94,7 +98,28 @@ func MakeDeleteEvent(source string, apiServerSourceName string, obj interface{},
 		eventType = sources.ApiServerSourceDeleteEventType
 	}
 
-	return makeEvent(source, apiServerSourceName, eventType, object, data)
+	return makeEvent(source, apiServerSourceName, eventType, object, cloudevents.ApplicationJSON, data)
+}
+
+// Diff is the payload of the events sent for updates in the `Diff` event mode
+// with the `Objects` format.
+type Diff struct {
+	Old *unstructured.Unstructured `json:"old,omitempty"`
+	New *unstructured.Unstructured `json:"new"`
+}
+
+// MakeDiffEvent returns a cloudevent with the changes made to a k8s object by
+// an update. When patch is nil, the event data contains both the old and the
+// new object, otherwise it contains the JSON Patch.
+func MakeDiffEvent(source string, apiServerSourceName string, oldObj, newObj *unstructured.Unstructured, patch []jsonpatch.Operation) (context.Context, cloudevents.Event, error) {
+	if newObj == nil {
+		return nil, cloudevents.Event{}, fmt.Errorf("resource can not be nil")
+	}
+
+	if patch != nil {
+		return makeEvent(source, apiServerSourceName, sources.ApiServerSourceDiffEventType, newObj, JSONPatchContentType, patch)
+	}
+	return makeEvent(source, apiServerSourceName, sources.ApiServerSourceDiffEventType, newObj, cloudevents.ApplicationJSON, Diff{Old: oldObj, New: newObj})
 }
 
 func getRef(object *unstructured.Unstructured) corev1.ObjectReference {
@@ -106,7 +131,7 @@ func getRef(object *unstructured.Unstructured) corev1.ObjectReference {
 	}
 }
 
-func makeEvent(source, apiServerSourceName, eventType string, obj *unstructured.Unstructured, data interface{}) (context.Context, cloudevents.Event, error) {
+func makeEvent(source, apiServerSourceName, eventType string, obj *unstructured.Unstructured, contentType string, data interface{}) (context.Context, cloudevents.Event, error) {
 	resourceName := obj.GetName()
 	kind := obj.GetKind()
 	namespace := obj.GetNamespace()
@@ -126,7 +151,7 @@ func makeEvent(source, apiServerSourceName, eventType string, obj *unstructured.
 	event.SetExtension("apiversion", obj.GetAPIVersion())
 	event.SetExtension("name", resourceName)
 	event.SetExtension("namespace", namespace)
-	if err := event.SetData(cloudevents.ApplicationJSON, data); err != nil {
+	if err := event.SetData(contentType, data); err != nil {
 		return nil, event, err
 	}
 
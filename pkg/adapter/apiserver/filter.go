@@ -17,16 +17,24 @@ limitations under the License.
 package apiserver
 
 import (
+	"github.com/google/cel-go/cel"
+	"go.uber.org/zap"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/eventing/pkg/adapter/apiserver/predicate"
 )
 
-// controllerFilter by apiVersion and/or kind
+// controllerFilter by apiVersion and/or kind of the controller, and by
+// predicate. Resources are only filtered by controller when kind is set.
 type controllerFilter struct {
 	apiVersion string
 	kind       string
+	predicate  cel.Program
 	delegate   cache.Store
+
+	logger *zap.SugaredLogger
 }
 
 var _ cache.Store = (*controllerFilter)(nil)
@@ -59,9 +67,32 @@ func (c *controllerFilter) Delete(obj interface{}) error {
 
 func (c *controllerFilter) filtered(obj interface{}) bool {
 	u := obj.(*unstructured.Unstructured)
+	if c.predicate != nil && !c.matches(u) {
+		return true
+	}
+	if c.kind == "" {
+		return false
+	}
 	controller := metav1.GetControllerOf(u)
 	return controller == nil || (c.apiVersion != "" && c.apiVersion != controller.APIVersion) ||
-		(c.kind != "" && c.kind != controller.Kind)
+		c.kind != controller.Kind
+}
+
+// matches returns whether the predicate evaluates to true for u. Resources
+// for which it fails to evaluate, for instance because of a missing field,
+// don't match.
+func (c *controllerFilter) matches(u *unstructured.Unstructured) bool {
+	out, _, err := c.predicate.Eval(map[string]interface{}{
+		predicate.ObjectVariable: u.Object,
+	})
+	if err != nil {
+		if c.logger != nil {
+			c.logger.Debugf("predicate failed to evaluate for %s/%s: %v", u.GetNamespace(), u.GetName(), err)
+		}
+		return false
+	}
+	matched, ok := out.Value().(bool)
+	return ok && matched
 }
 
 // Stub cache.Store impl
@@ -86,9 +117,15 @@ func (c *controllerFilter) GetByKey(key string) (item interface{}, exists bool,
 	return nil, false, nil
 }
 
-// Implements cache.Store
-func (c *controllerFilter) Replace([]interface{}, string) error {
-	return nil
+// Replace forwards the resources passing the filter.
+func (c *controllerFilter) Replace(list []interface{}, resourceVersion string) error {
+	filtered := make([]interface{}, 0, len(list))
+	for _, obj := range list {
+		if !c.filtered(obj) {
+			filtered = append(filtered, obj)
+		}
+	}
+	return c.delegate.Replace(filtered, resourceVersion)
 }
 
 // Implements cache.Store
@@ -19,6 +19,7 @@ package apiserver
 import (
 	"testing"
 
+	"knative.dev/eventing/pkg/adapter/apiserver/predicate"
 	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
 	sources "knative.dev/eventing/pkg/apis/sources"
 )
@@ -103,3 +104,44 @@ func makeController(apiVersion, kind string) (*controllerFilter, *adaptertest.Te
 		delegate:   delegate,
 	}, tc
 }
+
+func TestPredicateAddEvent(t *testing.T) {
+	c, tc := makePredicate(t, `object.metadata.name == "unit"`)
+	c.Add(simplePod("unit", "test"))
+	validateSent(t, tc, sources.ApiServerSourceAddRefEventType)
+}
+
+func TestPredicateAddEventNotMatching(t *testing.T) {
+	c, tc := makePredicate(t, `object.metadata.name == "other"`)
+	c.Add(simplePod("unit", "test"))
+	validateNotSent(t, tc, sources.ApiServerSourceAddRefEventType)
+}
+
+func TestPredicateAddEventMissingField(t *testing.T) {
+	c, tc := makePredicate(t, `object.spec.replicas > 1`)
+	c.Add(simplePod("unit", "test"))
+	validateNotSent(t, tc, sources.ApiServerSourceAddRefEventType)
+}
+
+func TestPredicateAndController(t *testing.T) {
+	c, tc := makePredicate(t, `object.metadata.namespace == "test"`)
+	c.kind = "ReplicaSet"
+	c.Add(simplePod("unit", "test"))
+	validateNotSent(t, tc, sources.ApiServerSourceAddRefEventType)
+
+	c.Add(simpleOwnedPod("unit", "test"))
+	validateSent(t, tc, sources.ApiServerSourceAddRefEventType)
+}
+
+func makePredicate(t *testing.T, expression string) (*controllerFilter, *adaptertest.TestCloudEventsClient) {
+	t.Helper()
+	program, err := predicate.Compile(expression)
+	if err != nil {
+		t.Fatal("predicate.Compile() =", err)
+	}
+	delegate, tc := makeRefAndTestingClient()
+	return &controllerFilter{
+		predicate: program,
+		delegate:  delegate,
+	}, tc
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package predicate compiles the CEL predicates of ApiServerSource resources.
+package predicate
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+)
+
+const (
+	// ObjectVariable is the name of the variable holding the resource in
+	// ApiServerSource predicates.
+	ObjectVariable = "object"
+
+	// costLimit bounds the cost of evaluating a predicate against a single
+	// resource.
+	costLimit = 1000000
+)
+
+// Compile compiles the CEL expression of an APIVersionKindSelector predicate
+// into a program returning a bool.
+func Compile(expression string) (cel.Program, error) {
+	env, err := cel.NewEnv(cel.Variable(ObjectVariable, cel.DynType))
+	if err != nil {
+		return nil, err
+	}
+
+	ast, iss := env.Compile(expression)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	if t := ast.OutputType(); !t.IsExactType(cel.BoolType) && !t.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("expression must evaluate to a bool, got %s", t)
+	}
+
+	return env.Program(ast, cel.CostLimit(costLimit))
+}
+
+// Validate returns an error when the CEL expression of an
+// APIVersionKindSelector predicate doesn't compile.
+func Validate(expression string) error {
+	_, err := Compile(expression)
+	return err
+}
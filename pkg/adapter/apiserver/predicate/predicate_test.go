@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompile(t *testing.T) {
+	tests := map[string]struct {
+		expression string
+		wantErr    string
+	}{
+		"bool": {
+			expression: `object.metadata.name.startsWith("web")`,
+		},
+		"dynamic": {
+			expression: "object.metadata.name",
+		},
+		"syntax error": {
+			expression: "object.metadata.name ==",
+			wantErr:    "Syntax error",
+		},
+		"string": {
+			expression: `"web"`,
+			wantErr:    "expression must evaluate to a bool, got string",
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			_, err := Compile(tc.expression)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Error("Compile() =", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("Compile() = %v, want an error containing %q", err, tc.wantErr)
+			}
+			if err := Validate(tc.expression); err == nil {
+				t.Error("Validate() = nil, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,314 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/eclipse/paho.golang/packets"
+	"github.com/eclipse/paho.golang/paho"
+	"go.uber.org/zap"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/apis/sources/v1alpha1"
+)
+
+const (
+	// keepAlive is the keep alive interval, in seconds, of the connection.
+	keepAlive = 30
+	// sessionExpiryInterval is how long, in seconds, the broker keeps the
+	// session after the connection is lost, so that messages published with
+	// QoS 1 or 2 in the meantime are delivered once reconnected.
+	sessionExpiryInterval = 300
+)
+
+type envConfig struct {
+	adapter.EnvConfig
+
+	// BrokerURL is the URL of the MQTT broker.
+	BrokerURL string `envconfig:"MQTT_BROKER_URL" required:"true"`
+
+	// ClientID is the client identifier presented to the broker.
+	ClientID string `envconfig:"MQTT_CLIENT_ID" required:"true"`
+
+	// Topics is the JSON encoded list of topics to subscribe to.
+	Topics string `envconfig:"MQTT_TOPICS" required:"true"`
+
+	Username string `envconfig:"MQTT_USERNAME"`
+	Password string `envconfig:"MQTT_PASSWORD"`
+
+	// CACert, Cert and Key are the PEM encoded certificates and key used for
+	// TLS connections.
+	CACert string `envconfig:"MQTT_TLS_CA_CERT"`
+	Cert   string `envconfig:"MQTT_TLS_CERT"`
+	Key    string `envconfig:"MQTT_TLS_KEY"`
+
+	ReconnectInitialDelay time.Duration `envconfig:"MQTT_RECONNECT_INITIAL_DELAY" default:"1s"`
+	ReconnectMaxDelay     time.Duration `envconfig:"MQTT_RECONNECT_MAX_DELAY" default:"2m"`
+}
+
+type mqttAdapter struct {
+	config *envConfig
+	topics []v1alpha1.MQTTTopic
+	ce     cloudevents.Client
+	logger *zap.SugaredLogger
+
+	// dial opens the network connection to the broker.
+	dial func(ctx context.Context) (net.Conn, error)
+}
+
+var _ adapter.Adapter = (*mqttAdapter)(nil)
+
+func NewEnvConfig() adapter.EnvConfigAccessor {
+	return &envConfig{}
+}
+
+func NewAdapter(ctx context.Context, processed adapter.EnvConfigAccessor, ceClient cloudevents.Client) adapter.Adapter {
+	logger := logging.FromContext(ctx)
+	env := processed.(*envConfig)
+
+	var topics []v1alpha1.MQTTTopic
+	if err := json.Unmarshal([]byte(env.Topics), &topics); err != nil {
+		logger.Fatalw("Failed to parse topics", zap.Error(err))
+	}
+
+	dial, err := newDialer(env)
+	if err != nil {
+		logger.Fatalw("Failed to configure the connection to the MQTT broker", zap.Error(err))
+	}
+
+	return &mqttAdapter{
+		config: env,
+		topics: topics,
+		ce:     ceClient,
+		logger: logger,
+		dial:   dial,
+	}
+}
+
+// Start connects to the broker and sends the messages published to the
+// topics until ctx is done, reconnecting with an exponential backoff when the
+// connection is lost.
+func (a *mqttAdapter) Start(ctx context.Context) error {
+	delay := a.config.ReconnectInitialDelay
+	for {
+		connected, err := a.session(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if connected {
+			delay = a.config.ReconnectInitialDelay
+		}
+
+		a.logger.Warnw("Connection to the MQTT broker lost, reconnecting", zap.Duration("delay", delay), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if delay > a.config.ReconnectMaxDelay {
+			delay = a.config.ReconnectMaxDelay
+		}
+	}
+}
+
+// session connects to the broker, subscribes to the topics and handles the
+// published messages until the connection is lost or ctx is done. It returns
+// whether the connection was established.
+func (a *mqttAdapter) session(ctx context.Context) (bool, error) {
+	conn, err := a.dial(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to dial %s: %w", a.config.BrokerURL, err)
+	}
+	conn = packets.NewThreadSafeConn(conn)
+	defer conn.Close()
+
+	lost := make(chan error, 1)
+	connectionLost := func(err error) {
+		select {
+		case lost <- err:
+		default:
+		}
+	}
+
+	var client *paho.Client
+	client = paho.NewClient(paho.ClientConfig{
+		ClientID:                   a.config.ClientID,
+		Conn:                       conn,
+		EnableManualAcknowledgment: true,
+		Router: paho.NewSingleHandlerRouter(func(p *paho.Publish) {
+			if err := a.handle(ctx, p); err != nil {
+				// Don't acknowledge the message, and reconnect so that the
+				// broker delivers it again.
+				connectionLost(err)
+				return
+			}
+			if err := client.Ack(p); err != nil {
+				a.logger.Warnw("Failed to acknowledge message", zap.String("topic", p.Topic), zap.Error(err))
+			}
+		}),
+		OnClientError: connectionLost,
+		OnServerDisconnect: func(d *paho.Disconnect) {
+			connectionLost(fmt.Errorf("disconnected by the broker, reason code %d", d.ReasonCode))
+		},
+	})
+
+	expiry := uint32(sessionExpiryInterval)
+	connect := &paho.Connect{
+		ClientID:   a.config.ClientID,
+		KeepAlive:  keepAlive,
+		CleanStart: false,
+		Properties: &paho.ConnectProperties{
+			SessionExpiryInterval: &expiry,
+		},
+	}
+	if a.config.Username != "" {
+		connect.Username = a.config.Username
+		connect.UsernameFlag = true
+	}
+	if a.config.Password != "" {
+		connect.Password = []byte(a.config.Password)
+		connect.PasswordFlag = true
+	}
+
+	connack, err := client.Connect(ctx, connect)
+	if err != nil {
+		return false, fmt.Errorf("failed to connect: %w", err)
+	}
+	if connack.ReasonCode != 0 {
+		return false, fmt.Errorf("connection refused, reason code %d", connack.ReasonCode)
+	}
+	a.logger.Infow("Connected to the MQTT broker", zap.String("broker", a.config.BrokerURL))
+
+	subscribe := &paho.Subscribe{}
+	for _, t := range a.topics {
+		subscribe.Subscriptions = append(subscribe.Subscriptions, paho.SubscribeOptions{
+			Topic: t.Filter,
+			QoS:   byte(t.QoS),
+		})
+	}
+	suback, err := client.Subscribe(ctx, subscribe)
+	if err != nil {
+		return true, fmt.Errorf("failed to subscribe: %w", err)
+	}
+	for i, reason := range suback.Reasons {
+		if reason >= 0x80 && i < len(a.topics) {
+			return true, fmt.Errorf("subscription to %q refused, reason code %d", a.topics[i].Filter, reason)
+		}
+	}
+	a.logger.Infow("Subscribed to topics", zap.Any("topics", a.topics))
+
+	select {
+	case <-ctx.Done():
+		_ = client.Disconnect(&paho.Disconnect{ReasonCode: 0})
+		return true, nil
+	case err := <-lost:
+		return true, err
+	}
+}
+
+// handle sends the message p to the sink. It returns an error when it was
+// not delivered and should be delivered again.
+func (a *mqttAdapter) handle(ctx context.Context, p *paho.Publish) error {
+	event, err := toEvent(ctx, a.config.BrokerURL, p)
+	if err != nil {
+		// The message can't be delivered, don't ask for it again.
+		a.logger.Warnw("Dropping invalid message", zap.String("topic", p.Topic), zap.Error(err))
+		return nil
+	}
+
+	if result := a.ce.Send(ctx, *event); !cloudevents.IsACK(result) {
+		a.logger.Errorw("Failed to send event", zap.String("topic", p.Topic), zap.String("id", event.ID()), zap.Error(result))
+		if p.QoS > 0 {
+			return result
+		}
+	}
+	return nil
+}
+
+// newDialer returns the function opening connections to the broker of env.
+func newDialer(env *envConfig) (func(ctx context.Context) (net.Conn, error), error) {
+	u, err := url.Parse(env.BrokerURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid broker URL: %w", err)
+	}
+	secure, ok := v1alpha1.MQTTBrokerSchemes[u.Scheme]
+	if !ok {
+		return nil, fmt.Errorf("unsupported broker URL scheme %q", u.Scheme)
+	}
+
+	address := u.Host
+	if u.Port() == "" {
+		port := "1883"
+		if secure {
+			port = "8883"
+		}
+		address = net.JoinHostPort(u.Hostname(), port)
+	}
+
+	if !secure {
+		dialer := &net.Dialer{}
+		return func(ctx context.Context) (net.Conn, error) {
+			return dialer.DialContext(ctx, "tcp", address)
+		}, nil
+	}
+
+	config, err := tlsConfig(env, u.Hostname())
+	if err != nil {
+		return nil, err
+	}
+	dialer := &tls.Dialer{Config: config}
+	return func(ctx context.Context) (net.Conn, error) {
+		return dialer.DialContext(ctx, "tcp", address)
+	}, nil
+}
+
+func tlsConfig(env *envConfig, serverName string) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+	}
+
+	if env.CACert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(env.CACert)) {
+			return nil, errors.New("failed to parse the CA certificates")
+		}
+		config.RootCAs = pool
+	}
+
+	if env.Cert != "" || env.Key != "" {
+		cert, err := tls.X509KeyPair([]byte(env.Cert), []byte(env.Key))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
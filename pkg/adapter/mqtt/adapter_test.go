@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/eclipse/paho.golang/packets"
+	"github.com/eclipse/paho.golang/paho"
+	"go.uber.org/zap"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	"knative.dev/eventing/pkg/apis/sources"
+	"knative.dev/eventing/pkg/apis/sources/v1alpha1"
+)
+
+func TestSession(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, server := net.Pipe()
+	defer server.Close()
+
+	ce := adaptertest.NewTestClient()
+	a := &mqttAdapter{
+		config: &envConfig{
+			BrokerURL: "tcp://broker:1883",
+			ClientID:  "client",
+			Username:  "user",
+			Password:  "secret",
+		},
+		topics: []v1alpha1.MQTTTopic{{Filter: "sensors/#", QoS: 1}},
+		ce:     ce,
+		logger: zap.NewNop().Sugar(),
+		dial: func(context.Context) (net.Conn, error) {
+			return client, nil
+		},
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := a.session(ctx)
+		done <- err
+	}()
+
+	connect := readPacket(t, server, packets.CONNECT).Content.(*packets.Connect)
+	if connect.ClientID != "client" || connect.Username != "user" || string(connect.Password) != "secret" {
+		t.Fatalf("Unexpected CONNECT %v", connect)
+	}
+	if connect.CleanStart {
+		t.Error("CONNECT requested a clean start, messages published while disconnected are lost")
+	}
+	writePacket(t, server, packets.CONNACK, &packets.Connack{Properties: &packets.Properties{}})
+
+	subscribe := readPacket(t, server, packets.SUBSCRIBE).Content.(*packets.Subscribe)
+	if len(subscribe.Subscriptions) != 1 || subscribe.Subscriptions[0].Topic != "sensors/#" || subscribe.Subscriptions[0].QoS != 1 {
+		t.Fatalf("Unexpected SUBSCRIBE %v", subscribe)
+	}
+	writePacket(t, server, packets.SUBACK, &packets.Suback{PacketID: subscribe.PacketID, Reasons: []byte{1}, Properties: &packets.Properties{}})
+
+	writePacket(t, server, packets.PUBLISH, &packets.Publish{
+		PacketID:   7,
+		QoS:        1,
+		Topic:      "sensors/kitchen",
+		Payload:    []byte(`{"temperature":21}`),
+		Properties: &packets.Properties{},
+	})
+
+	puback := readPacket(t, server, packets.PUBACK).Content.(*packets.Puback)
+	if puback.PacketID != 7 {
+		t.Errorf("PUBACK packet ID = %d, want 7", puback.PacketID)
+	}
+
+	sent := ce.Sent()
+	if len(sent) != 1 {
+		t.Fatalf("Sent %d events, want 1", len(sent))
+	}
+	if sent[0].Type() != sources.MQTTSourceEventType || sent[0].Subject() != "sensors/kitchen" {
+		t.Errorf("Unexpected event %v", sent[0])
+	}
+
+	cancel()
+	go func() {
+		// Drain the DISCONNECT sent by the client.
+		_, _ = packets.ReadPacket(server)
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("session() = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("session() didn't return")
+	}
+}
+
+func TestSessionRefused(t *testing.T) {
+	client, server := net.Pipe()
+	defer server.Close()
+
+	a := &mqttAdapter{
+		config: &envConfig{BrokerURL: "tcp://broker:1883", ClientID: "client"},
+		topics: []v1alpha1.MQTTTopic{{Filter: "sensors/#"}},
+		ce:     adaptertest.NewTestClient(),
+		logger: zap.NewNop().Sugar(),
+		dial: func(context.Context) (net.Conn, error) {
+			return client, nil
+		},
+	}
+
+	done := make(chan bool, 1)
+	go func() {
+		connected, err := a.session(context.Background())
+		if err == nil {
+			t.Error("session() succeeded, want error")
+		}
+		done <- connected
+	}()
+
+	readPacket(t, server, packets.CONNECT)
+	writePacket(t, server, packets.CONNACK, &packets.Connack{ReasonCode: packets.ConnackNotAuthorized, Properties: &packets.Properties{}})
+
+	select {
+	case connected := <-done:
+		if connected {
+			t.Error("session() connected, want refused")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("session() didn't return")
+	}
+}
+
+func TestHandleRedelivery(t *testing.T) {
+	ce := adaptertest.NewTestClient()
+	a := &mqttAdapter{
+		config: &envConfig{BrokerURL: "tcp://broker:1883"},
+		ce:     ce,
+		logger: zap.NewNop().Sugar(),
+	}
+
+	failing := func(qos byte) *paho.Publish {
+		return &paho.Publish{
+			QoS:   qos,
+			Topic: "sensors/kitchen",
+			Properties: &paho.PublishProperties{
+				User: paho.UserProperties{
+					{Key: "ce-specversion", Value: "1.0"},
+					{Key: "ce-id", Value: "1"},
+					{Key: "ce-source", Value: "/sensors"},
+					{Key: "ce-type", Value: "unit.sendFail"},
+				},
+			},
+		}
+	}
+
+	if err := a.handle(context.Background(), failing(1)); err == nil {
+		t.Error("handle() succeeded for an undelivered QoS 1 message, want error")
+	}
+	if err := a.handle(context.Background(), failing(0)); err != nil {
+		t.Errorf("handle() = %v for an undelivered QoS 0 message, want nil", err)
+	}
+	if len(ce.Sent()) != 2 {
+		t.Errorf("Sent %d events, want 2", len(ce.Sent()))
+	}
+}
+
+func TestNewDialer(t *testing.T) {
+	for _, url := range []string{"tcp://broker", "mqtt://broker:1883", "ssl://broker", "mqtts://broker:8883"} {
+		if _, err := newDialer(&envConfig{BrokerURL: url}); err != nil {
+			t.Errorf("newDialer(%q) = %v", url, err)
+		}
+	}
+	if _, err := newDialer(&envConfig{BrokerURL: "http://broker"}); err == nil {
+		t.Error("newDialer() succeeded for an http URL, want error")
+	}
+	if _, err := newDialer(&envConfig{BrokerURL: "ssl://broker", CACert: "not a certificate"}); err == nil {
+		t.Error("newDialer() succeeded with an invalid CA certificate, want error")
+	}
+}
+
+func readPacket(t *testing.T, conn net.Conn, want byte) *packets.ControlPacket {
+	t.Helper()
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	p, err := packets.ReadPacket(conn)
+	if err != nil {
+		t.Fatalf("Failed to read packet: %v", err)
+	}
+	if p.Type != want {
+		t.Fatalf("Read %s packet, want type %d", p.PacketType(), want)
+	}
+	return p
+}
+
+func writePacket(t *testing.T, conn net.Conn, typ byte, content packets.Packet) {
+	t.Helper()
+	p := packets.NewControlPacket(typ)
+	p.Content = content
+	_ = conn.SetWriteDeadline(time.Now().Add(5 * time.Second))
+	if _, err := p.WriteTo(conn); err != nil {
+		t.Fatalf("Failed to write packet: %v", err)
+	}
+}
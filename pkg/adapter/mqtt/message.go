@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	mqttpaho "github.com/cloudevents/sdk-go/protocol/mqtt_paho/v2"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/eclipse/paho.golang/paho"
+	"github.com/google/uuid"
+
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+var (
+	// extensionName matches the valid CloudEvents extension attribute names.
+	extensionName = regexp.MustCompile(`^[a-z0-9]+$`)
+
+	// reservedAttributes can't be set from the user properties of a message.
+	reservedAttributes = map[string]bool{
+		"specversion":     true,
+		"id":              true,
+		"source":          true,
+		"type":            true,
+		"datacontenttype": true,
+		"dataschema":      true,
+		"subject":         true,
+		"time":            true,
+		"data":            true,
+		"data_base64":     true,
+	}
+)
+
+// toEvent converts the message p, received from the broker at brokerURL, to
+// a CloudEvent. Messages that are already CloudEvents, in either the binary
+// or structured mode of the MQTT protocol binding, are sent as is. Other
+// messages are wrapped in an event whose data is the payload of the message.
+func toEvent(ctx context.Context, brokerURL string, p *paho.Publish) (*cloudevents.Event, error) {
+	message := mqttpaho.NewMessage(p)
+	if message.ReadEncoding() != binding.EncodingUnknown {
+		return binding.ToEvent(ctx, message)
+	}
+
+	event := cloudevents.NewEvent()
+	event.SetID(uuid.New().String())
+	event.SetType(sources.MQTTSourceEventType)
+	event.SetSource(brokerURL)
+	event.SetSubject(p.Topic)
+
+	contentType := ""
+	if p.Properties != nil {
+		contentType = p.Properties.ContentType
+		for _, property := range p.Properties.User {
+			name := strings.ToLower(property.Key)
+			if extensionName.MatchString(name) && !reservedAttributes[name] {
+				event.SetExtension(name, property.Value)
+			}
+		}
+	}
+	if contentType == "" {
+		if json.Valid(p.Payload) {
+			contentType = cloudevents.ApplicationJSON
+		} else {
+			contentType = "application/octet-stream"
+		}
+	}
+	if err := event.SetData(contentType, p.Payload); err != nil {
+		return nil, err
+	}
+
+	return &event, event.Validate()
+}
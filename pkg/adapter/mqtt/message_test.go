@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eclipse/paho.golang/paho"
+
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+func TestToEvent(t *testing.T) {
+	const broker = "tcp://broker:1883"
+
+	tests := map[string]struct {
+		publish         *paho.Publish
+		wantType        string
+		wantSource      string
+		wantSubject     string
+		wantContentType string
+		wantData        string
+		wantExtensions  map[string]interface{}
+	}{
+		"json payload": {
+			publish: &paho.Publish{
+				Topic:   "sensors/kitchen",
+				Payload: []byte(`{"temperature":21}`),
+			},
+			wantType:        sources.MQTTSourceEventType,
+			wantSource:      broker,
+			wantSubject:     "sensors/kitchen",
+			wantContentType: "application/json",
+			wantData:        `{"temperature":21}`,
+		},
+		"binary payload": {
+			publish: &paho.Publish{
+				Topic:   "cameras/door",
+				Payload: []byte{0xff, 0xd8, 0xff},
+			},
+			wantType:        sources.MQTTSourceEventType,
+			wantSource:      broker,
+			wantSubject:     "cameras/door",
+			wantContentType: "application/octet-stream",
+			wantData:        string([]byte{0xff, 0xd8, 0xff}),
+		},
+		"content type and user properties": {
+			publish: &paho.Publish{
+				Topic:   "sensors/kitchen",
+				Payload: []byte("21"),
+				Properties: &paho.PublishProperties{
+					ContentType: "text/plain",
+					User: paho.UserProperties{
+						{Key: "Room", Value: "kitchen"},
+						{Key: "type", Value: "ignored"},
+						{Key: "not-valid", Value: "ignored"},
+					},
+				},
+			},
+			wantType:        sources.MQTTSourceEventType,
+			wantSource:      broker,
+			wantSubject:     "sensors/kitchen",
+			wantContentType: "text/plain",
+			wantData:        "21",
+			wantExtensions:  map[string]interface{}{"room": "kitchen"},
+		},
+		"binary mode cloudevent": {
+			publish: &paho.Publish{
+				Topic:   "sensors/kitchen",
+				Payload: []byte(`{"temperature":21}`),
+				Properties: &paho.PublishProperties{
+					User: paho.UserProperties{
+						{Key: "ce-specversion", Value: "1.0"},
+						{Key: "ce-id", Value: "1"},
+						{Key: "ce-source", Value: "/sensors"},
+						{Key: "ce-type", Value: "com.example.temperature"},
+						{Key: "Content-Type", Value: "application/json"},
+					},
+				},
+			},
+			wantType:        "com.example.temperature",
+			wantSource:      "/sensors",
+			wantContentType: "application/json",
+			wantData:        `{"temperature":21}`,
+		},
+		"structured mode cloudevent": {
+			publish: &paho.Publish{
+				Topic:   "sensors/kitchen",
+				Payload: []byte(`{"specversion":"1.0","id":"1","source":"/sensors","type":"com.example.temperature","subject":"kitchen","datacontenttype":"application/json","data":{"temperature":21}}`),
+				Properties: &paho.PublishProperties{
+					User: paho.UserProperties{
+						{Key: "Content-Type", Value: "application/cloudevents+json"},
+					},
+				},
+			},
+			wantType:        "com.example.temperature",
+			wantSource:      "/sensors",
+			wantSubject:     "kitchen",
+			wantContentType: "application/json",
+			wantData:        `{"temperature":21}`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			event, err := toEvent(context.Background(), broker, tc.publish)
+			if err != nil {
+				t.Fatalf("toEvent() = %v", err)
+			}
+			if event.Type() != tc.wantType {
+				t.Errorf("type = %q, want %q", event.Type(), tc.wantType)
+			}
+			if event.Source() != tc.wantSource {
+				t.Errorf("source = %q, want %q", event.Source(), tc.wantSource)
+			}
+			if event.Subject() != tc.wantSubject {
+				t.Errorf("subject = %q, want %q", event.Subject(), tc.wantSubject)
+			}
+			if event.DataContentType() != tc.wantContentType {
+				t.Errorf("datacontenttype = %q, want %q", event.DataContentType(), tc.wantContentType)
+			}
+			if string(event.Data()) != tc.wantData {
+				t.Errorf("data = %q, want %q", event.Data(), tc.wantData)
+			}
+			for k, v := range tc.wantExtensions {
+				if got := event.Extensions()[k]; got != v {
+					t.Errorf("extension %s = %v, want %v", k, got, v)
+				}
+			}
+			if tc.wantExtensions == nil && len(event.Extensions()) != 0 {
+				t.Errorf("Unexpected extensions %v", event.Extensions())
+			}
+		})
+	}
+}
@@ -33,6 +33,7 @@ import (
 
 	"knative.dev/eventing/pkg/adapter/v2"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	eventingclient "knative.dev/eventing/pkg/client/injection/client"
 )
 
 const (
@@ -63,7 +64,7 @@ func NewAdapter(ctx context.Context, env adapter.EnvConfigAccessor, ceClient clo
 		cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
 	))
 
-	runner := NewCronJobsRunner(adapter.GetClientConfig(ctx), kubeclient.Get(ctx), logging.FromContext(ctx), opts)
+	runner := NewCronJobsRunner(adapter.GetClientConfig(ctx), kubeclient.Get(ctx), eventingclient.Get(ctx), logging.FromContext(ctx), opts)
 
 	return &mtpingAdapter{
 		logger:    logger,
@@ -113,6 +114,13 @@ func (a *mtpingAdapter) Update(ctx context.Context, source *sourcesv1.PingSource
 
 	id = a.runner.AddSchedule(source)
 
+	// Missed runs are only caught up when the schedule is not running yet. Updates of
+	// a running schedule, including the ones recording its fire times, must not replay
+	// the runs not yet recorded.
+	if !ok {
+		a.runner.CatchUp(source, id)
+	}
+
 	a.entryidMu.Lock()
 	a.entryids[key] = id
 	a.entryidMu.Unlock()
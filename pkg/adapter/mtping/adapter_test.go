@@ -92,11 +92,41 @@ func TestUpdateRemoveAdapter(t *testing.T) {
 	}
 }
 
+func TestUpdateCatchesUpOnce(t *testing.T) {
+	ctx, _ := rectesting.SetupFakeContext(t)
+	runner := &testRunner{}
+	adapter := mtpingAdapter{
+		logger:    logging.FromContext(ctx),
+		runner:    runner,
+		entryidMu: sync.RWMutex{},
+		entryids:  make(map[string]cron.EntryID),
+	}
+
+	source := &sourcesv1.PingSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-name",
+			Namespace: "test-ns",
+		},
+	}
+
+	// The second update is the one triggered by recording the fire time.
+	adapter.Update(ctx, source)
+	adapter.Update(ctx, source)
+
+	if runner.catchUps != 1 {
+		t.Errorf("Expected missed runs to be caught up once, got %d", runner.catchUps)
+	}
+}
+
 type testRunner struct {
 	CronJobRunner
+	catchUps int
 }
 
 func (*testRunner) AddSchedule(*sourcesv1.PingSource) cron.EntryID {
 	return cron.EntryID(1)
 }
 func (*testRunner) RemoveSchedule(cron.EntryID) {}
+func (r *testRunner) CatchUp(*sourcesv1.PingSource, cron.EntryID) {
+	r.catchUps++
+}
@@ -19,8 +19,11 @@ package mtping
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"strings"
+	"sync"
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
@@ -28,6 +31,8 @@ import (
 	"github.com/robfig/cron/v3"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/record"
@@ -36,6 +41,7 @@ import (
 	kncloudevents "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/eventing/pkg/adapter/v2/util/crstatusevent"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/eventing/pkg/client/clientset/versioned"
 	"knative.dev/eventing/pkg/observability"
 )
 
@@ -44,6 +50,7 @@ type CronJobRunner interface {
 	Stop()
 	AddSchedule(source *sourcesv1.PingSource) cron.EntryID
 	RemoveSchedule(id cron.EntryID)
+	CatchUp(source *sourcesv1.PingSource, id cron.EntryID)
 }
 
 type cronJobsRunner struct {
@@ -56,24 +63,59 @@ type cronJobsRunner struct {
 	// kubeClient for sending k8s events
 	kubeClient kubernetes.Interface
 
+	// eventingClient for recording the last fire time of PingSources
+	eventingClient versioned.Interface
+
+	// values holds the data of the values ConfigMap of templated PingSources per PingSource UID.
+	// The ConfigMap is fetched when the source is scheduled.
+	values sync.Map // map[types.UID]map[string]string
+
 	clientConfig kncloudevents.ClientConfig
+
+	// fires keeps track of the last fired run per PingSource UID so that
+	// catch-up and sequence numbers do not rely on a possibly stale status.
+	firesMu sync.Mutex
+	fires   map[types.UID]fireRecord
+	// persisted keeps track of the last fired run recorded in the status per
+	// PingSource UID, to not patch the status when nothing changed.
+	persisted map[types.UID]fireRecord
+
+	// ticks holds the context, client and event of every scheduled entry,
+	// so that missed runs are sent the same way as scheduled ones.
+	ticks sync.Map // map[cron.EntryID]tickContext
+}
+
+type tickContext struct {
+	ctx    context.Context
+	client kncloudevents.Client
+	event  cloudevents.Event
+	uid    types.UID
+}
+
+// fireRecord is the scheduled time and the sequence number of the last fired run.
+type fireRecord struct {
+	time     time.Time
+	sequence int64
 }
 
 const (
 	resourceGroup = "pingsources.sources.knative.dev"
 )
 
-func NewCronJobsRunner(cfg adapter.ClientConfig, kubeClient kubernetes.Interface, logger *zap.SugaredLogger, opts ...cron.Option) *cronJobsRunner {
+func NewCronJobsRunner(cfg adapter.ClientConfig, kubeClient kubernetes.Interface, eventingClient versioned.Interface, logger *zap.SugaredLogger, opts ...cron.Option) *cronJobsRunner {
 	return &cronJobsRunner{
-		cron:         *cron.New(opts...),
-		Logger:       logger,
-		kubeClient:   kubeClient,
-		clientConfig: cfg,
+		cron:           *cron.New(opts...),
+		Logger:         logger,
+		kubeClient:     kubeClient,
+		eventingClient: eventingClient,
+		clientConfig:   cfg,
+		fires:          make(map[types.UID]fireRecord),
+		persisted:      make(map[types.UID]fireRecord),
 	}
 }
 
 func (a *cronJobsRunner) AddSchedule(source *sourcesv1.PingSource) cron.EntryID {
-	event, err := makeEvent(source)
+	event, err := makeEvent(source, nil)
 	if err != nil {
 		a.Logger.Error("failed to makeEvent: ", zap.Error(err))
 	}
@@ -117,12 +159,56 @@ func (a *cronJobsRunner) AddSchedule(source *sourcesv1.PingSource) cron.EntryID
 		return -1
 	}
 
-	id, _ := a.cron.AddFunc(schedule, a.cronTick(ctx, client, source, event))
+	if _, err := a.loadTemplateValues(ctx, source); err != nil {
+		a.Logger.Desugar().Warn("Failed to get template values, retrying on the next run",
+			zap.String("name", source.GetName()),
+			zap.String("namespace", source.GetNamespace()),
+			zap.Error(err),
+		)
+	}
+
+	var id cron.EntryID
+	if source.Spec.At != nil {
+		id = a.cron.Schedule(atSchedule{at: source.Spec.At.Time}, cron.FuncJob(a.cronTick(ctx, client, source, event)))
+	} else {
+		id, _ = a.cron.AddFunc(schedule, a.cronTick(ctx, client, source, event))
+	}
+	a.ticks.Store(id, tickContext{ctx: ctx, client: client, event: event, uid: source.UID})
 	return id
 }
 
+// CatchUp fires the runs of the given source missed since the last fire time
+// recorded in its status. It must be called once, right after the schedule of
+// the source has been added, as the fired runs are only persisted asynchronously.
+func (a *cronJobsRunner) CatchUp(source *sourcesv1.PingSource, id cron.EntryID) {
+	v, ok := a.ticks.Load(id)
+	if !ok {
+		return
+	}
+	tick := v.(tickContext)
+
+	missed := a.missedRuns(source, a.cron.Entry(id).Schedule, time.Now())
+	if len(missed) == 0 {
+		return
+	}
+
+	a.Logger.Infow("Catching up missed runs",
+		zap.String("name", source.GetName()),
+		zap.String("namespace", source.GetNamespace()),
+		zap.Int("count", len(missed)),
+	)
+	go func() {
+		for _, scheduled := range missed {
+			a.fire(tick.ctx, tick.client, source, tick.event, scheduled)
+		}
+	}()
+}
+
 func (a *cronJobsRunner) RemoveSchedule(id cron.EntryID) {
 	a.cron.Remove(id)
+	if v, ok := a.ticks.LoadAndDelete(id); ok {
+		a.values.Delete(v.(tickContext).uid)
+	}
 }
 
 func (a *cronJobsRunner) Start(stopCh <-chan struct{}) {
@@ -139,30 +225,232 @@ func (a *cronJobsRunner) Stop() {
 }
 
 func (a *cronJobsRunner) cronTick(ctx context.Context, client kncloudevents.Client, src *sourcesv1.PingSource, event cloudevents.Event) func() {
+	return func() {
+		// Cron schedules have a one second precision, ticks start right after the scheduled time.
+		a.fire(ctx, client, src, event, time.Now().Truncate(time.Second))
+	}
+}
+
+func (a *cronJobsRunner) fire(ctx context.Context, client kncloudevents.Client, src *sourcesv1.PingSource, event cloudevents.Event, scheduled time.Time) {
 	target := src.Status.SinkURI.String()
+	var sequence int64
+	if recordsFires(src) {
+		sequence = a.nextSequence(src)
+	}
 
-	return func() {
-		event := event.Clone()
-		event.SetID(uuid.New().String()) // provide an ID here so we can track it with logging
-		defer a.Logger.Debug("Finished sending cloudevent id: ", event.ID())
-		source := event.Context.GetSource()
+	if src.Spec.Template != nil {
+		values, err := a.templateValues(ctx, src, scheduled, sequence)
+		if err != nil {
+			a.Logger.Errorw("Failed to get template values", zap.String("source", event.Source()), zap.Error(err))
+			return
+		}
+		if event, err = makeEvent(src, values); err != nil {
+			a.Logger.Errorw("Failed to render event template", zap.String("source", event.Source()), zap.Error(err))
+			return
+		}
+	}
+
+	event = event.Clone()
+	event.SetID(uuid.New().String()) // provide an ID here so we can track it with logging
+	defer a.Logger.Debug("Finished sending cloudevent id: ", event.ID())
+	source := event.Context.GetSource()
+
+	// Provide a delay so not all ping fired instantaneously distribute load on resources.
+	time.Sleep(time.Duration(rand.Intn(500)) * time.Millisecond) //nolint:gosec // Cryptographic randomness not necessary here.
+
+	a.Logger.Debugf("sending cloudevent id: %s, source: %s, target: %s", event.ID(), source, target)
+
+	if result := client.Send(ctx, event); !cloudevents.IsACK(result) {
+		// Exhausted number of retries. Event is lost.
+		a.Logger.Error("failed to send cloudevent result: ", zap.Any("result", result),
+			zap.String("source", source), zap.String("target", src.Status.SinkURI.String()), zap.String("id", event.ID()))
+	}
 
-		// Provide a delay so not all ping fired instantaneously distribute load on resources.
-		time.Sleep(time.Duration(rand.Intn(500)) * time.Millisecond) //nolint:gosec // Cryptographic randomness not necessary here.
+	client.CloseIdleConnections()
 
-		a.Logger.Debugf("sending cloudevent id: %s, source: %s, target: %s", event.ID(), source, target)
+	a.recordFire(src, scheduled, sequence)
+}
+
+// missedRuns returns the runs of the given source scheduled after the last fire
+// time persisted in its status and before now, filtered according to the source
+// catch-up policy.
+func (a *cronJobsRunner) missedRuns(source *sourcesv1.PingSource, schedule cron.Schedule, now time.Time) []time.Time {
+	policy, limit := catchUpPolicy(source)
+	if policy == sourcesv1.PingSourceCatchUpNone || schedule == nil {
+		return nil
+	}
+
+	var last time.Time
+	fired := source.Status.LastFireTime != nil
+	if fired {
+		last = source.Status.LastFireTime.Time
+	}
+
+	if source.Spec.At != nil {
+		at := source.Spec.At.Time
+		if !at.Before(now) || (fired && !at.After(last)) {
+			return nil
+		}
+		return []time.Time{at}
+	}
+
+	if !fired {
+		// Never fired before, there is nothing to catch up.
+		return nil
+	}
 
-		if result := client.Send(ctx, event); !cloudevents.IsACK(result) {
-			// Exhausted number of retries. Event is lost.
-			a.Logger.Error("failed to send cloudevent result: ", zap.Any("result", result),
-				zap.String("source", source), zap.String("target", src.Status.SinkURI.String()), zap.String("id", event.ID()))
+	var missed []time.Time
+	for t := schedule.Next(last); !t.IsZero() && t.Before(now); t = schedule.Next(t) {
+		missed = append(missed, t)
+		if len(missed) > limit {
+			missed = missed[1:]
 		}
+	}
 
-		client.CloseIdleConnections()
+	if policy == sourcesv1.PingSourceCatchUpLatest && len(missed) > 1 {
+		missed = missed[len(missed)-1:]
 	}
+	return missed
 }
 
-func makeEvent(source *sourcesv1.PingSource) (cloudevents.Event, error) {
+// lastFire returns the most recent fire time and sequence number known for
+// the given source, either from this runner or from the source status.
+func (a *cronJobsRunner) lastFire(source *sourcesv1.PingSource) (time.Time, int64, bool) {
+	a.firesMu.Lock()
+	defer a.firesMu.Unlock()
+	return a.lastFireLocked(source)
+}
+
+func (a *cronJobsRunner) lastFireLocked(source *sourcesv1.PingSource) (time.Time, int64, bool) {
+	last, fired := a.fires[source.UID]
+
+	if source.Status.LastFireTime != nil && (!fired || source.Status.LastFireTime.After(last.time)) {
+		last.time = source.Status.LastFireTime.Time
+		fired = true
+	}
+	if source.Status.Sequence > last.sequence {
+		last.sequence = source.Status.Sequence
+	}
+	return last.time, last.sequence, fired
+}
+
+// nextSequence returns the sequence number of the next run of the given source.
+func (a *cronJobsRunner) nextSequence(source *sourcesv1.PingSource) int64 {
+	a.firesMu.Lock()
+	defer a.firesMu.Unlock()
+
+	last, sequence, _ := a.lastFireLocked(source)
+	sequence++
+	a.fires[source.UID] = fireRecord{time: last, sequence: sequence}
+	return sequence
+}
+
+// recordFire records the scheduled time and sequence number of a fired run in
+// the source status so that they survive adapter restarts.
+func (a *cronJobsRunner) recordFire(source *sourcesv1.PingSource, scheduled time.Time, sequence int64) {
+	if !recordsFires(source) {
+		return
+	}
+
+	a.firesMu.Lock()
+	last, lastSequence, fired := a.lastFireLocked(source)
+	if !fired || scheduled.After(last) {
+		last = scheduled
+	}
+	if sequence > lastSequence {
+		lastSequence = sequence
+	}
+	record := fireRecord{time: last, sequence: lastSequence}
+	a.fires[source.UID] = record
+
+	// Every patch of the status triggers a reconciliation, skip it when nothing changed.
+	persisted, ok := a.persisted[source.UID]
+	if !ok && source.Status.LastFireTime != nil {
+		persisted, ok = fireRecord{time: source.Status.LastFireTime.Time, sequence: source.Status.Sequence}, true
+	}
+	if ok && persisted.time.Equal(last) && persisted.sequence == lastSequence {
+		a.firesMu.Unlock()
+		return
+	}
+	a.persisted[source.UID] = record
+	a.firesMu.Unlock()
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"lastFireTime": metav1.NewTime(last),
+			"sequence":     lastSequence,
+		},
+	})
+	if err != nil {
+		a.Logger.Errorw("Failed to marshal last fire time patch", zap.Error(err))
+		return
+	}
+
+	_, err = a.eventingClient.SourcesV1().PingSources(source.Namespace).
+		Patch(context.Background(), source.Name, types.MergePatchType, patch, metav1.PatchOptions{}, "status")
+	if err != nil {
+		a.Logger.Errorw("Failed to record last fire time",
+			zap.String("name", source.GetName()),
+			zap.String("namespace", source.GetNamespace()),
+			zap.Error(err),
+		)
+	}
+}
+
+// recordsFires returns true when fired runs of the given source are recorded
+// in its status, that is when they are needed to catch up missed runs or to
+// continue the sequence of the template after a restart.
+func recordsFires(source *sourcesv1.PingSource) bool {
+	policy, _ := catchUpPolicy(source)
+	return policy != sourcesv1.PingSourceCatchUpNone || usesSequence(source.Spec.Template)
+}
+
+// usesSequence returns true when the given template renders the sequence number.
+func usesSequence(tmpl *sourcesv1.PingSourceTemplate) bool {
+	if tmpl == nil {
+		return false
+	}
+	if strings.Contains(tmpl.Data, ".Sequence") {
+		return true
+	}
+	for _, ext := range tmpl.Extensions {
+		if strings.Contains(ext, ".Sequence") {
+			return true
+		}
+	}
+	return false
+}
+
+func catchUpPolicy(source *sourcesv1.PingSource) (sourcesv1.PingSourceCatchUpPolicy, int) {
+	if source.Spec.CatchUp == nil || source.Spec.CatchUp.Policy == "" {
+		return sourcesv1.PingSourceCatchUpNone, 0
+	}
+	limit := sourcesv1.DefaultPingSourceCatchUpLimit
+	if source.Spec.CatchUp.Limit != nil {
+		limit = int(*source.Spec.CatchUp.Limit)
+	}
+	return source.Spec.CatchUp.Policy, limit
+}
+
+// atSchedule is a cron.Schedule activated once at the given time.
+type atSchedule struct {
+	at time.Time
+}
+
+var _ cron.Schedule = atSchedule{}
+
+// Next implements cron.Schedule. A zero time means the schedule never
+// activates again.
+func (s atSchedule) Next(t time.Time) time.Time {
+	if t.Before(s.at) {
+		return s.at
+	}
+	return time.Time{}
+}
+
+// makeEvent creates the event sent by the given source. When the source has a
+// template, the given values are rendered into the event data and extensions.
+func makeEvent(source *sourcesv1.PingSource, values *templateValues) (cloudevents.Event, error) {
 	event := cloudevents.NewEvent()
 	event.SetType(sourcesv1.PingSourceEventType)
 	event.SetSource(sourcesv1.PingSourceSource(source.Namespace, source.Name))
@@ -172,6 +460,13 @@ func makeEvent(source *sourcesv1.PingSource) (cloudevents.Event, error) {
 		}
 	}
 
+	if source.Spec.Template != nil && values != nil {
+		if err := renderTemplate(&event, source.Spec.Template, source.Spec.ContentType, values); err != nil {
+			return event, err
+		}
+		return event, nil
+	}
+
 	var data interface{}
 	if source.Spec.DataBase64 != "" {
 		data, _ = base64.StdEncoding.DecodeString(source.Spec.DataBase64)
@@ -31,6 +31,7 @@ import (
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/cloudevents/sdk-go/v2/binding"
 	bindingshttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/robfig/cron/v3"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -45,6 +46,8 @@ import (
 
 	"knative.dev/eventing/pkg/adapter/v2"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	eventingclient "knative.dev/eventing/pkg/client/injection/client"
+	fakeeventingclient "knative.dev/eventing/pkg/client/injection/client/fake"
 	"knative.dev/eventing/pkg/eventingtls/eventingtlstesting"
 )
 
@@ -185,7 +188,7 @@ func TestAddRunRemoveSchedules(t *testing.T) {
 			defer s.Close()
 			url, _ := apis.ParseURL(s.URL)
 
-			runner := NewCronJobsRunner(adapter.ClientConfig{}, kubeclient.Get(ctx), logger)
+			runner := NewCronJobsRunner(adapter.ClientConfig{}, kubeclient.Get(ctx), eventingclient.Get(ctx), logger)
 			tc.src.Status.SinkURI = url
 			entryId := runner.AddSchedule(tc.src)
 
@@ -286,7 +289,7 @@ func TestSendEventsTLS(t *testing.T) {
 			cc := adapter.ClientConfig{
 				CeOverrides: tc.src.Spec.CloudEventOverrides,
 			}
-			runner := NewCronJobsRunner(cc, kubeclient.Get(ctx), logger)
+			runner := NewCronJobsRunner(cc, kubeclient.Get(ctx), eventingclient.Get(ctx), logger)
 			entryId := runner.AddSchedule(tc.src)
 
 			entry := runner.cron.Entry(entryId)
@@ -313,7 +316,7 @@ func TestStartStopCron(t *testing.T) {
 	ctx, _ := rectesting.SetupFakeContext(t)
 	logger := logging.FromContext(ctx)
 
-	runner := NewCronJobsRunner(adapter.ClientConfig{}, kubeclient.Get(ctx), logger)
+	runner := NewCronJobsRunner(adapter.ClientConfig{}, kubeclient.Get(ctx), eventingclient.Get(ctx), logger)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	wctx, wcancel := context.WithCancel(context.Background())
@@ -350,7 +353,7 @@ func TestStartStopCronDelayWait(t *testing.T) {
 	defer s.Close()
 	url, _ := apis.ParseURL(s.URL)
 
-	runner := NewCronJobsRunner(adapter.ClientConfig{}, kubeclient.Get(ctx), logger)
+	runner := NewCronJobsRunner(adapter.ClientConfig{}, kubeclient.Get(ctx), eventingclient.Get(ctx), logger)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -389,6 +392,208 @@ func TestStartStopCronDelayWait(t *testing.T) {
 	validateSent(t, *events, []byte("some delayed data"), cloudevents.TextPlain, nil)
 }
 
+func TestMissedRuns(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 30, 30, 0, time.UTC)
+	at := func(h, m int) time.Time {
+		return time.Date(2024, 1, 1, h, m, 0, 0, time.UTC)
+	}
+	everyMinute, _ := cron.ParseStandard("* * * * *")
+
+	testCases := map[string]struct {
+		spec     sourcesv1.PingSourceSpec
+		lastFire *time.Time
+		want     []time.Time
+	}{
+		"no catch-up": {
+			spec:     sourcesv1.PingSourceSpec{Schedule: "* * * * *"},
+			lastFire: ptrTime(at(10, 25)),
+		},
+		"catch-up none": {
+			spec: sourcesv1.PingSourceSpec{
+				Schedule: "* * * * *",
+				CatchUp:  &sourcesv1.PingSourceCatchUp{Policy: sourcesv1.PingSourceCatchUpNone},
+			},
+			lastFire: ptrTime(at(10, 25)),
+		},
+		"never fired": {
+			spec: sourcesv1.PingSourceSpec{
+				Schedule: "* * * * *",
+				CatchUp:  &sourcesv1.PingSourceCatchUp{Policy: sourcesv1.PingSourceCatchUpAll, Limit: pointer.Int32(10)},
+			},
+		},
+		"catch-up latest": {
+			spec: sourcesv1.PingSourceSpec{
+				Schedule: "* * * * *",
+				CatchUp:  &sourcesv1.PingSourceCatchUp{Policy: sourcesv1.PingSourceCatchUpLatest},
+			},
+			lastFire: ptrTime(at(10, 25)),
+			want:     []time.Time{at(10, 30)},
+		},
+		"catch-up all": {
+			spec: sourcesv1.PingSourceSpec{
+				Schedule: "* * * * *",
+				CatchUp:  &sourcesv1.PingSourceCatchUp{Policy: sourcesv1.PingSourceCatchUpAll, Limit: pointer.Int32(10)},
+			},
+			lastFire: ptrTime(at(10, 27)),
+			want:     []time.Time{at(10, 28), at(10, 29), at(10, 30)},
+		},
+		"catch-up all bounded": {
+			spec: sourcesv1.PingSourceSpec{
+				Schedule: "* * * * *",
+				CatchUp:  &sourcesv1.PingSourceCatchUp{Policy: sourcesv1.PingSourceCatchUpAll, Limit: pointer.Int32(2)},
+			},
+			lastFire: ptrTime(at(9, 0)),
+			want:     []time.Time{at(10, 29), at(10, 30)},
+		},
+		"catch-up all default limit": {
+			spec: sourcesv1.PingSourceSpec{
+				Schedule: "* * * * *",
+				CatchUp:  &sourcesv1.PingSourceCatchUp{Policy: sourcesv1.PingSourceCatchUpAll},
+			},
+			lastFire: ptrTime(at(9, 0)),
+			want: []time.Time{at(10, 21), at(10, 22), at(10, 23), at(10, 24), at(10, 25),
+				at(10, 26), at(10, 27), at(10, 28), at(10, 29), at(10, 30)},
+		},
+		"one-shot missed": {
+			spec: sourcesv1.PingSourceSpec{
+				At:      &metav1.Time{Time: at(10, 0)},
+				CatchUp: &sourcesv1.PingSourceCatchUp{Policy: sourcesv1.PingSourceCatchUpLatest},
+			},
+			want: []time.Time{at(10, 0)},
+		},
+		"one-shot already fired": {
+			spec: sourcesv1.PingSourceSpec{
+				At:      &metav1.Time{Time: at(10, 0)},
+				CatchUp: &sourcesv1.PingSourceCatchUp{Policy: sourcesv1.PingSourceCatchUpLatest},
+			},
+			lastFire: ptrTime(at(10, 0)),
+		},
+		"one-shot in the future": {
+			spec: sourcesv1.PingSourceSpec{
+				At:      &metav1.Time{Time: at(11, 0)},
+				CatchUp: &sourcesv1.PingSourceCatchUp{Policy: sourcesv1.PingSourceCatchUpLatest},
+			},
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			ctx, _ := rectesting.SetupFakeContext(t)
+			runner := NewCronJobsRunner(adapter.ClientConfig{}, kubeclient.Get(ctx), eventingclient.Get(ctx), logging.FromContext(ctx))
+
+			src := &sourcesv1.PingSource{Spec: tc.spec}
+			if tc.lastFire != nil {
+				src.Status.LastFireTime = &metav1.Time{Time: *tc.lastFire}
+			}
+
+			var schedule cron.Schedule = everyMinute
+			if tc.spec.At != nil {
+				schedule = atSchedule{at: tc.spec.At.Time}
+			}
+
+			got := runner.missedRuns(src, schedule, now)
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("Unexpected missed runs, want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRecordFire(t *testing.T) {
+	ctx, _ := rectesting.SetupFakeContext(t)
+	src := &sourcesv1.PingSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-name",
+			Namespace: "test-ns",
+			UID:       "test-uid",
+		},
+		Spec: sourcesv1.PingSourceSpec{
+			Schedule: "* * * * *",
+			CatchUp:  &sourcesv1.PingSourceCatchUp{Policy: sourcesv1.PingSourceCatchUpLatest},
+		},
+	}
+	client := eventingclient.Get(ctx)
+	if _, err := client.SourcesV1().PingSources(src.Namespace).Create(ctx, src, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	runner := NewCronJobsRunner(adapter.ClientConfig{}, kubeclient.Get(ctx), client, logging.FromContext(ctx))
+
+	scheduled := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+	runner.recordFire(src, scheduled, 3)
+
+	got, err := client.SourcesV1().PingSources(src.Namespace).Get(ctx, src.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status.LastFireTime == nil || !got.Status.LastFireTime.Equal(&metav1.Time{Time: scheduled}) {
+		t.Errorf("Expected last fire time %v, got %v", scheduled, got.Status.LastFireTime)
+	}
+	if got.Status.Sequence != 3 {
+		t.Errorf("Expected sequence 3, got %d", got.Status.Sequence)
+	}
+
+	if last, sequence, fired := runner.lastFire(src); !fired || !last.Equal(scheduled) || sequence != 3 {
+		t.Errorf("Expected in-memory last fire time %v and sequence 3, got %v and %d", scheduled, last, sequence)
+	}
+
+	// Recording an older run again must not patch the status.
+	fakeClient := fakeeventingclient.Get(ctx)
+	patches := len(fakeClient.Actions())
+	runner.recordFire(src, scheduled.Add(-time.Minute), 2)
+	if got := len(fakeClient.Actions()); got != patches {
+		t.Errorf("Expected no status patch for an unchanged last fire time, got %d new actions", got-patches)
+	}
+
+	if next := runner.nextSequence(src); next != 4 {
+		t.Errorf("Expected next sequence 4, got %d", next)
+	}
+}
+
+func TestRecordsFires(t *testing.T) {
+	testCases := map[string]struct {
+		spec sourcesv1.PingSourceSpec
+		want bool
+	}{
+		"no catch-up nor template": {
+			spec: sourcesv1.PingSourceSpec{Schedule: "* * * * *"},
+		},
+		"catch-up": {
+			spec: sourcesv1.PingSourceSpec{
+				CatchUp: &sourcesv1.PingSourceCatchUp{Policy: sourcesv1.PingSourceCatchUpLatest},
+			},
+			want: true,
+		},
+		"template without sequence": {
+			spec: sourcesv1.PingSourceSpec{
+				Template: &sourcesv1.PingSourceTemplate{Data: `{"scheduled":"{{ .ScheduledTime }}"}`},
+			},
+		},
+		"template with sequence in data": {
+			spec: sourcesv1.PingSourceSpec{
+				Template: &sourcesv1.PingSourceTemplate{Data: `{"seq":{{ .Sequence }}}`},
+			},
+			want: true,
+		},
+		"template with sequence in extensions": {
+			spec: sourcesv1.PingSourceSpec{
+				Template: &sourcesv1.PingSourceTemplate{Extensions: map[string]string{"seq": "{{ .Sequence }}"}},
+			},
+			want: true,
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			if got := recordsFires(&sourcesv1.PingSource{Spec: tc.spec}); got != tc.want {
+				t.Errorf("Unexpected recordsFires, want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
+
 func validateSent(t *testing.T, events []cloudevents.Event, wantData []byte, wantContentType string, extensions map[string]string) {
 	err := wait.PollUntilContextTimeout(context.Background(), time.Second, time.Minute, true, func(ctx context.Context) (done bool, err error) {
 		return len(events) == 1, nil
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mtping
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/template"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+// templateValues are the values available to PingSource templates.
+type templateValues struct {
+	ScheduledTime string
+	FireTime      string
+	Sequence      int64
+	Name          string
+	Namespace     string
+	Values        map[string]string
+}
+
+// templateValues returns the values of the run of the given source scheduled at the given time.
+func (a *cronJobsRunner) templateValues(ctx context.Context, source *sourcesv1.PingSource, scheduled time.Time, sequence int64) (*templateValues, error) {
+	values := &templateValues{
+		ScheduledTime: scheduled.Format(time.RFC3339),
+		FireTime:      time.Now().Format(time.RFC3339),
+		Sequence:      sequence,
+		Name:          source.Name,
+		Namespace:     source.Namespace,
+	}
+
+	if source.Spec.Template.ValuesConfigMap != "" {
+		data, ok := a.values.Load(source.UID)
+		if !ok {
+			// the ConfigMap could not be fetched when the source was scheduled
+			loaded, err := a.loadTemplateValues(ctx, source)
+			if err != nil {
+				return nil, err
+			}
+			data = loaded
+		}
+		values.Values = data.(map[string]string)
+	}
+	return values, nil
+}
+
+// loadTemplateValues fetches the values ConfigMap of the given templated source and caches its data
+// for the runs of the source.
+func (a *cronJobsRunner) loadTemplateValues(ctx context.Context, source *sourcesv1.PingSource) (map[string]string, error) {
+	a.values.Delete(source.UID)
+
+	if source.Spec.Template == nil || source.Spec.Template.ValuesConfigMap == "" {
+		return nil, nil
+	}
+
+	name := source.Spec.Template.ValuesConfigMap
+	cm, err := a.kubeClient.CoreV1().ConfigMaps(source.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ConfigMap %s/%s: %w", source.Namespace, name, err)
+	}
+
+	data := cm.Data
+	if data == nil {
+		data = map[string]string{}
+	}
+	a.values.Store(source.UID, data)
+	return data, nil
+}
+
+// renderTemplate renders the given template into the data and extensions of the event.
+func renderTemplate(event *cloudevents.Event, tmpl *sourcesv1.PingSourceTemplate, contentType string, values *templateValues) error {
+	for key, ext := range tmpl.Extensions {
+		rendered, err := render(key, ext, values)
+		if err != nil {
+			return err
+		}
+		event.SetExtension(key, rendered)
+	}
+
+	if tmpl.Data == "" {
+		return nil
+	}
+
+	data, err := render("data", tmpl.Data, values)
+	if err != nil {
+		return err
+	}
+	if err := event.SetData(contentType, []byte(data)); err != nil {
+		return fmt.Errorf("error when SetData(%v, %v), err: %v", contentType, data, err)
+	}
+	return nil
+}
+
+func render(name, text string, values *templateValues) (string, error) {
+	t, err := template.New(name).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template %q: %w", name, err)
+	}
+
+	var b bytes.Buffer
+	if err := t.Execute(&b, values); err != nil {
+		return "", fmt.Errorf("failed to execute template %q: %w", name, err)
+	}
+	return b.String(), nil
+}
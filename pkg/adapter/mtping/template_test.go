@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mtping
+
+import (
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/logging"
+	rectesting "knative.dev/pkg/reconciler/testing"
+
+	"knative.dev/eventing/pkg/adapter/v2"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	eventingclient "knative.dev/eventing/pkg/client/injection/client"
+)
+
+func TestMakeEventFromTemplate(t *testing.T) {
+	ctx, _ := rectesting.SetupFakeContext(t)
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ping-values",
+			Namespace: "test-ns",
+		},
+		Data: map[string]string{"job": "backup"},
+	}
+	if _, err := kubeclient.Get(ctx).CoreV1().ConfigMaps(cm.Namespace).Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	src := &sourcesv1.PingSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-name",
+			Namespace: "test-ns",
+		},
+		Spec: sourcesv1.PingSourceSpec{
+			Schedule:    "* * * * *",
+			ContentType: cloudevents.ApplicationJSON,
+			Template: &sourcesv1.PingSourceTemplate{
+				Data: `{"job":"{{ .Values.job }}","scheduled":"{{ .ScheduledTime }}","seq":{{ .Sequence }}}`,
+				Extensions: map[string]string{
+					"sequence": "{{ .Sequence }}",
+					"pingname": "{{ .Namespace }}.{{ .Name }}",
+				},
+				ValuesConfigMap: cm.Name,
+			},
+		},
+	}
+
+	runner := NewCronJobsRunner(adapter.ClientConfig{}, kubeclient.Get(ctx), eventingclient.Get(ctx), logging.FromContext(ctx))
+
+	scheduled := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+	values, err := runner.templateValues(ctx, src, scheduled, 7)
+	if err != nil {
+		t.Fatal("Unexpected error getting template values:", err)
+	}
+
+	event, err := makeEvent(src, values)
+	if err != nil {
+		t.Fatal("Unexpected error making event:", err)
+	}
+
+	wantData := `{"job":"backup","scheduled":"2024-01-01T10:30:00Z","seq":7}`
+	if got := string(event.Data()); got != wantData {
+		t.Errorf("Unexpected data, want %q, got %q", wantData, got)
+	}
+	if got := event.DataContentType(); got != cloudevents.ApplicationJSON {
+		t.Errorf("Unexpected content type, want %q, got %q", cloudevents.ApplicationJSON, got)
+	}
+	if got := event.Extensions()["sequence"]; got != "7" {
+		t.Errorf("Unexpected sequence extension, want %q, got %v", "7", got)
+	}
+	if got := event.Extensions()["pingname"]; got != "test-ns.test-name" {
+		t.Errorf("Unexpected pingname extension, want %q, got %v", "test-ns.test-name", got)
+	}
+}
+
+func TestTemplateValuesMissingConfigMap(t *testing.T) {
+	ctx, _ := rectesting.SetupFakeContext(t)
+
+	src := &sourcesv1.PingSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-name",
+			Namespace: "test-ns",
+		},
+		Spec: sourcesv1.PingSourceSpec{
+			Template: &sourcesv1.PingSourceTemplate{
+				Data:            "{{ .Values.job }}",
+				ValuesConfigMap: "missing",
+			},
+		},
+	}
+
+	runner := NewCronJobsRunner(adapter.ClientConfig{}, kubeclient.Get(ctx), eventingclient.Get(ctx), logging.FromContext(ctx))
+	if _, err := runner.templateValues(ctx, src, time.Now(), 1); err == nil {
+		t.Error("Expected an error when the values ConfigMap does not exist")
+	}
+}
+
+func TestTemplateValuesCachedPerSource(t *testing.T) {
+	ctx, _ := rectesting.SetupFakeContext(t)
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ping-values",
+			Namespace: "test-ns",
+		},
+		Data: map[string]string{"job": "backup"},
+	}
+	configMaps := kubeclient.Get(ctx).CoreV1().ConfigMaps(cm.Namespace)
+	if _, err := configMaps.Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	src := &sourcesv1.PingSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-name",
+			Namespace: "test-ns",
+			UID:       "test-uid",
+		},
+		Spec: sourcesv1.PingSourceSpec{
+			Template: &sourcesv1.PingSourceTemplate{
+				Data:            "{{ .Values.job }}",
+				ValuesConfigMap: cm.Name,
+			},
+		},
+	}
+
+	runner := NewCronJobsRunner(adapter.ClientConfig{}, kubeclient.Get(ctx), eventingclient.Get(ctx), logging.FromContext(ctx))
+	if _, err := runner.loadTemplateValues(ctx, src); err != nil {
+		t.Fatal("Unexpected error loading template values:", err)
+	}
+
+	cm.Data = map[string]string{"job": "cleanup"}
+	if _, err := configMaps.Update(ctx, cm, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := runner.templateValues(ctx, src, time.Now(), 1)
+	if err != nil {
+		t.Fatal("Unexpected error getting template values:", err)
+	}
+	if got := values.Values["job"]; got != "backup" {
+		t.Errorf("Unexpected value of the run, want the value cached when scheduled %q, got %q", "backup", got)
+	}
+
+	if _, err := runner.loadTemplateValues(ctx, src); err != nil {
+		t.Fatal("Unexpected error loading template values:", err)
+	}
+	values, err = runner.templateValues(ctx, src, time.Now(), 2)
+	if err != nil {
+		t.Fatal("Unexpected error getting template values:", err)
+	}
+	if got := values.Values["job"]; got != "cleanup" {
+		t.Errorf("Unexpected value after rescheduling, want %q, got %q", "cleanup", got)
+	}
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	ws "github.com/gorilla/websocket"
+	"go.uber.org/zap"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/apis/sources/v1alpha1"
+)
+
+const (
+	// handshakeTimeout is how long the opening handshake can take.
+	handshakeTimeout = 30 * time.Second
+	// pingInterval is the interval between the pings sent to the server. The
+	// connection is considered lost when nothing, not even a pong, is
+	// received for two intervals.
+	pingInterval = 30 * time.Second
+)
+
+type envConfig struct {
+	adapter.EnvConfig
+
+	// URL is the URL of the WebSocket server.
+	URL string `envconfig:"WEBSOCKET_URL" required:"true"`
+
+	// Headers is the JSON encoded list of the names of the headers sent in
+	// the opening handshake. The value of the i-th header is held by the
+	// WEBSOCKET_HEADER_<i> environment variable.
+	Headers string `envconfig:"WEBSOCKET_HEADERS"`
+
+	// Subprotocols are the subprotocols requested in the opening handshake.
+	Subprotocols []string `envconfig:"WEBSOCKET_SUBPROTOCOLS"`
+
+	// Format is how the messages are turned into CloudEvents, one of Auto,
+	// CloudEvent or Raw.
+	Format string `envconfig:"WEBSOCKET_FORMAT" default:"Auto"`
+
+	// EventType is the type of the CloudEvents wrapping the messages.
+	EventType string `envconfig:"WEBSOCKET_EVENT_TYPE" default:"dev.knative.sources.websocket.message"`
+
+	ReconnectInitialDelay time.Duration `envconfig:"WEBSOCKET_RECONNECT_INITIAL_DELAY" default:"1s"`
+	ReconnectMaxDelay     time.Duration `envconfig:"WEBSOCKET_RECONNECT_MAX_DELAY" default:"2m"`
+}
+
+type websocketAdapter struct {
+	config *envConfig
+	header http.Header
+	dialer *ws.Dialer
+	ce     cloudevents.Client
+	logger *zap.SugaredLogger
+
+	// connected is true while the adapter is connected to the server.
+	connected *atomic.Bool
+}
+
+var _ adapter.Adapter = (*websocketAdapter)(nil)
+
+type connectedKey struct{}
+
+// WithReadiness returns a context whose readiness probe, served by the
+// adapter main, only succeeds while the adapter created with it is connected
+// to the WebSocket server.
+func WithReadiness(ctx context.Context) context.Context {
+	connected := &atomic.Bool{}
+	ctx = context.WithValue(ctx, connectedKey{}, connected)
+	return injection.AddReadiness(ctx, func(w http.ResponseWriter, _ *http.Request) {
+		if !connected.Load() {
+			http.Error(w, "not connected to the WebSocket server", http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func NewEnvConfig() adapter.EnvConfigAccessor {
+	return &envConfig{}
+}
+
+func NewAdapter(ctx context.Context, processed adapter.EnvConfigAccessor, ceClient cloudevents.Client) adapter.Adapter {
+	logger := logging.FromContext(ctx)
+	env := processed.(*envConfig)
+
+	header, err := handshakeHeader(env.Headers, os.Getenv)
+	if err != nil {
+		logger.Fatalw("Failed to parse headers", zap.Error(err))
+	}
+
+	connected, ok := ctx.Value(connectedKey{}).(*atomic.Bool)
+	if !ok {
+		connected = &atomic.Bool{}
+	}
+
+	return &websocketAdapter{
+		config: env,
+		header: header,
+		dialer: &ws.Dialer{
+			Proxy:            http.ProxyFromEnvironment,
+			HandshakeTimeout: handshakeTimeout,
+			Subprotocols:     env.Subprotocols,
+		},
+		ce:        ceClient,
+		logger:    logger,
+		connected: connected,
+	}
+}
+
+// handshakeHeader returns the headers sent in the opening handshake, given
+// the JSON encoded list of their names.
+func handshakeHeader(names string, getenv func(string) string) (http.Header, error) {
+	header := http.Header{}
+	if names == "" {
+		return header, nil
+	}
+
+	var list []string
+	if err := json.Unmarshal([]byte(names), &list); err != nil {
+		return nil, err
+	}
+	for i, name := range list {
+		header.Set(name, getenv(fmt.Sprintf("WEBSOCKET_HEADER_%d", i)))
+	}
+	return header, nil
+}
+
+// Start connects to the server and sends the messages it receives until ctx
+// is done, reconnecting with an exponential backoff when the connection is
+// lost.
+func (a *websocketAdapter) Start(ctx context.Context) error {
+	delay := a.config.ReconnectInitialDelay
+	for {
+		connected, err := a.session(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if connected {
+			delay = a.config.ReconnectInitialDelay
+		}
+
+		a.logger.Warnw("Connection to the WebSocket server lost, reconnecting", zap.Duration("delay", delay), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if delay > a.config.ReconnectMaxDelay {
+			delay = a.config.ReconnectMaxDelay
+		}
+	}
+}
+
+// session connects to the server and handles the messages it sends until the
+// connection is lost or ctx is done. It returns whether the connection was
+// established.
+func (a *websocketAdapter) session(ctx context.Context) (bool, error) {
+	conn, resp, err := a.dialer.DialContext(ctx, a.config.URL, a.header)
+	if err != nil {
+		if resp != nil {
+			return false, fmt.Errorf("failed to connect to %s, status %d: %w", a.config.URL, resp.StatusCode, err)
+		}
+		return false, fmt.Errorf("failed to connect to %s: %w", a.config.URL, err)
+	}
+	defer conn.Close()
+
+	a.connected.Store(true)
+	defer a.connected.Store(false)
+	a.logger.Infow("Connected to the WebSocket server", zap.String("url", a.config.URL), zap.String("subprotocol", conn.Subprotocol()))
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(pingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				_ = conn.WriteControl(ws.CloseMessage, ws.FormatCloseMessage(ws.CloseNormalClosure, ""), time.Now().Add(time.Second))
+				conn.Close()
+				return
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := conn.WriteControl(ws.PingMessage, nil, time.Now().Add(pingInterval)); err != nil {
+					a.logger.Debugw("Failed to send ping", zap.Error(err))
+				}
+			}
+		}
+	}()
+
+	extendDeadline := func() {
+		_ = conn.SetReadDeadline(time.Now().Add(2 * pingInterval))
+	}
+	conn.SetPongHandler(func(string) error {
+		extendDeadline()
+		return nil
+	})
+
+	for {
+		extendDeadline()
+		messageType, data, err := conn.ReadMessage()
+		if err != nil {
+			if ctx.Err() != nil {
+				return true, nil
+			}
+			return true, err
+		}
+		a.handle(ctx, messageType, data)
+	}
+}
+
+// handle sends the message received in a frame of messageType to the sink.
+// WebSocket messages can't be acknowledged, so messages that can't be
+// delivered are dropped.
+func (a *websocketAdapter) handle(ctx context.Context, messageType int, data []byte) {
+	event, err := toEvent(v1alpha1.WebSocketMessageFormat(a.config.Format), a.config.EventType, a.config.URL, messageType, data)
+	if err != nil {
+		a.logger.Warnw("Dropping invalid message", zap.Error(err))
+		return
+	}
+
+	if result := a.ce.Send(ctx, *event); !cloudevents.IsACK(result) {
+		a.logger.Errorw("Failed to send event", zap.String("id", event.ID()), zap.Error(result))
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	ws "github.com/gorilla/websocket"
+	"go.uber.org/zap"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+)
+
+func TestSession(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var gotHeader http.Header
+	upgrader := ws.Upgrader{Subprotocols: []string{"v2.events"}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_ = conn.WriteMessage(ws.TextMessage, []byte(`{"price":42}`))
+		_ = conn.WriteMessage(ws.BinaryMessage, []byte{0xca, 0xfe})
+		_ = conn.WriteMessage(ws.TextMessage, []byte(`{"specversion":"1.0","id":"1","source":"/ticker","type":"com.example.tick"}`))
+		_ = conn.WriteMessage(ws.CloseMessage, ws.FormatCloseMessage(ws.CloseGoingAway, ""))
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer server.Close()
+
+	ce := adaptertest.NewTestClient()
+	a := &websocketAdapter{
+		config: &envConfig{
+			URL:       "ws" + strings.TrimPrefix(server.URL, "http"),
+			Format:    "Auto",
+			EventType: "dev.knative.sources.websocket.message",
+		},
+		header: http.Header{"Authorization": []string{"Bearer token"}},
+		dialer: &ws.Dialer{Subprotocols: []string{"v1.events", "v2.events"}},
+		ce:     ce,
+		logger: zap.NewNop().Sugar(),
+
+		connected: &atomic.Bool{},
+	}
+
+	connected, err := a.session(ctx)
+	if !connected {
+		t.Fatal("session() didn't connect:", err)
+	}
+	if !ws.IsCloseError(err, ws.CloseGoingAway) {
+		t.Errorf("session() = %v, want the close error sent by the server", err)
+	}
+	if a.connected.Load() {
+		t.Error("Adapter connected after the session ended")
+	}
+
+	if got := gotHeader.Get("Authorization"); got != "Bearer token" {
+		t.Errorf("Authorization header = %q, want %q", got, "Bearer token")
+	}
+	if got := gotHeader.Get("Sec-Websocket-Protocol"); got != "v1.events, v2.events" {
+		t.Errorf("Sec-WebSocket-Protocol header = %q, want %q", got, "v1.events, v2.events")
+	}
+
+	sent := ce.Sent()
+	if len(sent) != 3 {
+		t.Fatalf("Sent %d events, want 3", len(sent))
+	}
+	var types []string
+	for _, event := range sent {
+		types = append(types, event.Type())
+	}
+	want := []string{"dev.knative.sources.websocket.message", "dev.knative.sources.websocket.message", "com.example.tick"}
+	if diff := cmp.Diff(want, types); diff != "" {
+		t.Error("Unexpected event types (-want, +got):", diff)
+	}
+}
+
+func TestSessionRefused(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	a := &websocketAdapter{
+		config:    &envConfig{URL: "ws" + strings.TrimPrefix(server.URL, "http")},
+		dialer:    &ws.Dialer{},
+		ce:        adaptertest.NewTestClient(),
+		logger:    zap.NewNop().Sugar(),
+		connected: &atomic.Bool{},
+	}
+
+	connected, err := a.session(context.Background())
+	if connected {
+		t.Error("session() connected, want refused")
+	}
+	if err == nil || !strings.Contains(err.Error(), "status 401") {
+		t.Errorf("session() = %v, want an error with the status", err)
+	}
+}
+
+func TestStartStops(t *testing.T) {
+	upgrader := ws.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a := &websocketAdapter{
+		config: &envConfig{
+			URL:                   "ws" + strings.TrimPrefix(server.URL, "http"),
+			ReconnectInitialDelay: time.Millisecond,
+			ReconnectMaxDelay:     time.Millisecond,
+		},
+		dialer:    &ws.Dialer{},
+		ce:        adaptertest.NewTestClient(),
+		logger:    zap.NewNop().Sugar(),
+		connected: &atomic.Bool{},
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- a.Start(ctx)
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !a.connected.Load() {
+		if time.Now().After(deadline) {
+			t.Fatal("Adapter didn't connect")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Start() = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Start() didn't return")
+	}
+}
+
+func TestWithReadiness(t *testing.T) {
+	ctx := WithReadiness(context.Background())
+	connected := ctx.Value(connectedKey{}).(*atomic.Bool)
+
+	a := NewAdapter(ctx, &envConfig{URL: "ws://server"}, adaptertest.NewTestClient()).(*websocketAdapter)
+	if a.connected != connected {
+		t.Fatal("Adapter doesn't report its connection to the readiness probe")
+	}
+}
+
+func TestHandshakeHeader(t *testing.T) {
+	env := map[string]string{
+		"WEBSOCKET_HEADER_0": "Bearer token",
+		"WEBSOCKET_HEADER_1": "knative",
+	}
+	got, err := handshakeHeader(`["Authorization","x-client"]`, func(name string) string {
+		return env[name]
+	})
+	if err != nil {
+		t.Fatal("handshakeHeader() =", err)
+	}
+	want := http.Header{
+		"Authorization": []string{"Bearer token"},
+		"X-Client":      []string{"knative"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error("Unexpected headers (-want, +got):", diff)
+	}
+
+	if _, err := handshakeHeader("Authorization", func(string) string { return "" }); err == nil {
+		t.Error("handshakeHeader() succeeded with invalid names, want error")
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	ws "github.com/gorilla/websocket"
+
+	"knative.dev/eventing/pkg/apis/sources/v1alpha1"
+)
+
+// toEvent converts the message data, received in a frame of messageType from
+// the server at url, to a CloudEvent according to format. Messages that are
+// CloudEvents in the JSON event format are parsed, others are wrapped in an
+// event of eventType whose data is the message.
+func toEvent(format v1alpha1.WebSocketMessageFormat, eventType, url string, messageType int, data []byte) (*cloudevents.Event, error) {
+	if format != v1alpha1.WebSocketMessageFormatRaw {
+		event := cloudevents.NewEvent()
+		err := json.Unmarshal(data, &event)
+		if err == nil {
+			err = event.Validate()
+		}
+		if err == nil {
+			return &event, nil
+		}
+		if format == v1alpha1.WebSocketMessageFormatCloudEvent {
+			return nil, fmt.Errorf("message is not a CloudEvent: %w", err)
+		}
+	}
+
+	event := cloudevents.NewEvent()
+	event.SetID(uuid.New().String())
+	event.SetType(eventType)
+	event.SetSource(url)
+
+	contentType := "application/octet-stream"
+	if messageType == ws.TextMessage {
+		if json.Valid(data) {
+			contentType = cloudevents.ApplicationJSON
+		} else {
+			contentType = cloudevents.TextPlain
+		}
+	}
+	if err := event.SetData(contentType, data); err != nil {
+		return nil, err
+	}
+
+	return &event, event.Validate()
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"testing"
+
+	ws "github.com/gorilla/websocket"
+
+	"knative.dev/eventing/pkg/apis/sources/v1alpha1"
+)
+
+func TestToEvent(t *testing.T) {
+	const (
+		eventType = "dev.knative.sources.websocket.message"
+		url       = "wss://server/events"
+	)
+	cloudEvent := []byte(`{"specversion":"1.0","id":"1","source":"/ticker","type":"com.example.tick","data":{"price":42}}`)
+
+	tests := []struct {
+		name            string
+		format          v1alpha1.WebSocketMessageFormat
+		messageType     int
+		data            []byte
+		wantErr         bool
+		wantType        string
+		wantSource      string
+		wantContentType string
+	}{{
+		name:            "cloudevent",
+		format:          v1alpha1.WebSocketMessageFormatAuto,
+		messageType:     ws.TextMessage,
+		data:            cloudEvent,
+		wantType:        "com.example.tick",
+		wantSource:      "/ticker",
+		wantContentType: "",
+	}, {
+		name:            "cloudevent in a binary frame",
+		format:          v1alpha1.WebSocketMessageFormatCloudEvent,
+		messageType:     ws.BinaryMessage,
+		data:            cloudEvent,
+		wantType:        "com.example.tick",
+		wantSource:      "/ticker",
+		wantContentType: "",
+	}, {
+		name:            "raw cloudevent",
+		format:          v1alpha1.WebSocketMessageFormatRaw,
+		messageType:     ws.TextMessage,
+		data:            cloudEvent,
+		wantType:        eventType,
+		wantSource:      url,
+		wantContentType: "application/json",
+	}, {
+		name:            "json text",
+		format:          v1alpha1.WebSocketMessageFormatAuto,
+		messageType:     ws.TextMessage,
+		data:            []byte(`{"price":42}`),
+		wantType:        eventType,
+		wantSource:      url,
+		wantContentType: "application/json",
+	}, {
+		name:            "plain text",
+		format:          v1alpha1.WebSocketMessageFormatAuto,
+		messageType:     ws.TextMessage,
+		data:            []byte("tick"),
+		wantType:        eventType,
+		wantSource:      url,
+		wantContentType: "text/plain",
+	}, {
+		name:            "binary",
+		format:          v1alpha1.WebSocketMessageFormatAuto,
+		messageType:     ws.BinaryMessage,
+		data:            []byte{0xca, 0xfe},
+		wantType:        eventType,
+		wantSource:      url,
+		wantContentType: "application/octet-stream",
+	}, {
+		name:        "not a cloudevent",
+		format:      v1alpha1.WebSocketMessageFormatCloudEvent,
+		messageType: ws.TextMessage,
+		data:        []byte(`{"price":42}`),
+		wantErr:     true,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			event, err := toEvent(tc.format, eventType, url, tc.messageType, tc.data)
+			if tc.wantErr {
+				if err == nil {
+					t.Error("toEvent() succeeded, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal("toEvent() =", err)
+			}
+
+			if event.Type() != tc.wantType {
+				t.Errorf("type = %q, want %q", event.Type(), tc.wantType)
+			}
+			if event.Source() != tc.wantSource {
+				t.Errorf("source = %q, want %q", event.Source(), tc.wantSource)
+			}
+			if event.DataContentType() != tc.wantContentType {
+				t.Errorf("datacontenttype = %q, want %q", event.DataContentType(), tc.wantContentType)
+			}
+			if tc.wantType == eventType && string(event.Data()) != string(tc.data) {
+				t.Errorf("data = %q, want %q", event.Data(), tc.data)
+			}
+		})
+	}
+}
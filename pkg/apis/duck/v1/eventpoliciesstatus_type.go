@@ -32,4 +32,9 @@ type AppliedEventPolicyRef struct {
 
 	// Name of the applied EventPolicy
 	Name string `json:"name"`
+
+	// Kind of the applied policy. Either EventPolicy or ClusterEventPolicy.
+	// Defaults to EventPolicy if empty.
+	// +optional
+	Kind string `json:"kind,omitempty"`
 }
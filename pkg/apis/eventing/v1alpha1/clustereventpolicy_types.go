@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/kmeta"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterEventPolicy represents a policy for addressable resources (Broker, Channel, sinks)
+// in all namespaces selected by its namespace selector.
+type ClusterEventPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ClusterEventPolicy.
+	Spec ClusterEventPolicySpec `json:"spec,omitempty"`
+
+	// Status represents the current state of the ClusterEventPolicy.
+	// This data may be out of date.
+	// +optional
+	Status EventPolicyStatus `json:"status,omitempty"`
+}
+
+var (
+	// Check that ClusterEventPolicy can be validated and defaulted.
+	_ apis.Validatable = (*ClusterEventPolicy)(nil)
+	_ apis.Defaultable = (*ClusterEventPolicy)(nil)
+
+	// Check that ClusterEventPolicy can return its spec untyped.
+	_ apis.HasSpec = (*ClusterEventPolicy)(nil)
+
+	_ runtime.Object = (*ClusterEventPolicy)(nil)
+
+	// Check that we can create OwnerReferences to a ClusterEventPolicy.
+	_ kmeta.OwnerRefable = (*ClusterEventPolicy)(nil)
+
+	// Check that the type conforms to the duck Knative Resource shape.
+	_ duckv1.KRShaped = (*ClusterEventPolicy)(nil)
+)
+
+type ClusterEventPolicySpec struct {
+	// NamespaceSelector selects the namespaces of the resources for which this policy applies.
+	// An empty selector selects all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// EventPolicySpec holds the rules of the policy. They are evaluated the same way as
+	// the rules of an EventPolicy. The targets (.spec.to) can only be given by selectors
+	// and apply to the resources in the selected namespaces. References in .spec.from
+	// and .spec.deny[].from must set their namespace.
+	EventPolicySpec `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterEventPolicyList is a collection of ClusterEventPolicy.
+type ClusterEventPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterEventPolicy `json:"items"`
+}
+
+// ClusterEventPolicyKind is the kind of a ClusterEventPolicy
+const ClusterEventPolicyKind = "ClusterEventPolicy"
+
+// GetGroupVersionKind returns GroupVersionKind for ClusterEventPolicy
+func (cep *ClusterEventPolicy) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(ClusterEventPolicyKind)
+}
+
+// GetUntypedSpec returns the spec of the ClusterEventPolicy.
+func (cep *ClusterEventPolicy) GetUntypedSpec() interface{} {
+	return cep.Spec
+}
+
+// GetStatus retrieves the status of the ClusterEventPolicy. Implements the KRShaped interface.
+func (cep *ClusterEventPolicy) GetStatus() *duckv1.Status {
+	return &cep.Status.Status
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/pkg/apis"
+)
+
+func (cep *ClusterEventPolicy) Validate(ctx context.Context) *apis.FieldError {
+	// To not allow creation or spec updates of ClusterEventPolicy CRs
+	// if the oidc-authentication feature is not enabled
+	if apis.IsInCreate(ctx) || (apis.IsInUpdate(ctx) && apis.IsInSpec(ctx)) {
+		if !feature.FromContext(ctx).IsOIDCAuthentication() {
+			return apis.ErrGeneric("oidc-authentication feature not enabled")
+		}
+	}
+	return cep.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (cets *ClusterEventPolicySpec) Validate(ctx context.Context) *apis.FieldError {
+	err := cets.EventPolicySpec.Validate(ctx)
+
+	if cets.NamespaceSelector != nil {
+		if _, selectorErr := metav1.LabelSelectorAsSelector(cets.NamespaceSelector); selectorErr != nil {
+			err = err.Also(apis.ErrInvalidValue(cets.NamespaceSelector, "namespaceSelector", selectorErr.Error()))
+		}
+	}
+
+	for i, t := range cets.To {
+		if t.Ref != nil {
+			err = err.Also(apis.ErrDisallowedFields("ref").ViaFieldIndex("to", i))
+		}
+	}
+
+	for i, f := range cets.From {
+		err = err.Also(validateClusterFromNamespace(f).ViaFieldIndex("from", i))
+	}
+	for i, d := range cets.Deny {
+		for j, f := range d.From {
+			err = err.Also(validateClusterFromNamespace(f).ViaFieldIndex("from", j).ViaFieldIndex("deny", i))
+		}
+	}
+
+	return err
+}
+
+// validateClusterFromNamespace checks that references of cluster-scoped policies set
+// their namespace, as it can't be defaulted to the namespace of the policy
+func validateClusterFromNamespace(f EventPolicySpecFrom) *apis.FieldError {
+	if f.Ref != nil && f.Ref.Namespace == "" {
+		return apis.ErrMissingField("namespace").ViaField("ref")
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+)
+
+func TestClusterEventPolicySpecValidation(t *testing.T) {
+	invalidSelector := &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{
+			Key:      "team",
+			Operator: "invalid",
+		}},
+	}
+
+	tests := []struct {
+		name string
+		cep  *ClusterEventPolicy
+		want *apis.FieldError
+	}{
+		{
+			name: "valid, empty",
+			cep: &ClusterEventPolicy{
+				Spec: ClusterEventPolicySpec{},
+			},
+			want: nil,
+		},
+		{
+			name: "valid, namespace selector, to.selector and from.ref with namespace",
+			cep: &ClusterEventPolicy{
+				Spec: ClusterEventPolicySpec{
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"team": "a"},
+					},
+					EventPolicySpec: EventPolicySpec{
+						To: []EventPolicySpecTo{{
+							Selector: &EventPolicySelector{
+								LabelSelector: &metav1.LabelSelector{},
+								TypeMeta: &metav1.TypeMeta{
+									APIVersion: "eventing.knative.dev/v1",
+									Kind:       "Broker",
+								},
+							},
+						}},
+						From: []EventPolicySpecFrom{{
+							Ref: &EventPolicyFromReference{
+								APIVersion: "a",
+								Kind:       "b",
+								Name:       "c",
+								Namespace:  "d",
+							},
+						}, {
+							Sub: ptr.String("*"),
+						}},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "invalid, namespace selector",
+			cep: &ClusterEventPolicy{
+				Spec: ClusterEventPolicySpec{
+					NamespaceSelector: invalidSelector,
+				},
+			},
+			want: func() *apis.FieldError {
+				_, err := metav1.LabelSelectorAsSelector(invalidSelector)
+				return apis.ErrInvalidValue(invalidSelector, "namespaceSelector", err.Error()).ViaField("spec")
+			}(),
+		},
+		{
+			name: "invalid, to.ref",
+			cep: &ClusterEventPolicy{
+				Spec: ClusterEventPolicySpec{
+					EventPolicySpec: EventPolicySpec{
+						To: []EventPolicySpecTo{{
+							Ref: &EventPolicyToReference{
+								APIVersion: "a",
+								Kind:       "b",
+								Name:       "c",
+							},
+						}},
+					},
+				},
+			},
+			want: apis.ErrDisallowedFields("ref").ViaFieldIndex("to", 0).ViaField("spec"),
+		},
+		{
+			name: "invalid, from.ref missing namespace",
+			cep: &ClusterEventPolicy{
+				Spec: ClusterEventPolicySpec{
+					EventPolicySpec: EventPolicySpec{
+						From: []EventPolicySpecFrom{{
+							Ref: &EventPolicyFromReference{
+								APIVersion: "a",
+								Kind:       "b",
+								Name:       "c",
+							},
+						}},
+					},
+				},
+			},
+			want: apis.ErrMissingField("namespace").ViaField("ref").ViaFieldIndex("from", 0).ViaField("spec"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := feature.ToContext(context.TODO(), feature.Flags{
+				feature.OIDCAuthentication: feature.Enabled,
+			})
+			ctx = apis.WithinCreate(ctx)
+			got := test.cep.Validate(ctx)
+			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+				t.Errorf("%s: Validate ClusterEventPolicySpec (-want, +got) = %v", test.name, diff)
+			}
+		})
+	}
+}
+
+func TestClusterEventPolicyValidationWithOIDCAuthenticationFeatureFlagDisabled(t *testing.T) {
+	ctx := feature.ToContext(context.TODO(), feature.Flags{
+		feature.OIDCAuthentication: feature.Disabled,
+	})
+	ctx = apis.WithinCreate(ctx)
+
+	cep := &ClusterEventPolicy{
+		Spec: ClusterEventPolicySpec{
+			EventPolicySpec: EventPolicySpec{
+				From: []EventPolicySpecFrom{{
+					Sub: ptr.String("*"),
+				}},
+			},
+		},
+	}
+
+	want := apis.ErrGeneric("oidc-authentication feature not enabled")
+	if diff := cmp.Diff(want.Error(), cep.Validate(ctx).Error()); diff != "" {
+		t.Errorf("Validate ClusterEventPolicy (-want, +got) = %v", diff)
+	}
+}
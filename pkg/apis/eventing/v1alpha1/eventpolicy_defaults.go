@@ -31,6 +31,25 @@ func (ets *EventPolicySpec) SetDefaults(ctx context.Context) {
 	for i := range ets.From {
 		ets.From[i].SetDefaults(ctx)
 	}
+	for i := range ets.Deny {
+		for j := range ets.Deny[i].From {
+			ets.Deny[i].From[j].SetDefaults(ctx)
+		}
+	}
+	ets.RateLimit.SetDefaults(ctx)
+}
+
+func (rl *EventPolicySpecRateLimit) SetDefaults(ctx context.Context) {
+	if rl == nil {
+		return
+	}
+	if rl.Burst == nil {
+		burst := rl.EventsPerSecond
+		rl.Burst = &burst
+	}
+	if rl.Per == "" {
+		rl.Per = EventPolicyRateLimitPerSubject
+	}
 }
 
 func (from *EventPolicySpecFrom) SetDefaults(ctx context.Context) {
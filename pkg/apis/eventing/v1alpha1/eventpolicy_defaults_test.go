@@ -23,6 +23,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/google/go-cmp/cmp"
+	"knative.dev/pkg/ptr"
 )
 
 func TestEventPolicyDefaults(t *testing.T) {
@@ -64,6 +65,62 @@ func TestEventPolicyDefaults(t *testing.T) {
 				},
 			},
 		},
+		"default .spec.deny[].from[].namespace": {
+			initial: EventPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-ns",
+				},
+				Spec: EventPolicySpec{
+					Deny: []EventPolicySpecDeny{
+						{
+							From: []EventPolicySpecFrom{
+								{
+									Ref: &EventPolicyFromReference{
+										Namespace: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: EventPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-ns",
+				},
+				Spec: EventPolicySpec{
+					Deny: []EventPolicySpecDeny{
+						{
+							From: []EventPolicySpecFrom{
+								{
+									Ref: &EventPolicyFromReference{
+										Namespace: "my-ns",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"default .spec.rateLimit": {
+			initial: EventPolicy{
+				Spec: EventPolicySpec{
+					RateLimit: &EventPolicySpecRateLimit{
+						EventsPerSecond: 10,
+					},
+				},
+			},
+			expected: EventPolicy{
+				Spec: EventPolicySpec{
+					RateLimit: &EventPolicySpecRateLimit{
+						EventsPerSecond: 10,
+						Burst:           ptr.Int32(10),
+						Per:             EventPolicyRateLimitPerSubject,
+					},
+				},
+			},
+		},
 	}
 	for n, tc := range testCases {
 		t.Run(n, func(t *testing.T) {
@@ -0,0 +1,43 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+)
+
+// KeySetValidator validates the JSON Web Key Set of an EventPolicy signature.
+type KeySetValidator func(jwks string) error
+
+type keySetValidatorKey struct{}
+
+// WithKeySetValidator notes on the context the validator used to check the
+// key sets of EventPolicy signatures. The JOSE library isn't part of the API
+// types to keep it out of the clients, so it is only set by the webhook.
+func WithKeySetValidator(ctx context.Context, validator KeySetValidator) context.Context {
+	return context.WithValue(ctx, keySetValidatorKey{}, validator)
+}
+
+// GetKeySetValidator returns the validator of EventPolicy key sets associated
+// with the context, or nil.
+func GetKeySetValidator(ctx context.Context) KeySetValidator {
+	value := ctx.Value(keySetValidatorKey{})
+	if value == nil {
+		return nil
+	}
+	return value.(KeySetValidator)
+}
@@ -82,6 +82,58 @@ type EventPolicySpec struct {
 	// always evaluate to true.
 	// +optional
 	Filters []eventingv1.SubscriptionsAPIFilter `json:"filters,omitempty"`
+
+	// Deny is the list of sources or oidc identities and events, which are denied to be sent to the targets (.spec.to).
+	// Deny rules take precedence over the allowed sources of this and of all other EventPolicies applying to the targets.
+	// An EventPolicy without .spec.from, but with deny rules, only denies events and does not restrict the allowed sources.
+	// Deny rules whose sources are not yet resolved for the current generation deny the matching events of all sources.
+	// +optional
+	Deny []EventPolicySpecDeny `json:"deny,omitempty"`
+
+	// Signature requires the events allowed by this policy to be signed by one of the given keys.
+	// Events without a valid signature are not accepted.
+	// +optional
+	Signature *EventPolicySpecSignature `json:"signature,omitempty"`
+
+	// RateLimit limits the rate of events allowed by this policy. Events exceeding the
+	// rate limit are rejected with a 429 status. The rate limit is enforced by each
+	// replica of the ingress of the targets separately.
+	// +optional
+	RateLimit *EventPolicySpecRateLimit `json:"rateLimit,omitempty"`
+}
+
+type EventPolicySpecSignature struct {
+	// JWKS is a JSON Web Key Set holding the public keys, of which one must have signed the events.
+	// The signature of an event is a JWS in its knativesignature extension.
+	// The signature only covers the event, so a signed event can be replayed by anyone who obtained it.
+	// Senders should include a unique id and a time attribute, for receivers to detect replays.
+	JWKS string `json:"jwks"`
+}
+
+// EventPolicyRateLimitScope defines to which senders a rate limit applies
+type EventPolicyRateLimitScope string
+
+const (
+	// EventPolicyRateLimitPerSubject applies the rate limit to each subject separately
+	EventPolicyRateLimitPerSubject EventPolicyRateLimitScope = "Subject"
+
+	// EventPolicyRateLimitPerPolicy applies the rate limit to all subjects of the policy together
+	EventPolicyRateLimitPerPolicy EventPolicyRateLimitScope = "Policy"
+)
+
+type EventPolicySpecRateLimit struct {
+	// EventsPerSecond is the sustained rate of allowed events.
+	EventsPerSecond int32 `json:"eventsPerSecond"`
+
+	// Burst is the number of events allowed at once, exceeding the sustained rate.
+	// Defaults to EventsPerSecond.
+	// +optional
+	Burst *int32 `json:"burst,omitempty"`
+
+	// Per defines if the rate limit applies to each subject separately (Subject), or to all
+	// subjects of the policy together (Policy). Defaults to Subject.
+	// +optional
+	Per EventPolicyRateLimitScope `json:"per,omitempty"`
 }
 
 type EventPolicySpecTo struct {
@@ -100,9 +152,32 @@ type EventPolicySpecFrom struct {
 	Ref *EventPolicyFromReference `json:"ref,omitempty"`
 
 	// Sub sets the OIDC identity name to be allowed to send events to the target.
+	// Identities of trusted external OIDC issuers are prefixed with the issuer and
+	// a #, e.g. https://idp.example.com#my-client.
 	// It is also possible to set a glob-like pattern to match any suffix.
 	// +optional
 	Sub *string `json:"sub,omitempty"`
+
+	// SpiffeID sets the SPIFFE ID of the client certificate of senders to be allowed to send
+	// events to the target. It is used for requests without an OIDC token, when the
+	// transport-encryption feature is strict.
+	// The SPIFFE ID must be in the trust domain of the spiffe-trust-domain feature and is never matched by the subject of an OIDC token.
+	// It is also possible to set a glob-like pattern to match any suffix.
+	// +optional
+	SpiffeID *string `json:"spiffeID,omitempty"`
+}
+
+type EventPolicySpecDeny struct {
+	// From is the list of sources or oidc identities, which are denied to send events to the target.
+	// An empty list denies all sources sending events which match the filters.
+	// +optional
+	From []EventPolicySpecFrom `json:"from,omitempty"`
+
+	// Filters is the list of SubscriptionsApi filters which determine whether or not the event is denied.
+	// The event is denied if all filter expressions evaluate to true. Absence of any filters implies that
+	// all events of the sources are denied.
+	// +optional
+	Filters []eventingv1.SubscriptionsAPIFilter `json:"filters,omitempty"`
 }
 
 type EventPolicyToReference struct {
@@ -151,6 +226,16 @@ type EventPolicyStatus struct {
 
 	// From is the list of resolved oidc identities from .spec.from
 	From []string `json:"from,omitempty"`
+
+	// Deny is the list of resolved oidc identities from .spec.deny, in the order of the deny rules.
+	// A deny rule without .from resolves to the "*" pattern, which matches all identities.
+	Deny []EventPolicyStatusDeny `json:"deny,omitempty"`
+}
+
+// EventPolicyStatusDeny holds the resolved oidc identities of a deny rule
+type EventPolicyStatusDeny struct {
+	// From is the list of resolved oidc identities from .spec.deny[].from
+	From []string `json:"from,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
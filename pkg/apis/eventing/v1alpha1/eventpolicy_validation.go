@@ -39,14 +39,7 @@ func (ep *EventPolicy) Validate(ctx context.Context) *apis.FieldError {
 func (ets *EventPolicySpec) Validate(ctx context.Context) *apis.FieldError {
 	var err *apis.FieldError
 	for i, f := range ets.From {
-		if f.Ref == nil && (f.Sub == nil || *f.Sub == "") {
-			err = err.Also(apis.ErrMissingOneOf("ref", "sub").ViaFieldIndex("from", i))
-		}
-		if f.Ref != nil && f.Sub != nil {
-			err = err.Also(apis.ErrMultipleOneOf("ref", "sub").ViaFieldIndex("from", i))
-		}
-		err = err.Also(f.Ref.Validate().ViaField("ref").ViaFieldIndex("from", i))
-		err = err.Also(validateSub(f.Sub).ViaField("sub").ViaFieldIndex("from", i))
+		err = err.Also(f.Validate().ViaFieldIndex("from", i))
 	}
 
 	for i, t := range ets.To {
@@ -63,6 +56,86 @@ func (ets *EventPolicySpec) Validate(ctx context.Context) *apis.FieldError {
 
 	err = err.Also(eventingv1.ValidateSubscriptionAPIFiltersList(ctx, ets.Filters).ViaField("filters"))
 
+	for i, d := range ets.Deny {
+		err = err.Also(d.Validate(ctx).ViaFieldIndex("deny", i))
+	}
+
+	err = err.Also(ets.Signature.Validate(ctx).ViaField("signature"))
+	err = err.Also(ets.RateLimit.Validate().ViaField("rateLimit"))
+
+	return err
+}
+
+func (rl *EventPolicySpecRateLimit) Validate() *apis.FieldError {
+	if rl == nil {
+		return nil
+	}
+
+	var err *apis.FieldError
+	if rl.EventsPerSecond < 1 {
+		err = err.Also(apis.ErrInvalidValue(rl.EventsPerSecond, "eventsPerSecond", "must be at least 1"))
+	}
+	if rl.Burst != nil && *rl.Burst < 1 {
+		err = err.Also(apis.ErrInvalidValue(*rl.Burst, "burst", "must be at least 1"))
+	}
+	switch rl.Per {
+	case "", EventPolicyRateLimitPerSubject, EventPolicyRateLimitPerPolicy:
+	default:
+		err = err.Also(apis.ErrInvalidValue(rl.Per, "per", "must be Subject or Policy"))
+	}
+	return err
+}
+
+func (s *EventPolicySpecSignature) Validate(ctx context.Context) *apis.FieldError {
+	if s == nil {
+		return nil
+	}
+	if s.JWKS == "" {
+		return apis.ErrMissingField("jwks")
+	}
+	if validate := GetKeySetValidator(ctx); validate != nil {
+		if err := validate(s.JWKS); err != nil {
+			return apis.ErrInvalidValue(s.JWKS, "jwks", err.Error())
+		}
+	}
+	return nil
+}
+
+func (f *EventPolicySpecFrom) Validate() *apis.FieldError {
+	var err *apis.FieldError
+	set := 0
+	if f.Ref != nil {
+		set++
+	}
+	if f.Sub != nil {
+		set++
+	}
+	if f.SpiffeID != nil {
+		set++
+	}
+	if f.Ref == nil && (f.Sub == nil || *f.Sub == "") && (f.SpiffeID == nil || *f.SpiffeID == "") {
+		err = err.Also(apis.ErrMissingOneOf("ref", "sub", "spiffeID"))
+	}
+	if set > 1 {
+		err = err.Also(apis.ErrMultipleOneOf("ref", "sub", "spiffeID"))
+	}
+	err = err.Also(f.Ref.Validate().ViaField("ref"))
+	err = err.Also(validateSub(f.Sub).ViaField("sub"))
+	err = err.Also(validateSpiffeID(f.SpiffeID).ViaField("spiffeID"))
+	return err
+}
+
+func (d *EventPolicySpecDeny) Validate(ctx context.Context) *apis.FieldError {
+	if len(d.From) == 0 && len(d.Filters) == 0 {
+		// a deny rule without sources and filters would deny all events
+		return apis.ErrMissingOneOf("from", "filters")
+	}
+
+	var err *apis.FieldError
+	for i, f := range d.From {
+		err = err.Also(f.Validate().ViaFieldIndex("from", i))
+	}
+	err = err.Also(eventingv1.ValidateSubscriptionAPIFiltersList(ctx, d.Filters).ViaField("filters"))
 	return err
 }
 
@@ -80,6 +153,17 @@ func validateSub(sub *string) *apis.FieldError {
 	return nil
 }
 
+func validateSpiffeID(spiffeID *string) *apis.FieldError {
+	if spiffeID == nil || *spiffeID == "" {
+		return nil
+	}
+
+	if !strings.HasPrefix(*spiffeID, "spiffe://") {
+		return apis.ErrInvalidValue(*spiffeID, "", "must start with spiffe://")
+	}
+	return validateSub(spiffeID)
+}
+
 func (r *EventPolicyFromReference) Validate() *apis.FieldError {
 	if r == nil {
 		return nil
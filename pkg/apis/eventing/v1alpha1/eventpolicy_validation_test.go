@@ -18,9 +18,11 @@ package v1alpha1
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/sets"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/eventing/pkg/apis/feature"
 	"knative.dev/pkg/apis"
@@ -95,7 +97,7 @@ func TestEventPolicySpecValidationWithOIDCAuthenticationFeatureFlagEnabled(t *te
 				},
 			},
 			want: func() *apis.FieldError {
-				return apis.ErrMissingOneOf("ref", "sub").ViaFieldIndex("from", 0).ViaField("spec")
+				return apis.ErrMissingOneOf("ref", "sub", "spiffeID").ViaFieldIndex("from", 0).ViaField("spec")
 			}(),
 		},
 		{
@@ -161,7 +163,118 @@ func TestEventPolicySpecValidationWithOIDCAuthenticationFeatureFlagEnabled(t *te
 				},
 			},
 			want: func() *apis.FieldError {
-				return apis.ErrMultipleOneOf("ref", "sub").ViaFieldIndex("from", 0).ViaField("spec")
+				return apis.ErrMultipleOneOf("ref", "sub", "spiffeID").ViaFieldIndex("from", 0).ViaField("spec")
+			}(),
+		},
+		{
+			name: "valid, from.spiffeID set",
+			ep: &EventPolicy{
+				Spec: EventPolicySpec{
+					From: []EventPolicySpecFrom{{
+						SpiffeID: ptr.String("spiffe://cluster.local/ns/my-ns/*"),
+					}},
+				},
+			},
+			want: func() *apis.FieldError {
+				return nil
+			}(),
+		},
+		{
+			name: "invalid, from.spiffeID without spiffe scheme",
+			ep: &EventPolicy{
+				Spec: EventPolicySpec{
+					From: []EventPolicySpecFrom{{
+						SpiffeID: ptr.String("https://cluster.local/ns/my-ns/sa/my-sa"),
+					}},
+				},
+			},
+			want: func() *apis.FieldError {
+				return apis.ErrInvalidValue("https://cluster.local/ns/my-ns/sa/my-sa", "", "must start with spiffe://").ViaField("spiffeID").ViaFieldIndex("from", 0).ViaField("spec")
+			}(),
+		},
+		{
+			name: "invalid, both from.sub and from.spiffeID set for the same list element",
+			ep: &EventPolicy{
+				Spec: EventPolicySpec{
+					From: []EventPolicySpecFrom{{
+						Sub:      ptr.String("abc"),
+						SpiffeID: ptr.String("spiffe://cluster.local/ns/my-ns/sa/my-sa"),
+					}},
+				},
+			},
+			want: func() *apis.FieldError {
+				return apis.ErrMultipleOneOf("ref", "sub", "spiffeID").ViaFieldIndex("from", 0).ViaField("spec")
+			}(),
+		},
+		{
+			name: "valid, signature",
+			ep: &EventPolicy{
+				Spec: EventPolicySpec{
+					Signature: &EventPolicySpecSignature{
+						JWKS: `{"keys": [{"kty": "OKP"}]}`,
+					},
+				},
+			},
+			want: func() *apis.FieldError {
+				return nil
+			}(),
+		},
+		{
+			name: "invalid, signature without keys",
+			ep: &EventPolicy{
+				Spec: EventPolicySpec{
+					Signature: &EventPolicySpecSignature{
+						JWKS: `{"keys": []}`,
+					},
+				},
+			},
+			want: func() *apis.FieldError {
+				return apis.ErrInvalidValue(`{"keys": []}`, "jwks", "invalid key set").ViaField("signature").ViaField("spec")
+			}(),
+		},
+		{
+			name: "invalid, signature missing jwks",
+			ep: &EventPolicy{
+				Spec: EventPolicySpec{
+					Signature: &EventPolicySpecSignature{},
+				},
+			},
+			want: func() *apis.FieldError {
+				return apis.ErrMissingField("jwks").ViaField("signature").ViaField("spec")
+			}(),
+		},
+		{
+			name: "valid, rate limit",
+			ep: &EventPolicy{
+				Spec: EventPolicySpec{
+					RateLimit: &EventPolicySpecRateLimit{
+						EventsPerSecond: 10,
+						Burst:           ptr.Int32(20),
+						Per:             EventPolicyRateLimitPerPolicy,
+					},
+				},
+			},
+			want: func() *apis.FieldError {
+				return nil
+			}(),
+		},
+		{
+			name: "invalid, rate limit",
+			ep: &EventPolicy{
+				Spec: EventPolicySpec{
+					RateLimit: &EventPolicySpecRateLimit{
+						EventsPerSecond: 0,
+						Burst:           ptr.Int32(0),
+						Per:             "Namespace",
+					},
+				},
+			},
+			want: func() *apis.FieldError {
+				var errs *apis.FieldError
+				errs = errs.Also(apis.ErrInvalidValue(0, "eventsPerSecond", "must be at least 1"))
+				errs = errs.Also(apis.ErrInvalidValue(0, "burst", "must be at least 1"))
+				errs = errs.Also(apis.ErrInvalidValue("Namespace", "per", "must be Subject or Policy"))
+				return errs.ViaField("rateLimit").ViaField("spec")
 			}(),
 		},
 		{
@@ -334,6 +447,88 @@ func TestEventPolicySpecValidationWithOIDCAuthenticationFeatureFlagEnabled(t *te
 					ViaField("spec")
 			}(),
 		},
+		{
+			name: "valid, deny sub and filters",
+			ep: &EventPolicy{
+				Spec: EventPolicySpec{
+					Deny: []EventPolicySpecDeny{
+						{
+							From: []EventPolicySpecFrom{{
+								Sub: ptr.String("system:serviceaccount:ns:compromised"),
+							}},
+						},
+						{
+							Filters: []eventingv1.SubscriptionsAPIFilter{
+								{
+									Exact: map[string]string{"type": "unwanted"},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: func() *apis.FieldError {
+				return nil
+			}(),
+		},
+		{
+			name: "invalid, deny without from and filters",
+			ep: &EventPolicy{
+				Spec: EventPolicySpec{
+					Deny: []EventPolicySpecDeny{{}},
+				},
+			},
+			want: func() *apis.FieldError {
+				return apis.ErrMissingOneOf("from", "filters").ViaFieldIndex("deny", 0).ViaField("spec")
+			}(),
+		},
+		{
+			name: "invalid, deny.from.sub '*' set as infix",
+			ep: &EventPolicy{
+				Spec: EventPolicySpec{
+					Deny: []EventPolicySpecDeny{{
+						From: []EventPolicySpecFrom{{
+							Sub: ptr.String("a*b"),
+						}},
+					}},
+				},
+			},
+			want: func() *apis.FieldError {
+				return apis.ErrInvalidValue("a*b", "sub", "'*' is only allowed as suffix").
+					ViaFieldIndex("from", 0).
+					ViaFieldIndex("deny", 0).
+					ViaField("spec")
+			}(),
+		},
+		{
+			name: "invalid, deny invalid cesql filter",
+			ep: &EventPolicy{
+				Spec: EventPolicySpec{
+					Deny: []EventPolicySpecDeny{{
+						Filters: []eventingv1.SubscriptionsAPIFilter{
+							{
+								CESQL: "type LIKE id",
+							},
+						},
+					}},
+				},
+			},
+			want: func() *apis.FieldError {
+				return apis.ErrInvalidValue("type LIKE id", "cesql", "parse error: syntax error: |failed to parse LIKE expression: the pattern was not a string literal").
+					ViaFieldIndex("filters", 0).
+					ViaFieldIndex("deny", 0).
+					ViaField("spec")
+			}(),
+		},
+	}
+
+	// The webhook validates key sets with the JOSE library, which isn't part of the API types.
+	validKeySets := sets.New(`{"keys": [{"kty": "OKP"}]}`)
+	keySetValidator := func(jwks string) error {
+		if !validKeySets.Has(jwks) {
+			return errors.New("invalid key set")
+		}
+		return nil
 	}
 
 	for _, test := range tests {
@@ -341,6 +536,7 @@ func TestEventPolicySpecValidationWithOIDCAuthenticationFeatureFlagEnabled(t *te
 			ctx := feature.ToContext(context.TODO(), feature.Flags{
 				feature.OIDCAuthentication: feature.Enabled,
 			})
+			ctx = WithKeySetValidator(ctx, keySetValidator)
 			got := test.ep.Validate(ctx)
 			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
 				t.Errorf("%s: Validate EventPolicySpec (-want, +got) = %v", test.name, diff)
@@ -61,6 +61,8 @@ var (
 // Adds the list of known types to Scheme.
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&ClusterEventPolicy{},
+		&ClusterEventPolicyList{},
 		&EventPolicy{},
 		&EventPolicyList{},
 		&RequestReply{},
@@ -42,6 +42,8 @@ func TestKnownTypes(t *testing.T) {
 	types := scheme.KnownTypes(SchemeGroupVersion)
 
 	for _, name := range []string{
+		"ClusterEventPolicy",
+		"ClusterEventPolicyList",
 		"EventPolicy",
 		"EventPolicyList",
 	} {
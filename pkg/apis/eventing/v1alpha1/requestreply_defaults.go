@@ -24,6 +24,20 @@ import (
 	"knative.dev/pkg/apis"
 )
 
+const (
+	// DefaultRequestReplyCallbackAttribute is the default name of the attribute holding the callback URL of an
+	// asynchronous request.
+	DefaultRequestReplyCallbackAttribute = "replycallback"
+	// DefaultRequestReplyMaxPending is the default maximum number of pending asynchronous requests per replica.
+	DefaultRequestReplyMaxPending int32 = 1000
+	// DefaultRequestReplyReplyRetention is the default duration for which the reply of an asynchronous request can be polled.
+	DefaultRequestReplyReplyRetention = "PT5M"
+	// MaxRequestReplyCollectedReplies is the maximum number of replies collected for a request.
+	MaxRequestReplyCollectedReplies int32 = 1000
+	// DefaultRequestReplyKeyRotationPeriod is the default period after which the AES key of a RequestReply is rotated.
+	DefaultRequestReplyKeyRotationPeriod = "P7D"
+)
+
 func (rr *RequestReply) SetDefaults(ctx context.Context) {
 	ctx = apis.WithinParent(ctx, rr.ObjectMeta)
 	rr.Spec.SetDefaults(ctx)
@@ -48,4 +62,21 @@ func (rrs *RequestReplySpec) SetDefaults(ctx context.Context) {
 		rrs.ReplyAttribute = "replyid"
 	}
 
+	if rrs.Async != nil {
+		rrs.Async.SetDefaults(ctx)
+	}
+}
+
+func (a *RequestReplyAsync) SetDefaults(_ context.Context) {
+	if a.CallbackAttribute == "" {
+		a.CallbackAttribute = DefaultRequestReplyCallbackAttribute
+	}
+
+	if a.MaxPending == nil {
+		a.MaxPending = ptr.To(DefaultRequestReplyMaxPending)
+	}
+
+	if a.ReplyRetention == nil || *a.ReplyRetention == "" {
+		a.ReplyRetention = ptr.To(DefaultRequestReplyReplyRetention)
+	}
 }
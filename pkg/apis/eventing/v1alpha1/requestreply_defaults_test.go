@@ -67,6 +67,30 @@ func TestRequestReplyDefaults(t *testing.T) {
 				},
 			},
 		},
+		"async": {
+			initial: RequestReply{
+				Spec: RequestReplySpec{
+					Async: &RequestReplyAsync{},
+				},
+			},
+			expected: RequestReply{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"eventing.knative.dev/broker": "",
+					},
+				},
+				Spec: RequestReplySpec{
+					Timeout:              ptr.To("PT30S"),
+					CorrelationAttribute: "correlationid",
+					ReplyAttribute:       "replyid",
+					Async: &RequestReplyAsync{
+						CallbackAttribute: "replycallback",
+						MaxPending:        ptr.To(int32(1000)),
+						ReplyRetention:    ptr.To("PT5M"),
+					},
+				},
+			},
+		},
 	}
 	for n, tc := range testCases {
 		t.Run(n, func(t *testing.T) {
@@ -76,6 +76,62 @@ type RequestReplySpec struct {
 	Timeout *string `json:"timeout,omitempty"`
 
 	Delivery *eventingduckv1.DeliverySpec `json:"delivery,omitempty"`
+
+	// Async enables the asynchronous mode, in which the RequestReply immediately responds to a request with the
+	// location of its reply, instead of holding the connection open until the reply arrives.
+	// +optional
+	Async *RequestReplyAsync `json:"async,omitempty"`
+
+	// Collect enables the collection mode, in which the RequestReply responds to a request with all the replies it
+	// received until the expected number of replies arrived or the collection window closed, instead of the first reply.
+	// The replies are returned as a batch of cloudevents. Collect is not supported in the asynchronous mode.
+	// +optional
+	Collect *RequestReplyCollect `json:"collect,omitempty"`
+
+	// KeyRotationPeriod is an ISO8601 duration after which the AES key used to sign the correlation ids is rotated.
+	// The previous key remains valid for the timeout after a rotation, so the period is never shorter than the timeout.
+	// Defaults to P7D.
+	// +optional
+	KeyRotationPeriod *string `json:"keyRotationPeriod,omitempty"`
+}
+
+// RequestReplyAsync configures the asynchronous mode of a RequestReply.
+type RequestReplyAsync struct {
+	// CallbackAttribute is the name of the cloudevents extension attribute which holds the URL a reply is sent to.
+	// Requests without this attribute can only be polled for their reply.
+	// Defaults to replycallback.
+	// +optional
+	CallbackAttribute string `json:"callbackAttribute,omitempty"`
+
+	// AllowedCallbacks are the http or https URLs the callback URL of a request must start with, like
+	// https://example.com/replies/. Requests with any other callback URL are rejected, so callbacks are only
+	// accepted when at least one is set.
+	// +optional
+	AllowedCallbacks []string `json:"allowedCallbacks,omitempty"`
+
+	// MaxPending is the maximum number of requests, per data plane replica, whose replies are pending or have not
+	// expired yet. New requests are rejected while the limit is reached.
+	// Defaults to 1000.
+	// +optional
+	MaxPending *int32 `json:"maxPending,omitempty"`
+
+	// ReplyRetention is an ISO8601 duration for which a reply can be polled after it arrived.
+	// Defaults to PT5M.
+	// +optional
+	ReplyRetention *string `json:"replyRetention,omitempty"`
+}
+
+// RequestReplyCollect configures the collection mode of a RequestReply. At least one of Replies and Window must be set,
+// the collected replies are returned as soon as either condition is met, or once the timeout expires.
+type RequestReplyCollect struct {
+	// Replies is the number of replies after which the collected replies are returned. At most 1000 replies are
+	// collected for a request.
+	// +optional
+	Replies *int32 `json:"replies,omitempty"`
+
+	// Window is an ISO8601 duration, starting when the request was sent, after which the collected replies are returned.
+	// +optional
+	Window *string `json:"window,omitempty"`
 }
 
 // RequestReplyStatus represents the current state of a RequestReply.
@@ -99,6 +155,10 @@ type RequestReplyStatus struct {
 
 	// ReadyReplicas is the number of ready replicas (StatefulSet pod + trigger) for this RequestReply resource
 	ReadyReplicas *int32 `json:"readyReplicas,omitempty"`
+
+	// KeyGeneration is the generation of the AES key currently used to sign the correlation ids of this RequestReply
+	// +optional
+	KeyGeneration *int64 `json:"keyGeneration,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"context"
+	"math"
 	"strings"
 
 	"github.com/rickb777/date/period"
@@ -78,5 +79,82 @@ func (rrs *RequestReplySpec) Validate(ctx context.Context) *apis.FieldError {
 		errs = errs.Also(apis.ErrInvalidValue(rrs.ReplyAttribute, "replyattribute", "replyattribute must be non-empty and cannot be a core cloudevent attribute (id, type, specversion, source)"))
 	}
 
+	if rrs.Async != nil {
+		errs = errs.Also(rrs.Async.Validate(ctx).ViaField("async"))
+	}
+
+	if rrs.KeyRotationPeriod != nil {
+		keyRotationPeriod, err := period.Parse(*rrs.KeyRotationPeriod)
+		if err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(*rrs.KeyRotationPeriod, "keyRotationPeriod", err.Error()))
+		} else if keyRotationPeriod.IsNegative() || keyRotationPeriod.IsZero() {
+			errs = errs.Also(apis.ErrInvalidValue(*rrs.KeyRotationPeriod, "keyRotationPeriod", "keyRotationPeriod must be a positive duration"))
+		}
+	}
+
+	if rrs.Collect != nil {
+		errs = errs.Also(rrs.Collect.Validate(ctx).ViaField("collect"))
+
+		if rrs.Async != nil {
+			errs = errs.Also(apis.ErrMultipleOneOf("async", "collect"))
+		}
+	}
+
+	return errs
+}
+
+func (a *RequestReplyAsync) Validate(_ context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if a.CallbackAttribute == "id" ||
+		a.CallbackAttribute == "source" ||
+		a.CallbackAttribute == "specversion" ||
+		a.CallbackAttribute == "type" {
+		errs = errs.Also(apis.ErrInvalidValue(a.CallbackAttribute, "callbackAttribute", "callbackAttribute cannot be a core cloudevent attribute (id, type, specversion, source)"))
+	}
+
+	for i, callback := range a.AllowedCallbacks {
+		u, err := apis.ParseURL(callback)
+		if err != nil || u == nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") || u.User != nil {
+			errs = errs.Also(apis.ErrInvalidArrayValue(callback, "allowedCallbacks", i))
+		}
+	}
+
+	if a.MaxPending != nil && *a.MaxPending < 1 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*a.MaxPending, 1, math.MaxInt32, "maxPending"))
+	}
+
+	if a.ReplyRetention != nil {
+		retention, err := period.Parse(*a.ReplyRetention)
+		if err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(*a.ReplyRetention, "replyRetention", err.Error()))
+		} else if retention.IsNegative() || retention.IsZero() {
+			errs = errs.Also(apis.ErrInvalidValue(*a.ReplyRetention, "replyRetention", "replyRetention must be a positive duration"))
+		}
+	}
+
+	return errs
+}
+
+func (c *RequestReplyCollect) Validate(_ context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if c.Replies == nil && c.Window == nil {
+		errs = errs.Also(apis.ErrMissingOneOf("replies", "window"))
+	}
+
+	if c.Replies != nil && (*c.Replies < 1 || *c.Replies > MaxRequestReplyCollectedReplies) {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*c.Replies, 1, MaxRequestReplyCollectedReplies, "replies"))
+	}
+
+	if c.Window != nil {
+		window, err := period.Parse(*c.Window)
+		if err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(*c.Window, "window", err.Error()))
+		} else if window.IsNegative() || window.IsZero() {
+			errs = errs.Also(apis.ErrInvalidValue(*c.Window, "window", "window must be a positive duration"))
+		}
+	}
+
 	return errs
 }
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"context"
+	"math"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -87,6 +88,166 @@ func TestRequestReplyValidation(t *testing.T) {
 				return apis.ErrInvalidValue("30s", "spec.timeout", "expected 'P' period mark at the start: 30s")
 			}(),
 		},
+		{
+			name: "valid async",
+			rr: &RequestReply{
+				Spec: RequestReplySpec{
+					ReplyAttribute:       "reply",
+					CorrelationAttribute: "correlate",
+					BrokerRef: duckv1.KReference{
+						APIVersion: "eventing.knative.dev/v1",
+						Kind:       "Broker",
+						Name:       "broker",
+					},
+					Timeout: ptr.To("PT30S"),
+					Async: &RequestReplyAsync{
+						CallbackAttribute: "callback",
+						AllowedCallbacks:  []string{"https://example.com/replies/"},
+						MaxPending:        ptr.To(int32(10)),
+						ReplyRetention:    ptr.To("PT1M"),
+					},
+				},
+			},
+			want: func() *apis.FieldError {
+				return nil
+			}(),
+		},
+		{
+			name: "invalid async",
+			rr: &RequestReply{
+				Spec: RequestReplySpec{
+					ReplyAttribute:       "reply",
+					CorrelationAttribute: "correlate",
+					BrokerRef: duckv1.KReference{
+						APIVersion: "eventing.knative.dev/v1",
+						Kind:       "Broker",
+						Name:       "broker",
+					},
+					Timeout: ptr.To("PT30S"),
+					Async: &RequestReplyAsync{
+						CallbackAttribute: "source",
+						AllowedCallbacks:  []string{"https://example.com/replies/", "/relative", "ftp://example.com", "https://user@example.com"},
+						MaxPending:        ptr.To(int32(0)),
+						ReplyRetention:    ptr.To("-PT1M"),
+					},
+				},
+			},
+			want: func() *apis.FieldError {
+				var errs *apis.FieldError
+				errs = errs.Also(apis.ErrInvalidValue("source", "spec.async.callbackAttribute", "callbackAttribute cannot be a core cloudevent attribute (id, type, specversion, source)"))
+				errs = errs.Also(apis.ErrInvalidArrayValue("/relative", "spec.async.allowedCallbacks", 1))
+				errs = errs.Also(apis.ErrInvalidArrayValue("ftp://example.com", "spec.async.allowedCallbacks", 2))
+				errs = errs.Also(apis.ErrInvalidArrayValue("https://user@example.com", "spec.async.allowedCallbacks", 3))
+				errs = errs.Also(apis.ErrOutOfBoundsValue(0, 1, math.MaxInt32, "spec.async.maxPending"))
+				errs = errs.Also(apis.ErrInvalidValue("-PT1M", "spec.async.replyRetention", "replyRetention must be a positive duration"))
+				return errs
+			}(),
+		},
+		{
+			name: "invalid key rotation period",
+			rr: &RequestReply{
+				Spec: RequestReplySpec{
+					ReplyAttribute:       "reply",
+					CorrelationAttribute: "correlate",
+					BrokerRef: duckv1.KReference{
+						APIVersion: "eventing.knative.dev/v1",
+						Kind:       "Broker",
+						Name:       "broker",
+					},
+					Timeout:           ptr.To("PT30S"),
+					KeyRotationPeriod: ptr.To("PT0S"),
+				},
+			},
+			want: func() *apis.FieldError {
+				return apis.ErrInvalidValue("PT0S", "spec.keyRotationPeriod", "keyRotationPeriod must be a positive duration")
+			}(),
+		},
+		{
+			name: "valid collect",
+			rr: &RequestReply{
+				Spec: RequestReplySpec{
+					ReplyAttribute:       "reply",
+					CorrelationAttribute: "correlate",
+					BrokerRef: duckv1.KReference{
+						APIVersion: "eventing.knative.dev/v1",
+						Kind:       "Broker",
+						Name:       "broker",
+					},
+					Timeout: ptr.To("PT30S"),
+					Collect: &RequestReplyCollect{
+						Replies: ptr.To(int32(3)),
+						Window:  ptr.To("PT5S"),
+					},
+				},
+			},
+			want: func() *apis.FieldError {
+				return nil
+			}(),
+		},
+		{
+			name: "collect without replies and window",
+			rr: &RequestReply{
+				Spec: RequestReplySpec{
+					ReplyAttribute:       "reply",
+					CorrelationAttribute: "correlate",
+					BrokerRef: duckv1.KReference{
+						APIVersion: "eventing.knative.dev/v1",
+						Kind:       "Broker",
+						Name:       "broker",
+					},
+					Timeout: ptr.To("PT30S"),
+					Collect: &RequestReplyCollect{},
+				},
+			},
+			want: func() *apis.FieldError {
+				return apis.ErrMissingOneOf("spec.collect.replies", "spec.collect.window")
+			}(),
+		},
+		{
+			name: "invalid collect",
+			rr: &RequestReply{
+				Spec: RequestReplySpec{
+					ReplyAttribute:       "reply",
+					CorrelationAttribute: "correlate",
+					BrokerRef: duckv1.KReference{
+						APIVersion: "eventing.knative.dev/v1",
+						Kind:       "Broker",
+						Name:       "broker",
+					},
+					Timeout: ptr.To("PT30S"),
+					Collect: &RequestReplyCollect{
+						Replies: ptr.To(int32(1001)),
+						Window:  ptr.To("5s"),
+					},
+				},
+			},
+			want: func() *apis.FieldError {
+				var errs *apis.FieldError
+				errs = errs.Also(apis.ErrOutOfBoundsValue(1001, 1, 1000, "spec.collect.replies"))
+				errs = errs.Also(apis.ErrInvalidValue("5s", "spec.collect.window", "expected 'P' period mark at the start: 5s"))
+				return errs
+			}(),
+		},
+		{
+			name: "collect in async mode",
+			rr: &RequestReply{
+				Spec: RequestReplySpec{
+					ReplyAttribute:       "reply",
+					CorrelationAttribute: "correlate",
+					BrokerRef: duckv1.KReference{
+						APIVersion: "eventing.knative.dev/v1",
+						Kind:       "Broker",
+						Name:       "broker",
+					},
+					Timeout: ptr.To("PT30S"),
+					Async:   &RequestReplyAsync{},
+					Collect: &RequestReplyCollect{Replies: ptr.To(int32(3))},
+				},
+			},
+			want: func() *apis.FieldError {
+				return apis.ErrMultipleOneOf("spec.async", "spec.collect")
+			}(),
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
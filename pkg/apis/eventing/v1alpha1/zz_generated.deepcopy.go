@@ -29,6 +29,89 @@ import (
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterEventPolicy) DeepCopyInto(out *ClusterEventPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterEventPolicy.
+func (in *ClusterEventPolicy) DeepCopy() *ClusterEventPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterEventPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterEventPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterEventPolicyList) DeepCopyInto(out *ClusterEventPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterEventPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterEventPolicyList.
+func (in *ClusterEventPolicyList) DeepCopy() *ClusterEventPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterEventPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterEventPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterEventPolicySpec) DeepCopyInto(out *ClusterEventPolicySpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.EventPolicySpec.DeepCopyInto(&out.EventPolicySpec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterEventPolicySpec.
+func (in *ClusterEventPolicySpec) DeepCopy() *ClusterEventPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterEventPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventPolicy) DeepCopyInto(out *EventPolicy) {
 	*out = *in
@@ -156,6 +239,23 @@ func (in *EventPolicySpec) DeepCopyInto(out *EventPolicySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Deny != nil {
+		in, out := &in.Deny, &out.Deny
+		*out = make([]EventPolicySpecDeny, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(EventPolicySpecSignature)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(EventPolicySpecRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -169,6 +269,36 @@ func (in *EventPolicySpec) DeepCopy() *EventPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventPolicySpecDeny) DeepCopyInto(out *EventPolicySpecDeny) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]EventPolicySpecFrom, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]eventingv1.SubscriptionsAPIFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventPolicySpecDeny.
+func (in *EventPolicySpecDeny) DeepCopy() *EventPolicySpecDeny {
+	if in == nil {
+		return nil
+	}
+	out := new(EventPolicySpecDeny)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventPolicySpecFrom) DeepCopyInto(out *EventPolicySpecFrom) {
 	*out = *in
@@ -182,6 +312,11 @@ func (in *EventPolicySpecFrom) DeepCopyInto(out *EventPolicySpecFrom) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.SpiffeID != nil {
+		in, out := &in.SpiffeID, &out.SpiffeID
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -195,6 +330,43 @@ func (in *EventPolicySpecFrom) DeepCopy() *EventPolicySpecFrom {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventPolicySpecRateLimit) DeepCopyInto(out *EventPolicySpecRateLimit) {
+	*out = *in
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventPolicySpecRateLimit.
+func (in *EventPolicySpecRateLimit) DeepCopy() *EventPolicySpecRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(EventPolicySpecRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventPolicySpecSignature) DeepCopyInto(out *EventPolicySpecSignature) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventPolicySpecSignature.
+func (in *EventPolicySpecSignature) DeepCopy() *EventPolicySpecSignature {
+	if in == nil {
+		return nil
+	}
+	out := new(EventPolicySpecSignature)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventPolicySpecTo) DeepCopyInto(out *EventPolicySpecTo) {
 	*out = *in
@@ -230,6 +402,13 @@ func (in *EventPolicyStatus) DeepCopyInto(out *EventPolicyStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Deny != nil {
+		in, out := &in.Deny, &out.Deny
+		*out = make([]EventPolicyStatusDeny, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -243,6 +422,27 @@ func (in *EventPolicyStatus) DeepCopy() *EventPolicyStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventPolicyStatusDeny) DeepCopyInto(out *EventPolicyStatusDeny) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventPolicyStatusDeny.
+func (in *EventPolicyStatusDeny) DeepCopy() *EventPolicyStatusDeny {
+	if in == nil {
+		return nil
+	}
+	out := new(EventPolicyStatusDeny)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventPolicyToReference) DeepCopyInto(out *EventPolicyToReference) {
 	*out = *in
@@ -474,6 +674,63 @@ func (in *RequestReply) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestReplyAsync) DeepCopyInto(out *RequestReplyAsync) {
+	*out = *in
+	if in.AllowedCallbacks != nil {
+		in, out := &in.AllowedCallbacks, &out.AllowedCallbacks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxPending != nil {
+		in, out := &in.MaxPending, &out.MaxPending
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReplyRetention != nil {
+		in, out := &in.ReplyRetention, &out.ReplyRetention
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestReplyAsync.
+func (in *RequestReplyAsync) DeepCopy() *RequestReplyAsync {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestReplyAsync)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestReplyCollect) DeepCopyInto(out *RequestReplyCollect) {
+	*out = *in
+	if in.Replies != nil {
+		in, out := &in.Replies, &out.Replies
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestReplyCollect.
+func (in *RequestReplyCollect) DeepCopy() *RequestReplyCollect {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestReplyCollect)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RequestReplyList) DeepCopyInto(out *RequestReplyList) {
 	*out = *in
@@ -521,6 +778,21 @@ func (in *RequestReplySpec) DeepCopyInto(out *RequestReplySpec) {
 		*out = new(apisduckv1.DeliverySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Async != nil {
+		in, out := &in.Async, &out.Async
+		*out = new(RequestReplyAsync)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Collect != nil {
+		in, out := &in.Collect, &out.Collect
+		*out = new(RequestReplyCollect)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeyRotationPeriod != nil {
+		in, out := &in.KeyRotationPeriod, &out.KeyRotationPeriod
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -550,6 +822,11 @@ func (in *RequestReplyStatus) DeepCopyInto(out *RequestReplyStatus) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.KeyGeneration != nil {
+		in, out := &in.KeyGeneration, &out.KeyGeneration
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -71,6 +71,9 @@ const (
 	// DefaultRequestReplyTimeout is a value for RequestReplyDefaultTimeout that indicates to timeout
 	// a RequestReply resource after 30 seconds by default.
 	DefaultRequestReplyTimeout Flag = "PT30S"
+
+	// DefaultSpiffeTrustDomain is the default trust domain of the SPIFFE IDs of client certificates.
+	DefaultSpiffeTrustDomain Flag = "cluster.local"
 )
 
 // Flags is a map containing all the enabled/disabled flags for the experimental features.
@@ -170,6 +173,20 @@ func (e Flags) RequestReplyDefaultTimeout() string {
 	return string(timeout)
 }
 
+// SpiffeTrustDomain returns the trust domain the SPIFFE IDs of client certificates must be in
+func (e Flags) SpiffeTrustDomain() string {
+	if e == nil {
+		return string(DefaultSpiffeTrustDomain)
+	}
+
+	trustDomain, ok := e[SpiffeTrustDomain]
+	if !ok || trustDomain == "" {
+		return string(DefaultSpiffeTrustDomain)
+	}
+
+	return string(trustDomain)
+}
+
 func (e Flags) String() string {
 	return fmt.Sprintf("%+v", map[string]Flag(e))
 }
@@ -219,7 +236,7 @@ func NewFlagsConfigFromMap(data map[string]string) (Flags, error) {
 			flags[sanitizedKey] = AuthorizationDenyAll
 		} else if sanitizedKey == AuthorizationDefaultMode && strings.EqualFold(v, string(AuthorizationAllowSameNamespace)) {
 			flags[sanitizedKey] = AuthorizationAllowSameNamespace
-		} else if strings.Contains(k, NodeSelectorLabel) || sanitizedKey == OIDCDiscoveryBaseURL {
+		} else if strings.Contains(k, NodeSelectorLabel) || sanitizedKey == OIDCDiscoveryBaseURL || sanitizedKey == SpiffeTrustDomain {
 			flags[sanitizedKey] = Flag(v)
 		} else {
 			flags[k] = Flag(v)
@@ -63,6 +63,7 @@ func TestGetFlags(t *testing.T) {
 	require.Equal(t, expectedNodeSelector, nodeSelector)
 
 	require.Equal(t, flags.OIDCDiscoveryBaseURL(), "https://oidc.eks.eu-west-1.amazonaws.com/id/1")
+	require.Equal(t, "cluster.local", flags.SpiffeTrustDomain())
 }
 
 func TestShouldNotOverrideDefaults(t *testing.T) {
@@ -30,4 +30,5 @@ const (
 	AuthorizationDefaultMode   = "default-authorization-mode"
 	OIDCDiscoveryBaseURL       = "oidc-discovery-base-url"
 	RequestReplyDefaultTimeout = "requestreply-default-timeout"
+	SpiffeTrustDomain          = "spiffe-trust-domain"
 )
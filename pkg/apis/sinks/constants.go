@@ -17,7 +17,38 @@ limitations under the License.
 package sinks
 
 const (
-	JobSinkJobsLabelSelector = "sinks.knative.dev/job-sink=true"
+	JobSinkJobsLabel         = "sinks.knative.dev/job-sink"
+	JobSinkJobsLabelSelector = JobSinkJobsLabel + "=true"
 	JobSinkNameLabel         = "sinks.knative.dev/job-sink-name"
 	JobSinkIDLabel           = "sinks.knative.dev/job-sink-id"
+
+	// JobSinkQueuedLabel marks the event Secrets whose Job is not created
+	// yet, because the JobSink runs its maximum number of concurrent Jobs.
+	JobSinkQueuedLabel         = "sinks.knative.dev/job-sink-queued"
+	JobSinkQueuedLabelSelector = JobSinkQueuedLabel + "=true"
+
+	// JobSinkBatchLabel holds the name of the batch Job of the queued events
+	// collected into a batch, and of the batch Job itself.
+	JobSinkBatchLabel = "sinks.knative.dev/job-sink-batch"
+
+	// JobSinkEventIDAnnotation and JobSinkEventSourceAnnotation hold the ID
+	// and the source of the event a Job was created for.
+	JobSinkEventIDAnnotation     = "sinks.knative.dev/event-id"
+	JobSinkEventSourceAnnotation = "sinks.knative.dev/event-source"
+
+	// JobSinkBatchSizeAnnotation holds the number of events of a batch Job.
+	JobSinkBatchSizeAnnotation = "sinks.knative.dev/batch-size"
+
+	// JobSinkCompletionEventAnnotation holds the completion event of a
+	// finished Job.
+	JobSinkCompletionEventAnnotation = "sinks.knative.dev/completion-event"
+
+	// JobSinkCompletionSentAnnotation holds the time the completion event of
+	// a finished Job was sent to the completion sink.
+	JobSinkCompletionSentAnnotation = "sinks.knative.dev/completion-sent"
+
+	// JobSinkCompletionSigningKeySecretName is the name of the optional
+	// Secret in the system namespace holding the key completion events are
+	// signed with.
+	JobSinkCompletionSigningKeySecretName = "job-sink-completion-signing-key" //nolint:gosec // This is not a hardcoded credential
 )
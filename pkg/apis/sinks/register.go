@@ -39,6 +39,18 @@ var (
 		Group:    GroupName,
 		Resource: "integrationsinks",
 	}
+
+	// StreamSinkResource respresents a Knative Eventing sink StreamSink
+	StreamSinkResource = schema.GroupResource{
+		Group:    GroupName,
+		Resource: "streamsinks",
+	}
+
+	// PullSinkResource respresents a Knative Eventing sink PullSink
+	PullSinkResource = schema.GroupResource{
+		Group:    GroupName,
+		Resource: "pullsinks",
+	}
 )
 
 type Config struct {
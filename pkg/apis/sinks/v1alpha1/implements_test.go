@@ -30,6 +30,10 @@ func TestTypesImplements(t *testing.T) {
 	}{
 		{instance: &JobSink{}, iface: &duckv1.Conditions{}},
 		{instance: &JobSink{}, iface: &duckv1.Addressable{}},
+		{instance: &StreamSink{}, iface: &duckv1.Conditions{}},
+		{instance: &StreamSink{}, iface: &duckv1.Addressable{}},
+		{instance: &PullSink{}, iface: &duckv1.Conditions{}},
+		{instance: &PullSink{}, iface: &duckv1.Addressable{}},
 	}
 	for _, tc := range testCases {
 		if err := duck.VerifyType(tc.instance, tc.iface); err != nil {
@@ -21,12 +21,29 @@ import (
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
 )
 
 func (sink *JobSink) SetDefaults(ctx context.Context) {
 	if sink.Spec.Job != nil {
 		setBatchJobDefaults(sink.Spec.Job)
 	}
+	if sink.Spec.Completion != nil && sink.Spec.Completion.Sink != nil {
+		sink.Spec.Completion.Sink.SetDefaults(apis.WithinParent(ctx, sink.ObjectMeta))
+	}
+	if sink.Spec.Batching != nil {
+		sink.Spec.Batching.SetDefaults(ctx)
+	}
+}
+
+func (b *JobSinkBatching) SetDefaults(_ context.Context) {
+	if b.MaxEvents == nil {
+		b.MaxEvents = ptr.To(DefaultJobSinkBatchMaxEvents)
+	}
+	if b.MaxWait == nil {
+		b.MaxWait = ptr.To(DefaultJobSinkBatchMaxWait)
+	}
 }
 
 func setBatchJobDefaults(job *batchv1.Job) {
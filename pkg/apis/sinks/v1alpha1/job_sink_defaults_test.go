@@ -23,6 +23,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestSetDefaults(t *testing.T) {
@@ -125,6 +126,36 @@ func TestSetDefaults(t *testing.T) {
 				},
 			},
 		},
+		"batching": {
+			initial: JobSink{
+				Spec: JobSinkSpec{
+					Batching: &JobSinkBatching{},
+				},
+			},
+			expected: JobSink{
+				Spec: JobSinkSpec{
+					Batching: &JobSinkBatching{
+						MaxEvents: ptr.To(DefaultJobSinkBatchMaxEvents),
+						MaxWait:   ptr.To(DefaultJobSinkBatchMaxWait),
+					},
+				},
+			},
+		},
+		"batching max events set": {
+			initial: JobSink{
+				Spec: JobSinkSpec{
+					Batching: &JobSinkBatching{MaxEvents: ptr.To[int32](50)},
+				},
+			},
+			expected: JobSink{
+				Spec: JobSinkSpec{
+					Batching: &JobSinkBatching{
+						MaxEvents: ptr.To[int32](50),
+						MaxWait:   ptr.To(DefaultJobSinkBatchMaxWait),
+					},
+				},
+			},
+		},
 	}
 	for n, tc := range testCases {
 		t.Run(n, func(t *testing.T) {
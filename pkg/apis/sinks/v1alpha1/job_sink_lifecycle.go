@@ -35,11 +35,29 @@ const (
 	// JobSinkConditionEventPoliciesReady has status True when all the applying EventPolicies for this
 	// JobSink are ready.
 	JobSinkConditionEventPoliciesReady apis.ConditionType = "EventPoliciesReady"
+
+	// JobSinkConditionCompletionSinkResolved has status True when the sink the completion
+	// events are sent to is resolved, or when there is none.
+	JobSinkConditionCompletionSinkResolved apis.ConditionType = "CompletionSinkResolved"
+
+	// JobSinkConditionOIDCIdentityCreated has status True when the OIDC identity used to send
+	// the completion events is created.
+	JobSinkConditionOIDCIdentityCreated apis.ConditionType = "OIDCIdentityCreated"
+)
+
+const (
+	// JobSinkJobSucceededEventType is the type of the event emitted when a Job succeeds.
+	JobSinkJobSucceededEventType = "dev.knative.sinks.jobsink.job.succeeded"
+
+	// JobSinkJobFailedEventType is the type of the event emitted when a Job fails.
+	JobSinkJobFailedEventType = "dev.knative.sinks.jobsink.job.failed"
 )
 
 var JobSinkCondSet = apis.NewLivingConditionSet(
 	JobSinkConditionAddressable,
 	JobSinkConditionEventPoliciesReady,
+	JobSinkConditionCompletionSinkResolved,
+	JobSinkConditionOIDCIdentityCreated,
 )
 
 // GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
@@ -47,6 +65,11 @@ func (*JobSink) GetConditionSet() apis.ConditionSet {
 	return JobSinkCondSet
 }
 
+// JobSinkSource returns the JobSink CloudEvent source value.
+func JobSinkSource(namespace, name string) string {
+	return fmt.Sprintf("/apis/v1alpha1/namespaces/%s/jobsinks/%s", namespace, name)
+}
+
 // GetUntypedSpec returns the spec of the JobSink.
 func (sink *JobSink) GetUntypedSpec() interface{} {
 	return sink.Spec
@@ -117,3 +140,43 @@ func (s *JobSinkStatus) SetAddress(address *duckv1.Addressable) {
 
 	}
 }
+
+// MarkCompletionSinkResolved sets the completion sink of the JobSink and
+// marks the CompletionSinkResolved condition to True.
+func (s *JobSinkStatus) MarkCompletionSinkResolved(addr *duckv1.Addressable) {
+	s.Completion = &JobSinkCompletionStatus{
+		SinkURI:      addr.URL,
+		SinkCACerts:  addr.CACerts,
+		SinkAudience: addr.Audience,
+	}
+	JobSinkCondSet.Manage(s).MarkTrue(JobSinkConditionCompletionSinkResolved)
+}
+
+// MarkCompletionSinkNotConfigured clears the completion sink of the JobSink
+// and marks the CompletionSinkResolved condition to True.
+func (s *JobSinkStatus) MarkCompletionSinkNotConfigured() {
+	s.Completion = nil
+	JobSinkCondSet.Manage(s).MarkTrueWithReason(JobSinkConditionCompletionSinkResolved, "CompletionSinkNotConfigured", "No completion sink is configured.")
+}
+
+// MarkCompletionSinkFailed clears the completion sink of the JobSink and
+// marks the CompletionSinkResolved condition to False.
+func (s *JobSinkStatus) MarkCompletionSinkFailed(reason, messageFormat string, messageA ...interface{}) {
+	s.Completion = nil
+	JobSinkCondSet.Manage(s).MarkFalse(JobSinkConditionCompletionSinkResolved, reason, messageFormat, messageA...)
+}
+
+// MarkOIDCIdentityCreatedSucceeded marks the OIDCIdentityCreated condition to True.
+func (s *JobSinkStatus) MarkOIDCIdentityCreatedSucceeded() {
+	JobSinkCondSet.Manage(s).MarkTrue(JobSinkConditionOIDCIdentityCreated)
+}
+
+// MarkOIDCIdentityCreatedSucceededWithReason marks the OIDCIdentityCreated condition to True with the given reason and message.
+func (s *JobSinkStatus) MarkOIDCIdentityCreatedSucceededWithReason(reason, messageFormat string, messageA ...interface{}) {
+	JobSinkCondSet.Manage(s).MarkTrueWithReason(JobSinkConditionOIDCIdentityCreated, reason, messageFormat, messageA...)
+}
+
+// MarkOIDCIdentityCreatedFailed marks the OIDCIdentityCreated condition to False with the given reason and message.
+func (s *JobSinkStatus) MarkOIDCIdentityCreatedFailed(reason, messageFormat string, messageA ...interface{}) {
+	JobSinkCondSet.Manage(s).MarkFalse(JobSinkConditionOIDCIdentityCreated, reason, messageFormat, messageA...)
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
@@ -46,9 +47,15 @@ func TestJobSinkInitializeConditions(t *testing.T) {
 				Conditions: []apis.Condition{{
 					Type:   JobSinkConditionAddressable,
 					Status: corev1.ConditionUnknown,
+				}, {
+					Type:   JobSinkConditionCompletionSinkResolved,
+					Status: corev1.ConditionUnknown,
 				}, {
 					Type:   JobSinkConditionEventPoliciesReady,
 					Status: corev1.ConditionUnknown,
+				}, {
+					Type:   JobSinkConditionOIDCIdentityCreated,
+					Status: corev1.ConditionUnknown,
 				}, {
 					Type:   JobSinkConditionReady,
 					Status: corev1.ConditionUnknown,
@@ -70,9 +77,15 @@ func TestJobSinkInitializeConditions(t *testing.T) {
 				Conditions: []apis.Condition{{
 					Type:   JobSinkConditionAddressable,
 					Status: corev1.ConditionFalse,
+				}, {
+					Type:   JobSinkConditionCompletionSinkResolved,
+					Status: corev1.ConditionUnknown,
 				}, {
 					Type:   JobSinkConditionEventPoliciesReady,
 					Status: corev1.ConditionUnknown,
+				}, {
+					Type:   JobSinkConditionOIDCIdentityCreated,
+					Status: corev1.ConditionUnknown,
 				}, {
 					Type:   JobSinkConditionReady,
 					Status: corev1.ConditionUnknown,
@@ -94,9 +107,15 @@ func TestJobSinkInitializeConditions(t *testing.T) {
 				Conditions: []apis.Condition{{
 					Type:   JobSinkConditionAddressable,
 					Status: corev1.ConditionTrue,
+				}, {
+					Type:   JobSinkConditionCompletionSinkResolved,
+					Status: corev1.ConditionUnknown,
 				}, {
 					Type:   JobSinkConditionEventPoliciesReady,
 					Status: corev1.ConditionUnknown,
+				}, {
+					Type:   JobSinkConditionOIDCIdentityCreated,
+					Status: corev1.ConditionUnknown,
 				}, {
 					Type:   JobSinkConditionReady,
 					Status: corev1.ConditionUnknown,
@@ -114,3 +133,31 @@ func TestJobSinkInitializeConditions(t *testing.T) {
 		})
 	}
 }
+
+func TestJobSinkCompletionSink(t *testing.T) {
+	s := &JobSinkStatus{}
+	s.InitializeConditions()
+
+	url := apis.HTTP("completion-sink.ns.svc.cluster.local")
+	s.MarkCompletionSinkResolved(&duckv1.Addressable{URL: url, Audience: ptr.To("audience")})
+	if !s.GetCondition(JobSinkConditionCompletionSinkResolved).IsTrue() {
+		t.Errorf("expected %s to be true", JobSinkConditionCompletionSinkResolved)
+	}
+	want := &JobSinkCompletionStatus{SinkURI: url, SinkAudience: ptr.To("audience")}
+	if diff := cmp.Diff(want, s.Completion); diff != "" {
+		t.Error("unexpected completion status (-want, +got) =", diff)
+	}
+
+	s.MarkCompletionSinkFailed("Failed", "failed to resolve")
+	if !s.GetCondition(JobSinkConditionCompletionSinkResolved).IsFalse() {
+		t.Errorf("expected %s to be false", JobSinkConditionCompletionSinkResolved)
+	}
+	if s.Completion != nil {
+		t.Errorf("expected completion status to be cleared, got %v", s.Completion)
+	}
+
+	s.MarkCompletionSinkNotConfigured()
+	if !s.GetCondition(JobSinkConditionCompletionSinkResolved).IsTrue() {
+		t.Errorf("expected %s to be true", JobSinkConditionCompletionSinkResolved)
+	}
+}
@@ -32,6 +32,20 @@ const (
 	ExecutionModeEnvVar = "K_EXECUTION_MODE"
 )
 
+const (
+	// DefaultJobSinkBatchMaxEvents is the default maximum number of events
+	// of a batch.
+	DefaultJobSinkBatchMaxEvents int32 = 10
+
+	// DefaultJobSinkBatchMaxWait is the default maximum time an event waits
+	// for its batch to be full.
+	DefaultJobSinkBatchMaxWait = "PT10S"
+
+	// MaxJobSinkBatchMaxEvents is the upper bound of the maximum number of
+	// events of a batch, which are all held by a single Secret.
+	MaxJobSinkBatchMaxEvents int32 = 1000
+)
+
 type ExecutionMode string
 
 const (
@@ -68,6 +82,69 @@ type JobSinkSpec struct {
 	// Job to run when an event occur.
 	// +optional
 	Job *batchv1.Job `json:"job,omitempty"`
+
+	// Completion configures the events emitted when the Jobs finish.
+	// +optional
+	Completion *JobSinkCompletion `json:"completion,omitempty"`
+
+	// MaxConcurrentJobs is the maximum number of Jobs running at the same
+	// time. The events received while the limit is reached are queued and
+	// their Jobs are created as the running Jobs finish.
+	// +optional
+	MaxConcurrentJobs *int32 `json:"maxConcurrentJobs,omitempty"`
+
+	// Retention configures when finished Jobs and their events are deleted.
+	// +optional
+	Retention *JobSinkRetention `json:"retention,omitempty"`
+
+	// Batching configures the JobSink to run one Job for a batch of events
+	// instead of one Job per event.
+	// +optional
+	Batching *JobSinkBatching `json:"batching,omitempty"`
+}
+
+// JobSinkBatching configures how a JobSink collects events into batches.
+// The events of a batch are mounted in its Job as a JSON-lines file, with
+// one event per line, in their order of arrival.
+type JobSinkBatching struct {
+	// MaxEvents is the maximum number of events of a batch. A batch is
+	// started as soon as it is full. The events of a batch must fit in a
+	// single Secret, so a batch is also started as soon as its next event
+	// would exceed 1 MiB, and larger events are rejected.
+	// +optional
+	MaxEvents *int32 `json:"maxEvents,omitempty"`
+
+	// MaxWait is the maximum time an event waits for its batch to be full
+	// before the batch is started anyway, expressed as an ISO-8601 duration.
+	// +optional
+	MaxWait *string `json:"maxWait,omitempty"`
+}
+
+// JobSinkRetention configures the garbage collection of the finished Jobs
+// of a JobSink. A finished Job is deleted, along with its event, as soon as
+// one of the configured limits is exceeded.
+type JobSinkRetention struct {
+	// TTL is how long finished Jobs are kept, expressed as an ISO-8601
+	// duration, like PT1H.
+	// +optional
+	TTL *string `json:"ttl,omitempty"`
+
+	// KeepLast is the number of most recently finished Jobs kept.
+	// +optional
+	KeepLast *int32 `json:"keepLast,omitempty"`
+}
+
+// JobSinkCompletion configures the events a JobSink emits when its Jobs
+// finish, telling whether they succeeded or failed, their duration, the exit
+// code of their failed container and the termination message of their
+// containers as result. The completion events are signed with the key of the
+// job-sink-completion-signing-key Secret of the system namespace, when it
+// exists.
+type JobSinkCompletion struct {
+	// Sink is where the completion events are sent. The completion event of a
+	// Job is also returned as the reply to the requests for its status.
+	// +optional
+	Sink *duckv1.Destination `json:"sink,omitempty"`
 }
 
 // JobSinkStatus defines the observed state of JobSink.
@@ -85,6 +162,32 @@ type JobSinkStatus struct {
 	// AppliedEventPoliciesStatus contains the list of EventPolicies which apply to this JobSink
 	// +optional
 	eventingduckv1.AppliedEventPoliciesStatus `json:",inline"`
+
+	// Completion contains the resolved address of the sink the completion
+	// events are sent to.
+	// +optional
+	Completion *JobSinkCompletionStatus `json:"completion,omitempty"`
+
+	// Auth provides the relevant information for OIDC authentication.
+	// +optional
+	Auth *duckv1.AuthStatus `json:"auth,omitempty"`
+}
+
+// JobSinkCompletionStatus is the resolved address of the sink the completion
+// events of a JobSink are sent to.
+type JobSinkCompletionStatus struct {
+	// SinkURI is the resolved URI of the completion sink.
+	// +optional
+	SinkURI *apis.URL `json:"sinkUri,omitempty"`
+
+	// SinkCACerts are Certification Authority (CA) certificates in PEM format
+	// that the JobSink trusts when sending completion events.
+	// +optional
+	SinkCACerts *string `json:"sinkCACerts,omitempty"`
+
+	// SinkAudience is the OIDC audience of the completion sink.
+	// +optional
+	SinkAudience *string `json:"sinkAudience,omitempty"`
 }
 
 type JobStatus struct {
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"context"
 
+	"github.com/rickb777/date/period"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/storage/names"
 	"knative.dev/pkg/apis"
@@ -38,6 +39,22 @@ func (sink *JobSinkSpec) Validate(ctx context.Context) *apis.FieldError {
 		return errs.Also(apis.ErrMissingOneOf("job"))
 	}
 
+	if sink.Completion != nil && sink.Completion.Sink != nil {
+		errs = errs.Also(sink.Completion.Sink.Validate(ctx).ViaField("completion", "sink"))
+	}
+
+	if sink.MaxConcurrentJobs != nil && *sink.MaxConcurrentJobs < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(*sink.MaxConcurrentJobs, "maxConcurrentJobs", "must be greater than 0"))
+	}
+
+	if sink.Retention != nil {
+		errs = errs.Also(sink.Retention.Validate(ctx).ViaField("retention"))
+	}
+
+	if sink.Batching != nil {
+		errs = errs.Also(sink.Batching.Validate(ctx).ViaField("batching"))
+	}
+
 	if sink.Job != nil {
 		job := sink.Job.DeepCopy()
 		job.Name = names.SimpleNameGenerator.GenerateName(apis.ParentMeta(ctx).Name)
@@ -49,7 +66,41 @@ func (sink *JobSinkSpec) Validate(ctx context.Context) *apis.FieldError {
 				FieldValidation: metav1.FieldValidationStrict,
 			})
 		if err != nil {
-			return apis.ErrGeneric(err.Error(), "job")
+			return errs.Also(apis.ErrGeneric(err.Error(), "job"))
+		}
+	}
+
+	return errs
+}
+
+func (r *JobSinkRetention) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if r.TTL != nil {
+		p, err := period.Parse(*r.TTL)
+		if err != nil || p.IsNegative() {
+			errs = errs.Also(apis.ErrInvalidValue(*r.TTL, "ttl"))
+		}
+	}
+
+	if r.KeepLast != nil && *r.KeepLast < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*r.KeepLast, "keepLast", "must not be negative"))
+	}
+
+	return errs
+}
+
+func (b *JobSinkBatching) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if b.MaxEvents != nil && (*b.MaxEvents < 1 || *b.MaxEvents > MaxJobSinkBatchMaxEvents) {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*b.MaxEvents, 1, MaxJobSinkBatchMaxEvents, "maxEvents"))
+	}
+
+	if b.MaxWait != nil {
+		p, err := period.Parse(*b.MaxWait)
+		if err != nil || p.IsNegative() || p.IsZero() {
+			errs = errs.Also(apis.ErrInvalidValue(*b.MaxWait, "maxWait"))
 		}
 	}
 
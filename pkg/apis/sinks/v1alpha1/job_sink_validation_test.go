@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
 	"knative.dev/pkg/apis"
 )
 
@@ -45,3 +46,70 @@ func TestValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestJobSinkRetentionValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		retention JobSinkRetention
+		want      *apis.FieldError
+	}{{
+		name:      "valid",
+		retention: JobSinkRetention{TTL: ptr.To("PT1H"), KeepLast: ptr.To[int32](10)},
+	}, {
+		name:      "keep none",
+		retention: JobSinkRetention{KeepLast: ptr.To[int32](0)},
+	}, {
+		name:      "invalid ttl",
+		retention: JobSinkRetention{TTL: ptr.To("1h")},
+		want:      apis.ErrInvalidValue("1h", "ttl"),
+	}, {
+		name:      "negative ttl",
+		retention: JobSinkRetention{TTL: ptr.To("-PT1H")},
+		want:      apis.ErrInvalidValue("-PT1H", "ttl"),
+	}, {
+		name:      "negative keepLast",
+		retention: JobSinkRetention{KeepLast: ptr.To[int32](-1)},
+		want:      apis.ErrInvalidValue(-1, "keepLast", "must not be negative"),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.retention.Validate(context.TODO())
+			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+				t.Error("JobSinkRetention.Validate (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestJobSinkBatchingValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		batching JobSinkBatching
+		want     *apis.FieldError
+	}{{
+		name:     "valid",
+		batching: JobSinkBatching{MaxEvents: ptr.To[int32](100), MaxWait: ptr.To("PT30S")},
+	}, {
+		name:     "no events",
+		batching: JobSinkBatching{MaxEvents: ptr.To[int32](0)},
+		want:     apis.ErrOutOfBoundsValue(0, 1, MaxJobSinkBatchMaxEvents, "maxEvents"),
+	}, {
+		name:     "too many events",
+		batching: JobSinkBatching{MaxEvents: ptr.To(MaxJobSinkBatchMaxEvents + 1)},
+		want:     apis.ErrOutOfBoundsValue(MaxJobSinkBatchMaxEvents+1, 1, MaxJobSinkBatchMaxEvents, "maxEvents"),
+	}, {
+		name:     "zero wait",
+		batching: JobSinkBatching{MaxWait: ptr.To("PT0S")},
+		want:     apis.ErrInvalidValue("PT0S", "maxWait"),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.batching.Validate(context.TODO())
+			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+				t.Error("JobSinkBatching.Validate (-want, +got) =", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+)
+
+const (
+	// PullSinkConditionReady has status True when the PullSink is ready to buffer events.
+	PullSinkConditionReady = apis.ConditionReady
+
+	// PullSinkConditionAddressable has status True when the PullSink has an address.
+	PullSinkConditionAddressable apis.ConditionType = "Addressable"
+
+	// PullSinkConditionEventPoliciesReady has status True when all the applying EventPolicies for this
+	// PullSink are ready.
+	PullSinkConditionEventPoliciesReady apis.ConditionType = "EventPoliciesReady"
+
+	// PullSinkConditionDeadLetterSinkResolved has status True when the dead letter sink of the
+	// PullSink is resolved, or when there is none.
+	PullSinkConditionDeadLetterSinkResolved apis.ConditionType = "DeadLetterSinkResolved"
+
+	// PullSinkConditionOIDCIdentityCreated has status True when the OIDC identity used to send
+	// events to the dead letter sink is created.
+	PullSinkConditionOIDCIdentityCreated apis.ConditionType = "OIDCIdentityCreated"
+)
+
+var PullSinkCondSet = apis.NewLivingConditionSet(
+	PullSinkConditionAddressable,
+	PullSinkConditionEventPoliciesReady,
+	PullSinkConditionDeadLetterSinkResolved,
+	PullSinkConditionOIDCIdentityCreated,
+)
+
+// GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
+func (*PullSink) GetConditionSet() apis.ConditionSet {
+	return PullSinkCondSet
+}
+
+// GetUntypedSpec returns the spec of the PullSink.
+func (sink *PullSink) GetUntypedSpec() interface{} {
+	return sink.Spec
+}
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (sink *PullSink) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("PullSink")
+}
+
+// GetCondition returns the condition currently associated with the given type, or nil.
+func (s *PullSinkStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return PullSinkCondSet.Manage(s).GetCondition(t)
+}
+
+// GetTopLevelCondition returns the top level Condition.
+func (s *PullSinkStatus) GetTopLevelCondition() *apis.Condition {
+	return PullSinkCondSet.Manage(s).GetTopLevelCondition()
+}
+
+// IsReady returns true if the resource is ready overall.
+func (s *PullSinkStatus) IsReady() bool {
+	return PullSinkCondSet.Manage(s).IsHappy()
+}
+
+// InitializeConditions sets relevant unset conditions to Unknown state.
+func (s *PullSinkStatus) InitializeConditions() {
+	PullSinkCondSet.Manage(s).InitializeConditions()
+}
+
+// MarkEventPoliciesFailed marks the EventPoliciesReady condition to False with the given reason and message.
+func (s *PullSinkStatus) MarkEventPoliciesFailed(reason, messageFormat string, messageA ...interface{}) {
+	PullSinkCondSet.Manage(s).MarkFalse(PullSinkConditionEventPoliciesReady, reason, messageFormat, messageA...)
+}
+
+// MarkEventPoliciesUnknown marks the EventPoliciesReady condition to Unknown with the given reason and message.
+func (s *PullSinkStatus) MarkEventPoliciesUnknown(reason, messageFormat string, messageA ...interface{}) {
+	PullSinkCondSet.Manage(s).MarkUnknown(PullSinkConditionEventPoliciesReady, reason, messageFormat, messageA...)
+}
+
+// MarkEventPoliciesTrue marks the EventPoliciesReady condition to True.
+func (s *PullSinkStatus) MarkEventPoliciesTrue() {
+	PullSinkCondSet.Manage(s).MarkTrue(PullSinkConditionEventPoliciesReady)
+}
+
+// MarkEventPoliciesTrueWithReason marks the EventPoliciesReady condition to True with the given reason and message.
+func (s *PullSinkStatus) MarkEventPoliciesTrueWithReason(reason, messageFormat string, messageA ...interface{}) {
+	PullSinkCondSet.Manage(s).MarkTrueWithReason(PullSinkConditionEventPoliciesReady, reason, messageFormat, messageA...)
+}
+
+// MarkDeadLetterSinkResolvedSucceeded sets the dead letter sink of the PullSink
+// and marks the DeadLetterSinkResolved condition to True.
+func (s *PullSinkStatus) MarkDeadLetterSinkResolvedSucceeded(deliveryStatus eventingduckv1.DeliveryStatus) {
+	s.DeliveryStatus = deliveryStatus
+	PullSinkCondSet.Manage(s).MarkTrue(PullSinkConditionDeadLetterSinkResolved)
+}
+
+// MarkDeadLetterSinkNotConfigured clears the dead letter sink of the PullSink
+// and marks the DeadLetterSinkResolved condition to True.
+func (s *PullSinkStatus) MarkDeadLetterSinkNotConfigured() {
+	s.DeliveryStatus = eventingduckv1.DeliveryStatus{}
+	PullSinkCondSet.Manage(s).MarkTrueWithReason(PullSinkConditionDeadLetterSinkResolved, "DeadLetterSinkNotConfigured", "No dead letter sink is configured.")
+}
+
+// MarkDeadLetterSinkResolvedFailed clears the dead letter sink of the PullSink
+// and marks the DeadLetterSinkResolved condition to False.
+func (s *PullSinkStatus) MarkDeadLetterSinkResolvedFailed(reason, messageFormat string, messageA ...interface{}) {
+	s.DeliveryStatus = eventingduckv1.DeliveryStatus{}
+	PullSinkCondSet.Manage(s).MarkFalse(PullSinkConditionDeadLetterSinkResolved, reason, messageFormat, messageA...)
+}
+
+// MarkOIDCIdentityCreatedSucceeded marks the OIDCIdentityCreated condition to True.
+func (s *PullSinkStatus) MarkOIDCIdentityCreatedSucceeded() {
+	PullSinkCondSet.Manage(s).MarkTrue(PullSinkConditionOIDCIdentityCreated)
+}
+
+// MarkOIDCIdentityCreatedSucceededWithReason marks the OIDCIdentityCreated condition to True with the given reason and message.
+func (s *PullSinkStatus) MarkOIDCIdentityCreatedSucceededWithReason(reason, messageFormat string, messageA ...interface{}) {
+	PullSinkCondSet.Manage(s).MarkTrueWithReason(PullSinkConditionOIDCIdentityCreated, reason, messageFormat, messageA...)
+}
+
+// MarkOIDCIdentityCreatedFailed marks the OIDCIdentityCreated condition to False with the given reason and message.
+func (s *PullSinkStatus) MarkOIDCIdentityCreatedFailed(reason, messageFormat string, messageA ...interface{}) {
+	PullSinkCondSet.Manage(s).MarkFalse(PullSinkConditionOIDCIdentityCreated, reason, messageFormat, messageA...)
+}
+
+// MarkOIDCIdentityCreatedUnknown marks the OIDCIdentityCreated condition to Unknown with the given reason and message.
+func (s *PullSinkStatus) MarkOIDCIdentityCreatedUnknown(reason, messageFormat string, messageA ...interface{}) {
+	PullSinkCondSet.Manage(s).MarkUnknown(PullSinkConditionOIDCIdentityCreated, reason, messageFormat, messageA...)
+}
+
+// SetAddress sets the address of the PullSink and marks the Addressable
+// condition accordingly.
+func (s *PullSinkStatus) SetAddress(address *duckv1.Addressable) {
+	s.Address = address
+	if address == nil || address.URL.IsEmpty() {
+		PullSinkCondSet.Manage(s).MarkFalse(PullSinkConditionAddressable, "EmptyHostname", "hostname is the empty string")
+	} else {
+		PullSinkCondSet.Manage(s).MarkTrue(PullSinkConditionAddressable)
+	}
+}
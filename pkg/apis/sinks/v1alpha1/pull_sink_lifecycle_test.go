@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+)
+
+func TestPullSinkGetConditionSet(t *testing.T) {
+	r := &PullSink{}
+
+	if got, want := r.GetConditionSet().GetTopLevelConditionType(), apis.ConditionReady; got != want {
+		t.Errorf("GetTopLevelCondition=%v, want=%v", got, want)
+	}
+}
+
+func TestPullSinkInitializeConditions(t *testing.T) {
+	s := &PullSinkStatus{}
+	s.InitializeConditions()
+
+	want := &PullSinkStatus{
+		Status: duckv1.Status{
+			Conditions: []apis.Condition{{
+				Type:   PullSinkConditionAddressable,
+				Status: corev1.ConditionUnknown,
+			}, {
+				Type:   PullSinkConditionDeadLetterSinkResolved,
+				Status: corev1.ConditionUnknown,
+			}, {
+				Type:   PullSinkConditionEventPoliciesReady,
+				Status: corev1.ConditionUnknown,
+			}, {
+				Type:   PullSinkConditionOIDCIdentityCreated,
+				Status: corev1.ConditionUnknown,
+			}, {
+				Type:   PullSinkConditionReady,
+				Status: corev1.ConditionUnknown,
+			}},
+		},
+	}
+	if diff := cmp.Diff(want, s, ignoreAllButTypeAndStatus); diff != "" {
+		t.Error("unexpected conditions (-want, +got) =", diff)
+	}
+}
+
+func TestPullSinkReady(t *testing.T) {
+	address := &duckv1.Addressable{URL: apis.HTTP("pull-sink.knative-eventing.svc")}
+	dls := eventingduckv1.DeliveryStatus{DeadLetterSinkURI: apis.HTTP("dls.ns.svc")}
+
+	tests := []struct {
+		name string
+		mark func(s *PullSinkStatus)
+		want bool
+	}{{
+		name: "all ready",
+		mark: func(s *PullSinkStatus) {
+			s.SetAddress(address)
+			s.MarkEventPoliciesTrue()
+			s.MarkDeadLetterSinkResolvedSucceeded(dls)
+			s.MarkOIDCIdentityCreatedSucceeded()
+		},
+		want: true,
+	}, {
+		name: "no dead letter sink",
+		mark: func(s *PullSinkStatus) {
+			s.SetAddress(address)
+			s.MarkEventPoliciesTrue()
+			s.MarkDeadLetterSinkNotConfigured()
+			s.MarkOIDCIdentityCreatedSucceeded()
+		},
+		want: true,
+	}, {
+		name: "dead letter sink not resolved",
+		mark: func(s *PullSinkStatus) {
+			s.SetAddress(address)
+			s.MarkEventPoliciesTrue()
+			s.MarkDeadLetterSinkResolvedFailed("Unresolved", "")
+			s.MarkOIDCIdentityCreatedSucceeded()
+		},
+		want: false,
+	}, {
+		name: "not addressable",
+		mark: func(s *PullSinkStatus) {
+			s.SetAddress(nil)
+			s.MarkEventPoliciesTrue()
+			s.MarkDeadLetterSinkNotConfigured()
+			s.MarkOIDCIdentityCreatedSucceeded()
+		},
+		want: false,
+	}, {
+		name: "OIDC identity not created",
+		mark: func(s *PullSinkStatus) {
+			s.SetAddress(address)
+			s.MarkEventPoliciesTrue()
+			s.MarkDeadLetterSinkNotConfigured()
+			s.MarkOIDCIdentityCreatedFailed("Failed", "")
+		},
+		want: false,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &PullSinkStatus{}
+			s.InitializeConditions()
+			test.mark(s)
+			if got := s.IsReady(); got != test.want {
+				t.Errorf("IsReady() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPullSinkDeadLetterSinkResolvedFailedClearsDeliveryStatus(t *testing.T) {
+	s := &PullSinkStatus{}
+	s.MarkDeadLetterSinkResolvedSucceeded(eventingduckv1.DeliveryStatus{DeadLetterSinkURI: apis.HTTP("dls.ns.svc")})
+	s.MarkDeadLetterSinkResolvedFailed("Unresolved", "")
+
+	if s.DeliveryStatus.IsSet() {
+		t.Errorf("DeliveryStatus = %v, want empty", s.DeliveryStatus)
+	}
+}
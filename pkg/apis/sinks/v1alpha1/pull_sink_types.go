@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/kmeta"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+)
+
+const (
+	// DefaultPullSinkLeaseDuration is the default duration of the leases of
+	// the events pulled from a PullSink.
+	DefaultPullSinkLeaseDuration = "PT30S"
+
+	// DefaultPullSinkCapacity is the default maximum number of events
+	// buffered by a PullSink.
+	DefaultPullSinkCapacity int32 = 1000
+
+	// MaxPullSinkCapacity is the maximum number of events a PullSink can be
+	// configured to buffer.
+	MaxPullSinkCapacity int32 = 100000
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:defaulter-gen=true
+
+// PullSink is a sink buffering the events it receives until consumers, which
+// can't receive events pushed to them, pull and acknowledge them. Events are
+// buffered in memory only, so delivery is at-most-once: the events which
+// haven't been acknowledged are lost when the dispatcher restarts.
+type PullSink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PullSinkSpec   `json:"spec,omitempty"`
+	Status PullSinkStatus `json:"status,omitempty"`
+}
+
+// Check the interfaces that PullSink should be implementing.
+var (
+	_ runtime.Object     = (*PullSink)(nil)
+	_ kmeta.OwnerRefable = (*PullSink)(nil)
+	_ apis.Validatable   = (*PullSink)(nil)
+	_ apis.Defaultable   = (*PullSink)(nil)
+	_ apis.HasSpec       = (*PullSink)(nil)
+	_ duckv1.KRShaped    = (*PullSink)(nil)
+	_ apis.Convertible   = (*PullSink)(nil)
+)
+
+// PullSinkSpec defines the desired state of the PullSink.
+type PullSinkSpec struct {
+	// Consumers restricts the consumers allowed to pull the events.
+	// +optional
+	Consumers *PullSinkConsumers `json:"consumers,omitempty"`
+
+	// LeaseDuration is how long the events pulled by a consumer are leased
+	// to it, expressed as an ISO-8601 duration. Events that are neither
+	// acknowledged nor rejected before their lease expires are redelivered.
+	// Defaults to 30 seconds.
+	// +optional
+	LeaseDuration *string `json:"leaseDuration,omitempty"`
+
+	// Capacity is the maximum number of events buffered, leased or not.
+	// Events sent to a full PullSink are rejected. Defaults to 1000.
+	// +optional
+	Capacity *int32 `json:"capacity,omitempty"`
+
+	// Delivery configures what happens to the events the consumers fail to
+	// process. An event is redelivered Retry times after it is rejected or
+	// its lease expires, then sent to the DeadLetterSink, or dropped when
+	// there is none. Events are redelivered until they are acknowledged when
+	// Retry isn't set. The other delivery options are ignored.
+	// +optional
+	Delivery *eventingduckv1.DeliverySpec `json:"delivery,omitempty"`
+}
+
+// PullSinkConsumers defines the consumers allowed to pull the events of a
+// PullSink.
+type PullSinkConsumers struct {
+	// Subjects are the OIDC subjects of the consumers allowed to pull the
+	// events. A subject ending with * matches all the subjects starting with
+	// the preceding characters. When no subject is given, consumers are
+	// authorized according to the default authorization mode. Consumers are
+	// refused when the OIDC authentication feature is disabled.
+	// +optional
+	Subjects []string `json:"subjects,omitempty"`
+}
+
+// PullSinkStatus defines the observed state of PullSink.
+type PullSinkStatus struct {
+	duckv1.Status `json:",inline"`
+
+	// AddressStatus is the part where the PullSink fulfills the Addressable contract.
+	// It exposes the endpoint as an URI to get events delivered, and from
+	// which the consumers pull them.
+	// +optional
+	duckv1.AddressStatus `json:",inline"`
+
+	// AppliedEventPoliciesStatus contains the list of EventPolicies which apply to this PullSink
+	// +optional
+	eventingduckv1.AppliedEventPoliciesStatus `json:",inline"`
+
+	// DeliveryStatus contains a resolved URL to the dead letter sink address, and any other
+	// resolved delivery options.
+	// +optional
+	eventingduckv1.DeliveryStatus `json:",inline"`
+
+	// Auth provides the relevant information for OIDC authentication.
+	// +optional
+	Auth *duckv1.AuthStatus `json:"auth,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PullSinkList contains a list of PullSink.
+type PullSinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PullSink `json:"items"`
+}
+
+// GetStatus retrieves the status of the PullSink. Implements the KRShaped interface.
+func (sink *PullSink) GetStatus() *duckv1.Status {
+	return &sink.Status.Status
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rickb777/date/period"
+	"knative.dev/pkg/apis"
+)
+
+func (sink *PullSink) Validate(ctx context.Context) *apis.FieldError {
+	ctx = apis.WithinParent(ctx, sink.ObjectMeta)
+	return sink.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (spec *PullSinkSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if spec.Consumers != nil {
+		errs = errs.Also(spec.Consumers.Validate(ctx).ViaField("consumers"))
+	}
+
+	if spec.LeaseDuration != nil {
+		p, err := period.Parse(*spec.LeaseDuration)
+		if err != nil || p.IsNegative() || p.IsZero() {
+			errs = errs.Also(apis.ErrInvalidValue(*spec.LeaseDuration, "leaseDuration"))
+		}
+	}
+
+	if spec.Capacity != nil && (*spec.Capacity < 1 || *spec.Capacity > MaxPullSinkCapacity) {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*spec.Capacity, 1, MaxPullSinkCapacity, "capacity"))
+	}
+
+	if spec.Delivery != nil {
+		errs = errs.Also(spec.Delivery.Validate(ctx).ViaField("delivery"))
+	}
+
+	return errs
+}
+
+func (c *PullSinkConsumers) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	for i, sub := range c.Subjects {
+		if sub == "" {
+			errs = errs.Also(apis.ErrInvalidArrayValue(sub, "subjects", i))
+		} else if strings.Contains(strings.TrimSuffix(sub, "*"), "*") {
+			errs = errs.Also(apis.ErrInvalidValue(sub, "", "'*' is only allowed as suffix").ViaFieldIndex("subjects", i))
+		}
+	}
+
+	return errs
+}
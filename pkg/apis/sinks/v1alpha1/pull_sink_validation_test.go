@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+)
+
+func TestPullSinkValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		sink PullSink
+		want *apis.FieldError
+	}{{
+		name: "empty",
+		sink: PullSink{},
+	}, {
+		name: "valid",
+		sink: PullSink{
+			Spec: PullSinkSpec{
+				Consumers: &PullSinkConsumers{
+					Subjects: []string{"system:serviceaccount:ns:batch", "system:serviceaccount:jobs:*"},
+				},
+				LeaseDuration: ptr.To("PT1M"),
+				Capacity:      ptr.To[int32](10),
+				Delivery: &eventingduckv1.DeliverySpec{
+					DeadLetterSink: &duckv1.Destination{
+						URI: apis.HTTP("dls.ns.svc"),
+					},
+					Retry: ptr.To[int32](3),
+				},
+			},
+		},
+	}, {
+		name: "wildcard not as suffix",
+		sink: PullSink{
+			Spec: PullSinkSpec{
+				Consumers: &PullSinkConsumers{
+					Subjects: []string{"system:serviceaccount:*:batch"},
+				},
+			},
+		},
+		want: apis.ErrInvalidValue("system:serviceaccount:*:batch", "", "'*' is only allowed as suffix").ViaFieldIndex("subjects", 0).ViaField("spec", "consumers"),
+	}, {
+		name: "invalid lease duration",
+		sink: PullSink{
+			Spec: PullSinkSpec{
+				LeaseDuration: ptr.To("30s"),
+			},
+		},
+		want: apis.ErrInvalidValue("30s", "spec.leaseDuration"),
+	}, {
+		name: "zero lease duration",
+		sink: PullSink{
+			Spec: PullSinkSpec{
+				LeaseDuration: ptr.To("PT0S"),
+			},
+		},
+		want: apis.ErrInvalidValue("PT0S", "spec.leaseDuration"),
+	}, {
+		name: "capacity out of bounds",
+		sink: PullSink{
+			Spec: PullSinkSpec{
+				Capacity: ptr.To[int32](0),
+			},
+		},
+		want: apis.ErrOutOfBoundsValue(0, 1, MaxPullSinkCapacity, "spec.capacity"),
+	}, {
+		name: "invalid delivery",
+		sink: PullSink{
+			Spec: PullSinkSpec{
+				Delivery: &eventingduckv1.DeliverySpec{
+					Retry: ptr.To[int32](-1),
+				},
+			},
+		},
+		want: apis.ErrInvalidValue(int32(-1), "spec.delivery.retry"),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.sink.Validate(context.TODO())
+			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+				t.Error("PullSink.Validate (-want, +got) =", diff)
+			}
+		})
+	}
+}
@@ -49,6 +49,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&JobSinkList{},
 		&IntegrationSink{},
 		&IntegrationSinkList{},
+		&StreamSink{},
+		&StreamSinkList{},
+		&PullSink{},
+		&PullSinkList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+)
+
+func TestStreamSinkSetDefaults(t *testing.T) {
+	testCases := map[string]struct {
+		initial  StreamSink
+		expected StreamSink
+	}{
+		"buffer size": {
+			initial: StreamSink{},
+			expected: StreamSink{
+				Spec: StreamSinkSpec{
+					BufferSize: ptr.To(DefaultStreamSinkBufferSize),
+				},
+			},
+		},
+		"buffer size set": {
+			initial: StreamSink{
+				Spec: StreamSinkSpec{
+					BufferSize: ptr.To[int32](10),
+				},
+			},
+			expected: StreamSink{
+				Spec: StreamSinkSpec{
+					BufferSize: ptr.To[int32](10),
+				},
+			},
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			tc.initial.SetDefaults(context.Background())
+			if diff := cmp.Diff(tc.expected, tc.initial); diff != "" {
+				t.Fatal("Unexpected defaults (-want, +got):", diff)
+			}
+		})
+	}
+}
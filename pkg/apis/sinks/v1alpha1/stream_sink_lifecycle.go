@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+const (
+	// StreamSinkConditionReady has status True when the StreamSink is ready to stream events.
+	StreamSinkConditionReady = apis.ConditionReady
+
+	// StreamSinkConditionAddressable has status True when the StreamSink has an address.
+	StreamSinkConditionAddressable apis.ConditionType = "Addressable"
+
+	// StreamSinkConditionEventPoliciesReady has status True when all the applying EventPolicies for this
+	// StreamSink are ready.
+	StreamSinkConditionEventPoliciesReady apis.ConditionType = "EventPoliciesReady"
+)
+
+var StreamSinkCondSet = apis.NewLivingConditionSet(
+	StreamSinkConditionAddressable,
+	StreamSinkConditionEventPoliciesReady,
+)
+
+// GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
+func (*StreamSink) GetConditionSet() apis.ConditionSet {
+	return StreamSinkCondSet
+}
+
+// GetUntypedSpec returns the spec of the StreamSink.
+func (sink *StreamSink) GetUntypedSpec() interface{} {
+	return sink.Spec
+}
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (sink *StreamSink) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("StreamSink")
+}
+
+// GetCondition returns the condition currently associated with the given type, or nil.
+func (s *StreamSinkStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return StreamSinkCondSet.Manage(s).GetCondition(t)
+}
+
+// GetTopLevelCondition returns the top level Condition.
+func (s *StreamSinkStatus) GetTopLevelCondition() *apis.Condition {
+	return StreamSinkCondSet.Manage(s).GetTopLevelCondition()
+}
+
+// IsReady returns true if the resource is ready overall.
+func (s *StreamSinkStatus) IsReady() bool {
+	return StreamSinkCondSet.Manage(s).IsHappy()
+}
+
+// InitializeConditions sets relevant unset conditions to Unknown state.
+func (s *StreamSinkStatus) InitializeConditions() {
+	StreamSinkCondSet.Manage(s).InitializeConditions()
+}
+
+// MarkEventPoliciesFailed marks the EventPoliciesReady condition to False with the given reason and message.
+func (s *StreamSinkStatus) MarkEventPoliciesFailed(reason, messageFormat string, messageA ...interface{}) {
+	StreamSinkCondSet.Manage(s).MarkFalse(StreamSinkConditionEventPoliciesReady, reason, messageFormat, messageA...)
+}
+
+// MarkEventPoliciesUnknown marks the EventPoliciesReady condition to Unknown with the given reason and message.
+func (s *StreamSinkStatus) MarkEventPoliciesUnknown(reason, messageFormat string, messageA ...interface{}) {
+	StreamSinkCondSet.Manage(s).MarkUnknown(StreamSinkConditionEventPoliciesReady, reason, messageFormat, messageA...)
+}
+
+// MarkEventPoliciesTrue marks the EventPoliciesReady condition to True.
+func (s *StreamSinkStatus) MarkEventPoliciesTrue() {
+	StreamSinkCondSet.Manage(s).MarkTrue(StreamSinkConditionEventPoliciesReady)
+}
+
+// MarkEventPoliciesTrueWithReason marks the EventPoliciesReady condition to True with the given reason and message.
+func (s *StreamSinkStatus) MarkEventPoliciesTrueWithReason(reason, messageFormat string, messageA ...interface{}) {
+	StreamSinkCondSet.Manage(s).MarkTrueWithReason(StreamSinkConditionEventPoliciesReady, reason, messageFormat, messageA...)
+}
+
+// SetAddress sets the address of the StreamSink and marks the Addressable
+// condition accordingly.
+func (s *StreamSinkStatus) SetAddress(address *duckv1.Addressable) {
+	s.Address = address
+	if address == nil || address.URL.IsEmpty() {
+		StreamSinkCondSet.Manage(s).MarkFalse(StreamSinkConditionAddressable, "EmptyHostname", "hostname is the empty string")
+	} else {
+		StreamSinkCondSet.Manage(s).MarkTrue(StreamSinkConditionAddressable)
+	}
+}
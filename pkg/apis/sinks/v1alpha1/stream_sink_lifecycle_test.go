@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestStreamSinkGetConditionSet(t *testing.T) {
+	r := &StreamSink{}
+
+	if got, want := r.GetConditionSet().GetTopLevelConditionType(), apis.ConditionReady; got != want {
+		t.Errorf("GetTopLevelCondition=%v, want=%v", got, want)
+	}
+}
+
+func TestStreamSinkInitializeConditions(t *testing.T) {
+	s := &StreamSinkStatus{}
+	s.InitializeConditions()
+
+	want := &StreamSinkStatus{
+		Status: duckv1.Status{
+			Conditions: []apis.Condition{{
+				Type:   StreamSinkConditionAddressable,
+				Status: corev1.ConditionUnknown,
+			}, {
+				Type:   StreamSinkConditionEventPoliciesReady,
+				Status: corev1.ConditionUnknown,
+			}, {
+				Type:   StreamSinkConditionReady,
+				Status: corev1.ConditionUnknown,
+			}},
+		},
+	}
+	if diff := cmp.Diff(want, s, ignoreAllButTypeAndStatus); diff != "" {
+		t.Error("unexpected conditions (-want, +got) =", diff)
+	}
+}
+
+func TestStreamSinkReady(t *testing.T) {
+	tests := []struct {
+		name          string
+		address       *duckv1.Addressable
+		eventPolicies bool
+		want          bool
+	}{{
+		name:          "addressable and event policies ready",
+		address:       &duckv1.Addressable{URL: apis.HTTP("stream-sink.knative-eventing.svc")},
+		eventPolicies: true,
+		want:          true,
+	}, {
+		name:          "not addressable",
+		eventPolicies: true,
+		want:          false,
+	}, {
+		name:    "event policies not ready",
+		address: &duckv1.Addressable{URL: apis.HTTP("stream-sink.knative-eventing.svc")},
+		want:    false,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &StreamSinkStatus{}
+			s.InitializeConditions()
+			s.SetAddress(test.address)
+			if test.eventPolicies {
+				s.MarkEventPoliciesTrue()
+			} else {
+				s.MarkEventPoliciesFailed("NotReady", "")
+			}
+			if got := s.IsReady(); got != test.want {
+				t.Errorf("IsReady() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/kmeta"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+)
+
+const (
+	// DefaultStreamSinkBufferSize is the default number of events buffered
+	// for each client of a StreamSink.
+	DefaultStreamSinkBufferSize int32 = 100
+
+	// MaxStreamSinkBufferSize is the maximum number of events buffered for
+	// each client of a StreamSink.
+	MaxStreamSinkBufferSize int32 = 10000
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:defaulter-gen=true
+
+// StreamSink is a sink streaming the events it receives to the clients, like
+// browsers, connected to it using Server-Sent Events or WebSockets.
+type StreamSink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StreamSinkSpec   `json:"spec,omitempty"`
+	Status StreamSinkStatus `json:"status,omitempty"`
+}
+
+// Check the interfaces that StreamSink should be implementing.
+var (
+	_ runtime.Object     = (*StreamSink)(nil)
+	_ kmeta.OwnerRefable = (*StreamSink)(nil)
+	_ apis.Validatable   = (*StreamSink)(nil)
+	_ apis.Defaultable   = (*StreamSink)(nil)
+	_ apis.HasSpec       = (*StreamSink)(nil)
+	_ duckv1.KRShaped    = (*StreamSink)(nil)
+	_ apis.Convertible   = (*StreamSink)(nil)
+)
+
+// StreamSinkSpec defines the desired state of the StreamSink.
+type StreamSinkSpec struct {
+	// Clients restricts the clients allowed to connect to the StreamSink.
+	// +optional
+	Clients *StreamSinkClients `json:"clients,omitempty"`
+
+	// BufferSize is the number of events buffered for each client. Clients
+	// that don't keep up with the events are disconnected when their buffer
+	// is full. Defaults to 100.
+	// +optional
+	BufferSize *int32 `json:"bufferSize,omitempty"`
+}
+
+// StreamSinkClients defines the clients allowed to connect to a StreamSink.
+type StreamSinkClients struct {
+	// Subjects are the OIDC subjects of the clients allowed to connect. A
+	// subject ending with * matches all the subjects starting with the
+	// preceding characters. When no subject is given, clients are authorized
+	// according to the default authorization mode. Clients are refused when
+	// the OIDC authentication feature is disabled.
+	// +optional
+	Subjects []string `json:"subjects,omitempty"`
+}
+
+// StreamSinkStatus defines the observed state of StreamSink.
+type StreamSinkStatus struct {
+	duckv1.Status `json:",inline"`
+
+	// AddressStatus is the part where the StreamSink fulfills the Addressable contract.
+	// It exposes the endpoint as an URI to get events delivered, and to which
+	// the clients connect to receive them.
+	// +optional
+	duckv1.AddressStatus `json:",inline"`
+
+	// AppliedEventPoliciesStatus contains the list of EventPolicies which apply to this StreamSink
+	// +optional
+	eventingduckv1.AppliedEventPoliciesStatus `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// StreamSinkList contains a list of StreamSink.
+type StreamSinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StreamSink `json:"items"`
+}
+
+// GetStatus retrieves the status of the StreamSink. Implements the KRShaped interface.
+func (sink *StreamSink) GetStatus() *duckv1.Status {
+	return &sink.Status.Status
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"knative.dev/pkg/apis"
+)
+
+func (sink *StreamSink) Validate(ctx context.Context) *apis.FieldError {
+	ctx = apis.WithinParent(ctx, sink.ObjectMeta)
+	return sink.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (spec *StreamSinkSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if spec.Clients != nil {
+		errs = errs.Also(spec.Clients.Validate(ctx).ViaField("clients"))
+	}
+
+	if spec.BufferSize != nil && (*spec.BufferSize < 1 || *spec.BufferSize > MaxStreamSinkBufferSize) {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*spec.BufferSize, 1, MaxStreamSinkBufferSize, "bufferSize"))
+	}
+
+	return errs
+}
+
+func (c *StreamSinkClients) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	for i, sub := range c.Subjects {
+		if sub == "" {
+			errs = errs.Also(apis.ErrInvalidArrayValue(sub, "subjects", i))
+		} else if strings.Contains(strings.TrimSuffix(sub, "*"), "*") {
+			errs = errs.Also(apis.ErrInvalidValue(sub, "", "'*' is only allowed as suffix").ViaFieldIndex("subjects", i))
+		}
+	}
+
+	return errs
+}
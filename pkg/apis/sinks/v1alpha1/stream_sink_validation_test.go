@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+)
+
+func TestStreamSinkValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		sink StreamSink
+		want *apis.FieldError
+	}{{
+		name: "empty",
+		sink: StreamSink{},
+	}, {
+		name: "valid",
+		sink: StreamSink{
+			Spec: StreamSinkSpec{
+				Clients: &StreamSinkClients{
+					Subjects: []string{"system:serviceaccount:ns:dashboard", "system:serviceaccount:web:*", "*"},
+				},
+				BufferSize: ptr.To[int32](10),
+			},
+		},
+	}, {
+		name: "empty subject",
+		sink: StreamSink{
+			Spec: StreamSinkSpec{
+				Clients: &StreamSinkClients{
+					Subjects: []string{""},
+				},
+			},
+		},
+		want: apis.ErrInvalidArrayValue("", "subjects", 0).ViaField("spec", "clients"),
+	}, {
+		name: "wildcard not as suffix",
+		sink: StreamSink{
+			Spec: StreamSinkSpec{
+				Clients: &StreamSinkClients{
+					Subjects: []string{"system:serviceaccount:*:dashboard"},
+				},
+			},
+		},
+		want: apis.ErrInvalidValue("system:serviceaccount:*:dashboard", "", "'*' is only allowed as suffix").ViaFieldIndex("subjects", 0).ViaField("spec", "clients"),
+	}, {
+		name: "buffer size too small",
+		sink: StreamSink{
+			Spec: StreamSinkSpec{
+				BufferSize: ptr.To[int32](0),
+			},
+		},
+		want: apis.ErrOutOfBoundsValue(0, 1, MaxStreamSinkBufferSize, "spec.bufferSize"),
+	}, {
+		name: "buffer size too large",
+		sink: StreamSink{
+			Spec: StreamSinkSpec{
+				BufferSize: ptr.To(MaxStreamSinkBufferSize + 1),
+			},
+		},
+		want: apis.ErrOutOfBoundsValue(MaxStreamSinkBufferSize+1, 1, MaxStreamSinkBufferSize, "spec.bufferSize"),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.sink.Validate(context.TODO())
+			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+				t.Error("StreamSink.Validate (-want, +got) =", diff)
+			}
+		})
+	}
+}
@@ -32,6 +32,15 @@ const (
 	ApiServerSourceUpdateRefEventType = "dev.knative.apiserver.ref.update"
 	// ApiServerSourceDeleteRefEventType is the ApiServerSource CloudEvent type for ref deletions.
 	ApiServerSourceDeleteRefEventType = "dev.knative.apiserver.ref.delete"
+
+	// ApiServerSourceDiffEventType is the ApiServerSource CloudEvent type for the changes made by updates.
+	ApiServerSourceDiffEventType = "dev.knative.apiserver.resource.diff"
+
+	// MQTTSourceEventType is the MQTTSource CloudEvent type for messages that are not CloudEvents.
+	MQTTSourceEventType = "dev.knative.sources.mqtt.message"
+
+	// WebSocketSourceEventType is the default WebSocketSource CloudEvent type for messages that are not CloudEvents.
+	WebSocketSourceEventType = "dev.knative.sources.websocket.message"
 )
 
 // ApiServerSourceEventReferenceModeTypes is the list of CloudEvent types the ApiServerSource with EventMode of ReferenceMode emits.
@@ -47,3 +56,10 @@ var ApiServerSourceEventResourceModeTypes = []string{
 	ApiServerSourceDeleteEventType,
 	ApiServerSourceUpdateEventType,
 }
+
+// ApiServerSourceEventDiffModeTypes is the list of CloudEvent types the ApiServerSource with EventMode of DiffMode emits.
+var ApiServerSourceEventDiffModeTypes = []string{
+	ApiServerSourceAddEventType,
+	ApiServerSourceDeleteEventType,
+	ApiServerSourceDiffEventType,
+}
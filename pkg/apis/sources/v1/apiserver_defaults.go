@@ -30,6 +30,15 @@ func (ss *ApiServerSourceSpec) SetDefaults(ctx context.Context) {
 		ss.EventMode = ReferenceMode
 	}
 
+	if ss.EventMode == DiffMode {
+		if ss.Diff == nil {
+			ss.Diff = &ApiServerSourceDiff{}
+		}
+		if ss.Diff.Format == "" {
+			ss.Diff.Format = DiffFormatObjects
+		}
+	}
+
 	if ss.ServiceAccountName == "" {
 		ss.ServiceAccountName = "default"
 	}
@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+)
+
+// PredicateValidator validates the CEL expression of an APIVersionKindSelector
+// predicate.
+type PredicateValidator func(expression string) error
+
+type predicateValidatorKey struct{}
+
+// WithPredicateValidator notes on the context the validator used to check the
+// predicates of ApiServerSources. The CEL compiler isn't part of the API types
+// to keep it out of the clients, so it is only set by the webhook.
+func WithPredicateValidator(ctx context.Context, validator PredicateValidator) context.Context {
+	return context.WithValue(ctx, predicateValidatorKey{}, validator)
+}
+
+// GetPredicateValidator returns the validator of ApiServerSource predicates
+// associated with the context, or nil.
+func GetPredicateValidator(ctx context.Context) PredicateValidator {
+	value := ctx.Value(predicateValidatorKey{})
+	if value == nil {
+		return nil
+	}
+	return value.(PredicateValidator)
+}
@@ -73,10 +73,22 @@ type ApiServerSourceSpec struct {
 	// EventMode controls the format of the event.
 	// `Reference` sends a dataref event type for the resource under watch.
 	// `Resource` send the full resource lifecycle event.
+	// `Diff` sends the full resource for adds and deletes, and the changes
+	// made to the resource for updates.
 	// Defaults to `Reference`
 	// +optional
 	EventMode string `json:"mode,omitempty"`
 
+	// Diff configures the events sent in the `Diff` event mode.
+	// +optional
+	Diff *ApiServerSourceDiff `json:"diff,omitempty"`
+
+	// Checkpoint persists the last seen resource version of every watched
+	// resource so that a restarted source only sends the changes made since,
+	// instead of nothing or the full inventory.
+	// +optional
+	Checkpoint *ApiServerSourceCheckpoint `json:"checkpoint,omitempty"`
+
 	// ServiceAccountName is the name of the ServiceAccount to use to run this
 	// source. Defaults to default if not set.
 	// +optional
@@ -113,6 +125,35 @@ type ApiServerSourceStatus struct {
 	Namespaces []string `json:"namespaces"`
 }
 
+// ApiServerSourceDiff configures the events sent in the `Diff` event mode.
+type ApiServerSourceDiff struct {
+	// Format is the format of the changes sent for updates.
+	// `Objects` sends both the old and the new resource.
+	// `JSONPatch` sends an RFC 6902 JSON Patch from the old to the new resource.
+	// Defaults to `Objects`
+	// +optional
+	Format string `json:"format,omitempty"`
+
+	// IgnorePaths are RFC 6901 JSON Pointers to fields of the resource whose
+	// changes are ignored, for instance `/status` or `/metadata/managedFields`.
+	// Updates only changing ignored fields are not sent, and ignored fields
+	// are left out of JSON Patches.
+	// +optional
+	IgnorePaths []string `json:"ignorePaths,omitempty"`
+}
+
+// ApiServerSourceCheckpoint configures where the last seen resource versions
+// of an ApiServerSource are persisted.
+type ApiServerSourceCheckpoint struct {
+	// ConfigMapName is the name of the ConfigMap, in the namespace of the
+	// source, holding the last seen resource version of every watched
+	// resource. It is created if it doesn't exist, so the ServiceAccount of
+	// the source must be allowed to get, create and update it. As ConfigMaps
+	// are limited to 1 MiB, checkpoints are meant for watches of up to a few
+	// thousand resources.
+	ConfigMapName string `json:"configMapName"`
+}
+
 // APIVersionKind is an APIVersion and Kind tuple.
 type APIVersionKind struct {
 	// APIVersion - the API version of the resource to watch.
@@ -123,7 +164,7 @@ type APIVersionKind struct {
 	Kind string `json:"kind"`
 }
 
-// APIVersionKindSelector is an APIVersion Kind tuple with a LabelSelector.
+// APIVersionKindSelector is an APIVersion Kind tuple with selectors.
 type APIVersionKindSelector struct {
 	// APIVersion - the API version of the resource to watch.
 	APIVersion string `json:"apiVersion"`
@@ -137,6 +178,20 @@ type APIVersionKindSelector struct {
 	// More info: http://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors
 	// +optional
 	LabelSelector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// FieldSelector filters this source to objects to those resources pass the
+	// field selector, for instance `status.phase=Running`. Like the label
+	// selector, it is evaluated by the API server.
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/field-selectors/
+	// +optional
+	FieldSelector string `json:"fieldSelector,omitempty"`
+
+	// Predicate is a CEL expression over the resource, available as
+	// `object`, filtering this source to the resources for which it
+	// evaluates to true, for instance `object.spec.replicas > 1`. It is
+	// evaluated by the source before any event is built.
+	// +optional
+	Predicate string `json:"predicate,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -20,7 +20,9 @@ import (
 	"context"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/eventing/pkg/apis/feature"
@@ -32,6 +34,13 @@ const (
 	ReferenceMode = "Reference"
 	// ResourceMode produces payloads of ResourceEvent
 	ResourceMode = "Resource"
+	// DiffMode produces payloads of the changes made to resources
+	DiffMode = "Diff"
+
+	// DiffFormatObjects produces update payloads with the old and new resources
+	DiffFormatObjects = "Objects"
+	// DiffFormatJSONPatch produces update payloads of RFC 6902 JSON Patches
+	DiffFormatJSONPatch = "JSONPatch"
 )
 
 func (c *ApiServerSource) Validate(ctx context.Context) *apis.FieldError {
@@ -43,12 +52,24 @@ func (cs *ApiServerSourceSpec) Validate(ctx context.Context) *apis.FieldError {
 
 	// Validate mode, if can be empty or set as certain value
 	switch cs.EventMode {
-	case ReferenceMode, ResourceMode:
+	case ReferenceMode, ResourceMode, DiffMode:
 	// EventMode is valid.
 	default:
 		errs = errs.Also(apis.ErrInvalidValue(cs.EventMode, "mode"))
 	}
 
+	if cs.Diff != nil {
+		if cs.EventMode != DiffMode {
+			errs = errs.Also(apis.ErrDisallowedFields("diff"))
+		} else {
+			errs = errs.Also(cs.Diff.Validate(ctx).ViaField("diff"))
+		}
+	}
+
+	if cs.Checkpoint != nil {
+		errs = errs.Also(cs.Checkpoint.Validate(ctx).ViaField("checkpoint"))
+	}
+
 	// Validate sink
 	errs = errs.Also(cs.Sink.Validate(ctx).ViaField("sink"))
 
@@ -63,6 +84,16 @@ func (cs *ApiServerSourceSpec) Validate(ctx context.Context) *apis.FieldError {
 		if strings.TrimSpace(res.Kind) == "" {
 			errs = errs.Also(apis.ErrMissingField("kind").ViaFieldIndex("resources", i))
 		}
+		if res.FieldSelector != "" {
+			if _, err := fields.ParseSelector(res.FieldSelector); err != nil {
+				errs = errs.Also(apis.ErrInvalidValue(res.FieldSelector, "fieldSelector", err.Error()).ViaFieldIndex("resources", i))
+			}
+		}
+		if validate := GetPredicateValidator(ctx); res.Predicate != "" && validate != nil {
+			if err := validate(res.Predicate); err != nil {
+				errs = errs.Also(apis.ErrInvalidValue(res.Predicate, "predicate", err.Error()).ViaFieldIndex("resources", i))
+			}
+		}
 	}
 
 	if cs.ResourceOwner != nil {
@@ -79,6 +110,34 @@ func (cs *ApiServerSourceSpec) Validate(ctx context.Context) *apis.FieldError {
 	return errs
 }
 
+func (d *ApiServerSourceDiff) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	switch d.Format {
+	case "", DiffFormatObjects, DiffFormatJSONPatch:
+	// Format is valid.
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(d.Format, "format"))
+	}
+
+	for i, path := range d.IgnorePaths {
+		if !strings.HasPrefix(path, "/") || path == "/" {
+			errs = errs.Also(apis.ErrInvalidArrayValue(path, "ignorePaths", i))
+		}
+	}
+	return errs
+}
+
+func (c *ApiServerSourceCheckpoint) Validate(ctx context.Context) *apis.FieldError {
+	if c.ConfigMapName == "" {
+		return apis.ErrMissingField("configMapName")
+	}
+	if msgs := validation.IsDNS1123Subdomain(c.ConfigMapName); len(msgs) > 0 {
+		return apis.ErrInvalidValue(strings.Join(msgs, ", "), "configMapName")
+	}
+	return nil
+}
+
 func validateSubscriptionAPIFiltersList(ctx context.Context, filters []eventingv1.SubscriptionsAPIFilter) (errs *apis.FieldError) {
 	if !feature.FromContext(ctx).IsEnabled(feature.NewAPIServerFilters) {
 		if len(filters) != 0 {
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -92,6 +93,256 @@ func TestAPIServerValidation(t *testing.T) {
 			errs = errs.Also(apis.ErrInvalidValue("Test", "mode"))
 			return errs
 		}(),
+	}, {
+		name: "valid diff mode",
+		spec: ApiServerSourceSpec{
+			EventMode: "Diff",
+			Diff: &ApiServerSourceDiff{
+				Format:      DiffFormatJSONPatch,
+				IgnorePaths: []string{"/status", "/metadata/managedFields"},
+			},
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Foo",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: nil,
+	}, {
+		name: "diff without diff mode",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Diff: &ApiServerSourceDiff{
+				Format: DiffFormatObjects,
+			},
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Foo",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: apis.ErrDisallowedFields("diff"),
+	}, {
+		name: "invalid diff format",
+		spec: ApiServerSourceSpec{
+			EventMode: "Diff",
+			Diff: &ApiServerSourceDiff{
+				Format: "Test",
+			},
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Foo",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: errors.New("invalid value: Test: diff.format"),
+	}, {
+		name: "invalid diff ignore path",
+		spec: ApiServerSourceSpec{
+			EventMode: "Diff",
+			Diff: &ApiServerSourceDiff{
+				IgnorePaths: []string{"/status", "status"},
+			},
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Foo",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: errors.New("invalid value: status: diff.ignorePaths[1]"),
+	}, {
+		name: "valid checkpoint",
+		spec: ApiServerSourceSpec{
+			EventMode:  "Resource",
+			Checkpoint: &ApiServerSourceCheckpoint{ConfigMapName: "checkpoints"},
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Foo",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: nil,
+	}, {
+		name: "missing checkpoint ConfigMap name",
+		spec: ApiServerSourceSpec{
+			EventMode:  "Resource",
+			Checkpoint: &ApiServerSourceCheckpoint{},
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Foo",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: apis.ErrMissingField("checkpoint.configMapName"),
+	}, {
+		name: "invalid checkpoint ConfigMap name",
+		spec: ApiServerSourceSpec{
+			EventMode:  "Resource",
+			Checkpoint: &ApiServerSourceCheckpoint{ConfigMapName: "Checkpoints"},
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Foo",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: errors.New("invalid value: a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*'): checkpoint.configMapName"),
+	}, {
+		name: "valid field selector and predicate",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion:    "v1",
+				Kind:          "Foo",
+				FieldSelector: "status.phase=Running",
+				Predicate:     `object.metadata.name.startsWith("web")`,
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: nil,
+	}, {
+		name: "invalid field selector",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion:    "v1",
+				Kind:          "Foo",
+				FieldSelector: "status.phase",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: apis.ErrInvalidValue("status.phase", "fieldSelector", "invalid selector: 'status.phase'; can't understand 'status.phase'").ViaFieldIndex("resources", 0),
+	}, {
+		name: "invalid predicate",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Foo",
+				Predicate:  "object.metadata.name ==",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: apis.ErrInvalidValue("object.metadata.name ==", "predicate", "invalid predicate").ViaFieldIndex("resources", 0),
+	}, {
+		name: "non bool predicate",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Foo",
+				Predicate:  "object.metadata.name",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: nil,
+	}, {
+		name: "string predicate",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Foo",
+				Predicate:  `"web"`,
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: apis.ErrInvalidValue(`"web"`, "predicate", "invalid predicate").ViaFieldIndex("resources", 0),
 	}, {
 		name: "invalid apiVersion",
 		spec: ApiServerSourceSpec{
@@ -234,9 +485,18 @@ func TestAPIServerValidation(t *testing.T) {
 		),
 	}}
 
+	// The webhook validates predicates with the CEL compiler, which isn't part of the API types.
+	validPredicates := sets.New(`object.metadata.name.startsWith("web")`, "object.metadata.name")
+	ctx := WithPredicateValidator(context.TODO(), func(expression string) error {
+		if !validPredicates.Has(expression) {
+			return errors.New("invalid predicate")
+		}
+		return nil
+	})
+
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := test.spec.Validate(context.TODO())
+			got := test.spec.Validate(ctx)
 			if test.want != nil {
 				if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
 					t.Errorf("APIServerSourceSpec.Validate (-want, +got) = %v", diff)
@@ -22,6 +22,10 @@ import (
 
 const (
 	defaultSchedule = "* * * * *"
+
+	// DefaultPingSourceCatchUpLimit is the maximum number of missed runs fired
+	// when the catch-up policy is All and no limit is set.
+	DefaultPingSourceCatchUpLimit = 10
 )
 
 func (s *PingSource) SetDefaults(ctx context.Context) {
@@ -29,7 +33,20 @@ func (s *PingSource) SetDefaults(ctx context.Context) {
 }
 
 func (ss *PingSourceSpec) SetDefaults(ctx context.Context) {
-	if ss.Schedule == "" {
+	if ss.Schedule == "" && ss.At == nil {
 		ss.Schedule = defaultSchedule
 	}
+	if ss.CatchUp != nil {
+		ss.CatchUp.SetDefaults(ctx)
+	}
+}
+
+func (cu *PingSourceCatchUp) SetDefaults(ctx context.Context) {
+	if cu.Policy == "" {
+		cu.Policy = PingSourceCatchUpNone
+	}
+	if cu.Policy == PingSourceCatchUpAll && cu.Limit == nil {
+		limit := int32(DefaultPingSourceCatchUpLimit)
+		cu.Limit = &limit
+	}
 }
@@ -19,8 +19,11 @@ package v1
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/ptr"
 )
 
 func TestPingSourceSetDefaults(t *testing.T) {
@@ -55,6 +58,51 @@ func TestPingSourceSetDefaults(t *testing.T) {
 				},
 			},
 		},
+		"with at": {
+			initial: PingSource{
+				Spec: PingSourceSpec{
+					At: &metav1.Time{Time: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+			expected: PingSource{
+				Spec: PingSourceSpec{
+					At: &metav1.Time{Time: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+		},
+		"with empty catch-up": {
+			initial: PingSource{
+				Spec: PingSourceSpec{
+					CatchUp: &PingSourceCatchUp{},
+				},
+			},
+			expected: PingSource{
+				Spec: PingSourceSpec{
+					Schedule: defaultSchedule,
+					CatchUp: &PingSourceCatchUp{
+						Policy: PingSourceCatchUpNone,
+					},
+				},
+			},
+		},
+		"with catch-up all": {
+			initial: PingSource{
+				Spec: PingSourceSpec{
+					CatchUp: &PingSourceCatchUp{
+						Policy: PingSourceCatchUpAll,
+					},
+				},
+			},
+			expected: PingSource{
+				Spec: PingSourceSpec{
+					Schedule: defaultSchedule,
+					CatchUp: &PingSourceCatchUp{
+						Policy: PingSourceCatchUpAll,
+						Limit:  ptr.Int32(DefaultPingSourceCatchUpLimit),
+					},
+				},
+			},
+		},
 	}
 	for n, tc := range testCases {
 		t.Run(n, func(t *testing.T) {
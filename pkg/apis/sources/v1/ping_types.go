@@ -58,10 +58,23 @@ type PingSourceSpec struct {
 	//   and modifications of the event sent to the sink.
 	duckv1.SourceSpec `json:",inline"`
 
-	// Schedule is the cron schedule. Defaults to `* * * * *`.
+	// Schedule is the cron schedule. Defaults to `* * * * *` unless At is set.
+	// An optional leading seconds field is supported, e.g. `*/10 * * * * *`.
+	// Mutually exclusive with At.
 	// +optional
 	Schedule string `json:"schedule,omitempty"`
 
+	// At is the time of a one-shot run. When set, the PingSource sends a single
+	// event at the given time instead of following a cron schedule.
+	// Mutually exclusive with Schedule.
+	// +optional
+	At *metav1.Time `json:"at,omitempty"`
+
+	// CatchUp defines how runs missed while the adapter was unavailable
+	// (restarts, leader changes) are handled. Defaults to not catching up.
+	// +optional
+	CatchUp *PingSourceCatchUp `json:"catchUp,omitempty"`
+
 	// Timezone modifies the actual time relative to the specified timezone.
 	// Defaults to the system time zone.
 	// More general information about time zones: https://www.iana.org/time-zones
@@ -81,6 +94,64 @@ type PingSourceSpec struct {
 	// Mutually exclusive with Data.
 	// +optional
 	DataBase64 string `json:"dataBase64,omitempty"`
+
+	// Template defines Go templates rendered for every run into the body and
+	// the extension attributes of the event posted to the sink.
+	// Mutually exclusive with Data and DataBase64.
+	// +optional
+	Template *PingSourceTemplate `json:"template,omitempty"`
+}
+
+// PingSourceCatchUpPolicy is the policy applied to missed runs.
+type PingSourceCatchUpPolicy string
+
+const (
+	// PingSourceCatchUpNone drops missed runs.
+	PingSourceCatchUpNone PingSourceCatchUpPolicy = "None"
+
+	// PingSourceCatchUpLatest fires the most recent missed run only.
+	PingSourceCatchUpLatest PingSourceCatchUpPolicy = "Latest"
+
+	// PingSourceCatchUpAll fires every missed run, up to the configured limit.
+	PingSourceCatchUpAll PingSourceCatchUpPolicy = "All"
+)
+
+// PingSourceCatchUp defines how missed runs are fired after downtime.
+type PingSourceCatchUp struct {
+	// Policy is the catch-up policy, one of None, Latest or All.
+	// Defaults to None.
+	// +optional
+	Policy PingSourceCatchUpPolicy `json:"policy,omitempty"`
+
+	// Limit is the maximum number of missed runs fired when the policy is All.
+	// Older missed runs beyond this limit are dropped. Defaults to 10.
+	// +optional
+	Limit *int32 `json:"limit,omitempty"`
+}
+
+// PingSourceTemplate defines the templates rendered for every run of a
+// PingSource. Templates use the Go text/template syntax and have access to:
+// * .ScheduledTime - the scheduled time of the run, in RFC 3339 format.
+// * .FireTime - the actual time of the run, in RFC 3339 format.
+// * .Sequence - the sequence number of the run, starting at 1.
+// * .Name and .Namespace - the name and namespace of the PingSource.
+// * .Values - the data of the ConfigMap referenced by ValuesConfigMap.
+type PingSourceTemplate struct {
+	// Data is the template of the body of the event posted to the sink.
+	// +optional
+	Data string `json:"data,omitempty"`
+
+	// Extensions are the templates of extension attributes added to the
+	// event posted to the sink, keyed by attribute name.
+	// +optional
+	Extensions map[string]string `json:"extensions,omitempty"`
+
+	// ValuesConfigMap is the name of a ConfigMap in the namespace of the
+	// PingSource whose data is available to the templates as `.Values`.
+	// The ConfigMap is read when the PingSource is scheduled, changes
+	// of its data apply once the PingSource is updated.
+	// +optional
+	ValuesConfigMap string `json:"valuesConfigMap,omitempty"`
 }
 
 // PingSourceStatus defines the observed state of PingSource.
@@ -93,6 +164,19 @@ type PingSourceStatus struct {
 	// * SinkURI - the current active sink URI that has been configured for the
 	//   Source.
 	duckv1.SourceStatus `json:",inline"`
+
+	// LastFireTime is the scheduled time of the last run fired by the adapter.
+	// It is only recorded when CatchUp is enabled or the Template uses the
+	// sequence number, and is used to detect runs missed while the adapter
+	// was unavailable.
+	// +optional
+	LastFireTime *metav1.Time `json:"lastFireTime,omitempty"`
+
+	// Sequence is the sequence number of the last run fired by the adapter.
+	// It is only recorded when CatchUp is enabled or the Template uses the
+	// sequence number.
+	// +optional
+	Sequence int64 `json:"sequence,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
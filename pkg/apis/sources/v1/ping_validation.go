@@ -23,41 +23,41 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"text/template"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 
 	"github.com/robfig/cron/v3"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	"knative.dev/eventing/pkg/apis/sources/config"
 )
 
+// maxCatchUpLimit bounds the number of missed runs fired after downtime.
+const maxCatchUpLimit = 100
+
 func (c *PingSource) Validate(ctx context.Context) *apis.FieldError {
 	return c.Spec.Validate(ctx).ViaField("spec")
 }
 
 func (cs *PingSourceSpec) Validate(ctx context.Context) *apis.FieldError {
 	var errs *apis.FieldError
-	schedule := cs.Schedule
-
-	errs = validateDescriptor(schedule)
 
-	if cs.Timezone != "" {
-		schedule = "CRON_TZ=" + cs.Timezone + " " + schedule
+	if cs.At != nil {
+		if cs.Schedule != "" {
+			errs = errs.Also(apis.ErrMultipleOneOf("schedule", "at"))
+		}
+		if cs.Timezone != "" {
+			errs = errs.Also(apis.ErrDisallowedFields("timezone"))
+		}
+	} else {
+		errs = errs.Also(cs.validateSchedule())
 	}
 
-	parser := cron.NewParser(
-		cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
-	)
-
-	if _, err := parser.Parse(schedule); err != nil {
-		if strings.HasPrefix(err.Error(), "provided bad location") {
-			fe := apis.ErrInvalidValue(err, "timezone")
-			errs = errs.Also(fe)
-		} else {
-			fe := apis.ErrInvalidValue(err, "schedule")
-			errs = errs.Also(fe)
-		}
+	if cs.CatchUp != nil {
+		errs = errs.Also(cs.CatchUp.Validate(ctx).ViaField("catchUp"))
 	}
 
 	pingConfig := config.FromContextOrDefaults(ctx)
@@ -98,10 +98,89 @@ func (cs *PingSourceSpec) Validate(ctx context.Context) *apis.FieldError {
 			}
 		}
 	}
+
+	if cs.Template != nil {
+		if cs.Data != "" {
+			errs = errs.Also(apis.ErrMultipleOneOf("data", "template"))
+		}
+		if cs.DataBase64 != "" {
+			errs = errs.Also(apis.ErrMultipleOneOf("dataBase64", "template"))
+		}
+		errs = errs.Also(cs.Template.Validate(ctx).ViaField("template"))
+	}
+
 	errs = errs.Also(cs.SourceSpec.Validate(ctx))
 	return errs
 }
 
+func (cs *PingSourceSpec) validateSchedule() *apis.FieldError {
+	schedule := cs.Schedule
+
+	errs := validateDescriptor(schedule)
+
+	if cs.Timezone != "" {
+		schedule = "CRON_TZ=" + cs.Timezone + " " + schedule
+	}
+
+	parser := cron.NewParser(
+		cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+	)
+
+	if _, err := parser.Parse(schedule); err != nil {
+		if strings.HasPrefix(err.Error(), "provided bad location") {
+			fe := apis.ErrInvalidValue(err, "timezone")
+			errs = errs.Also(fe)
+		} else {
+			fe := apis.ErrInvalidValue(err, "schedule")
+			errs = errs.Also(fe)
+		}
+	}
+	return errs
+}
+
+func (cu *PingSourceCatchUp) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	switch cu.Policy {
+	case "", PingSourceCatchUpNone, PingSourceCatchUpLatest, PingSourceCatchUpAll:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(cu.Policy, "policy"))
+	}
+
+	if cu.Limit != nil && (*cu.Limit < 1 || *cu.Limit > maxCatchUpLimit) {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*cu.Limit, 1, maxCatchUpLimit, "limit"))
+	}
+	return errs
+}
+
+func (t *PingSourceTemplate) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	pingDefaults := config.FromContextOrDefaults(ctx).PingDefaults.GetPingConfig()
+	if bsize := int64(len(t.Data)); pingDefaults.DataMaxSize > -1 && bsize > pingDefaults.DataMaxSize {
+		fe := apis.ErrInvalidValue(fmt.Sprintf("the data length of %d bytes exceeds limit set at %d.", bsize, pingDefaults.DataMaxSize), "data")
+		errs = errs.Also(fe)
+	}
+	if _, err := template.New("data").Parse(t.Data); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(err, "data"))
+	}
+
+	overrides := duckv1.CloudEventOverrides{Extensions: t.Extensions}
+	errs = errs.Also(overrides.Validate(ctx))
+	for key, ext := range t.Extensions {
+		if _, err := template.New(key).Parse(ext); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(err, apis.CurrentField).ViaKey(key).ViaField("extensions"))
+		}
+	}
+
+	if t.ValuesConfigMap != "" {
+		if msgs := validation.IsDNS1123Subdomain(t.ValuesConfigMap); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidValue(strings.Join(msgs, ", "), "valuesConfigMap"))
+		}
+	}
+	return errs
+}
+
 func validateJSON(str string) error {
 	var objmap map[string]interface{}
 	return json.Unmarshal([]byte(str), &objmap)
@@ -21,10 +21,13 @@ import (
 	"encoding/base64"
 	"strings"
 	"testing"
+	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
 
 	"github.com/google/go-cmp/cmp"
 	"knative.dev/pkg/apis"
@@ -475,6 +478,193 @@ func TestPingSourceValidation(t *testing.T) {
 				errs = errs.Also(fe)
 				return errs
 			}(),
+		}, {
+			name: "valid one-shot spec",
+			source: PingSource{
+				Spec: PingSourceSpec{
+					At: &metav1.Time{Time: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+					SourceSpec: duckv1.SourceSpec{
+						Sink: duckv1.Destination{
+							Ref: &duckv1.KReference{
+								APIVersion: "v1",
+								Kind:       "broker",
+								Name:       "default",
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+		}, {
+			name: "invalid spec with schedule and at both set",
+			source: PingSource{
+				Spec: PingSourceSpec{
+					Schedule: "*/2 * * * *",
+					At:       &metav1.Time{Time: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+					SourceSpec: duckv1.SourceSpec{
+						Sink: duckv1.Destination{
+							Ref: &duckv1.KReference{
+								APIVersion: "v1",
+								Kind:       "broker",
+								Name:       "default",
+							},
+						},
+					},
+				},
+			},
+			want: apis.ErrMultipleOneOf("spec.schedule", "spec.at"),
+		}, {
+			name: "invalid one-shot spec with timezone",
+			source: PingSource{
+				Spec: PingSourceSpec{
+					At:       &metav1.Time{Time: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+					Timezone: "Europe/Paris",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: duckv1.Destination{
+							Ref: &duckv1.KReference{
+								APIVersion: "v1",
+								Kind:       "broker",
+								Name:       "default",
+							},
+						},
+					},
+				},
+			},
+			want: apis.ErrDisallowedFields("spec.timezone"),
+		}, {
+			name: "valid spec with catch-up",
+			source: PingSource{
+				Spec: PingSourceSpec{
+					Schedule: "*/2 * * * *",
+					CatchUp: &PingSourceCatchUp{
+						Policy: PingSourceCatchUpAll,
+						Limit:  ptr.Int32(5),
+					},
+					SourceSpec: duckv1.SourceSpec{
+						Sink: duckv1.Destination{
+							Ref: &duckv1.KReference{
+								APIVersion: "v1",
+								Kind:       "broker",
+								Name:       "default",
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+		}, {
+			name: "invalid catch-up policy",
+			source: PingSource{
+				Spec: PingSourceSpec{
+					Schedule: "*/2 * * * *",
+					CatchUp: &PingSourceCatchUp{
+						Policy: "Sometimes",
+					},
+					SourceSpec: duckv1.SourceSpec{
+						Sink: duckv1.Destination{
+							Ref: &duckv1.KReference{
+								APIVersion: "v1",
+								Kind:       "broker",
+								Name:       "default",
+							},
+						},
+					},
+				},
+			},
+			want: apis.ErrInvalidValue("Sometimes", "spec.catchUp.policy"),
+		}, {
+			name: "invalid catch-up limit",
+			source: PingSource{
+				Spec: PingSourceSpec{
+					Schedule: "*/2 * * * *",
+					CatchUp: &PingSourceCatchUp{
+						Policy: PingSourceCatchUpAll,
+						Limit:  ptr.Int32(1000),
+					},
+					SourceSpec: duckv1.SourceSpec{
+						Sink: duckv1.Destination{
+							Ref: &duckv1.KReference{
+								APIVersion: "v1",
+								Kind:       "broker",
+								Name:       "default",
+							},
+						},
+					},
+				},
+			},
+			want: apis.ErrOutOfBoundsValue(1000, 1, 100, "spec.catchUp.limit"),
+		}, {
+			name: "valid spec with template",
+			source: PingSource{
+				Spec: PingSourceSpec{
+					Schedule:    "*/2 * * * *",
+					ContentType: cloudevents.ApplicationJSON,
+					Template: &PingSourceTemplate{
+						Data:            `{"seq": {{ .Sequence }}}`,
+						Extensions:      map[string]string{"scheduled": "{{ .ScheduledTime }}"},
+						ValuesConfigMap: "ping-values",
+					},
+					SourceSpec: duckv1.SourceSpec{
+						Sink: duckv1.Destination{
+							Ref: &duckv1.KReference{
+								APIVersion: "v1",
+								Kind:       "broker",
+								Name:       "default",
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+		}, {
+			name: "invalid spec with template and data",
+			source: PingSource{
+				Spec: PingSourceSpec{
+					Schedule: "*/2 * * * *",
+					Data:     "some data",
+					Template: &PingSourceTemplate{
+						Data: "{{ .Sequence }}",
+					},
+					SourceSpec: duckv1.SourceSpec{
+						Sink: duckv1.Destination{
+							Ref: &duckv1.KReference{
+								APIVersion: "v1",
+								Kind:       "broker",
+								Name:       "default",
+							},
+						},
+					},
+				},
+			},
+			want: apis.ErrMultipleOneOf("spec.data", "spec.template"),
+		}, {
+			name: "invalid template",
+			source: PingSource{
+				Spec: PingSourceSpec{
+					Schedule: "*/2 * * * *",
+					Template: &PingSourceTemplate{
+						Data:            "{{ .Sequence ",
+						Extensions:      map[string]string{"Invalid_key": "value"},
+						ValuesConfigMap: "Not_Valid",
+					},
+					SourceSpec: duckv1.SourceSpec{
+						Sink: duckv1.Destination{
+							Ref: &duckv1.KReference{
+								APIVersion: "v1",
+								Kind:       "broker",
+								Name:       "default",
+							},
+						},
+					},
+				},
+			},
+			want: func() *apis.FieldError {
+				var errs *apis.FieldError
+				errs = errs.Also(apis.ErrInvalidValue(`template: data:1: unclosed action`, "spec.template.data"))
+				errs = errs.Also(apis.ErrInvalidKeyName("Invalid_key", "spec.template.extensions", "keys are expected to be alphanumeric"))
+				errs = errs.Also(apis.ErrInvalidValue("a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')", "spec.template.valuesConfigMap"))
+				return errs
+			}(),
 		},
 	}
 
@@ -92,6 +92,43 @@ func (in *ApiServerSource) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiServerSourceCheckpoint) DeepCopyInto(out *ApiServerSourceCheckpoint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiServerSourceCheckpoint.
+func (in *ApiServerSourceCheckpoint) DeepCopy() *ApiServerSourceCheckpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiServerSourceCheckpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiServerSourceDiff) DeepCopyInto(out *ApiServerSourceDiff) {
+	*out = *in
+	if in.IgnorePaths != nil {
+		in, out := &in.IgnorePaths, &out.IgnorePaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiServerSourceDiff.
+func (in *ApiServerSourceDiff) DeepCopy() *ApiServerSourceDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiServerSourceDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApiServerSourceList) DeepCopyInto(out *ApiServerSourceList) {
 	*out = *in
@@ -141,6 +178,16 @@ func (in *ApiServerSourceSpec) DeepCopyInto(out *ApiServerSourceSpec) {
 		*out = new(APIVersionKind)
 		**out = **in
 	}
+	if in.Diff != nil {
+		in, out := &in.Diff, &out.Diff
+		*out = new(ApiServerSourceDiff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Checkpoint != nil {
+		in, out := &in.Checkpoint, &out.Checkpoint
+		*out = new(ApiServerSourceCheckpoint)
+		**out = **in
+	}
 	if in.NamespaceSelector != nil {
 		in, out := &in.NamespaceSelector, &out.NamespaceSelector
 		*out = new(metav1.LabelSelector)
@@ -312,6 +359,27 @@ func (in *PingSource) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PingSourceCatchUp) DeepCopyInto(out *PingSourceCatchUp) {
+	*out = *in
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PingSourceCatchUp.
+func (in *PingSourceCatchUp) DeepCopy() *PingSourceCatchUp {
+	if in == nil {
+		return nil
+	}
+	out := new(PingSourceCatchUp)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PingSourceList) DeepCopyInto(out *PingSourceList) {
 	*out = *in
@@ -349,6 +417,20 @@ func (in *PingSourceList) DeepCopyObject() runtime.Object {
 func (in *PingSourceSpec) DeepCopyInto(out *PingSourceSpec) {
 	*out = *in
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.At != nil {
+		in, out := &in.At, &out.At
+		*out = (*in).DeepCopy()
+	}
+	if in.CatchUp != nil {
+		in, out := &in.CatchUp, &out.CatchUp
+		*out = new(PingSourceCatchUp)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(PingSourceTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -366,6 +448,10 @@ func (in *PingSourceSpec) DeepCopy() *PingSourceSpec {
 func (in *PingSourceStatus) DeepCopyInto(out *PingSourceStatus) {
 	*out = *in
 	in.SourceStatus.DeepCopyInto(&out.SourceStatus)
+	if in.LastFireTime != nil {
+		in, out := &in.LastFireTime, &out.LastFireTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -379,6 +465,29 @@ func (in *PingSourceStatus) DeepCopy() *PingSourceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PingSourceTemplate) DeepCopyInto(out *PingSourceTemplate) {
+	*out = *in
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PingSourceTemplate.
+func (in *PingSourceTemplate) DeepCopy() *PingSourceTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(PingSourceTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SinkBinding) DeepCopyInto(out *SinkBinding) {
 	*out = *in
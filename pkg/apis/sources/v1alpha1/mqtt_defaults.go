@@ -0,0 +1,33 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/kmeta"
+)
+
+func (source *MQTTSource) SetDefaults(ctx context.Context) {
+	if source.Spec.ClientID == "" && source.Name != "" {
+		source.Spec.ClientID = kmeta.ChildName(source.Namespace+"-", source.Name)
+	}
+	source.Spec.SetDefaults(ctx)
+}
+
+func (spec *MQTTSourceSpec) SetDefaults(ctx context.Context) {
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMQTTSourceDefaults(t *testing.T) {
+	testCases := map[string]struct {
+		initial  MQTTSource
+		expected string
+	}{
+		"client ID from namespace and name": {
+			initial: MQTTSource{
+				ObjectMeta: metav1.ObjectMeta{Name: "sensors", Namespace: "ns"},
+			},
+			expected: "ns-sensors",
+		},
+		"client ID set": {
+			initial: MQTTSource{
+				ObjectMeta: metav1.ObjectMeta{Name: "sensors", Namespace: "ns"},
+				Spec:       MQTTSourceSpec{ClientID: "gateway"},
+			},
+			expected: "gateway",
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			tc.initial.SetDefaults(context.Background())
+			if got := tc.initial.Spec.ClientID; got != tc.expected {
+				t.Errorf("ClientID = %q, want %q", got, tc.expected)
+			}
+		})
+	}
+}
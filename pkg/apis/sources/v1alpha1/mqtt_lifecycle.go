@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// MQTTSourceConditionReady has status True when the MQTTSource is ready to send events.
+	MQTTSourceConditionReady = apis.ConditionReady
+
+	// MQTTSourceConditionContainerSourceReady has status True when the MQTTSource's ContainerSource is ready.
+	MQTTSourceConditionContainerSourceReady apis.ConditionType = "ContainerSourceReady"
+)
+
+var MQTTCondSet = apis.NewLivingConditionSet(
+	MQTTSourceConditionContainerSourceReady,
+)
+
+// GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
+func (*MQTTSource) GetConditionSet() apis.ConditionSet {
+	return MQTTCondSet
+}
+
+// GetTopLevelCondition returns the top level condition.
+func (s *MQTTSourceStatus) GetTopLevelCondition() *apis.Condition {
+	return MQTTCondSet.Manage(s).GetTopLevelCondition()
+}
+
+// InitializeConditions sets relevant unset conditions to Unknown state.
+func (s *MQTTSourceStatus) InitializeConditions() {
+	MQTTCondSet.Manage(s).InitializeConditions()
+}
+
+// IsReady returns true if the resource is ready overall.
+func (s *MQTTSourceStatus) IsReady() bool {
+	return MQTTCondSet.Manage(s).IsHappy()
+}
+
+// PropagateContainerSourceStatus sets the status of the MQTTSource from the
+// status of its ContainerSource.
+func (s *MQTTSourceStatus) PropagateContainerSourceStatus(status *v1.ContainerSourceStatus) {
+	// ContainerSource status has all we need, hence deep copy it.
+	s.SourceStatus = *status.SourceStatus.DeepCopy()
+
+	cond := status.GetCondition(apis.ConditionReady)
+	switch {
+	case cond == nil:
+		MQTTCondSet.Manage(s).MarkUnknown(MQTTSourceConditionContainerSourceReady, "", "")
+	case cond.Status == corev1.ConditionTrue:
+		MQTTCondSet.Manage(s).MarkTrue(MQTTSourceConditionContainerSourceReady)
+	case cond.Status == corev1.ConditionFalse:
+		MQTTCondSet.Manage(s).MarkFalse(MQTTSourceConditionContainerSourceReady, cond.Reason, cond.Message)
+	default:
+		MQTTCondSet.Manage(s).MarkUnknown(MQTTSourceConditionContainerSourceReady, cond.Reason, cond.Message)
+	}
+}
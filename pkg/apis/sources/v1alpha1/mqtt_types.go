@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/kmeta"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MQTTSource is an event source that subscribes to topics of an MQTT broker
+// and sends the messages published to them as CloudEvents.
+type MQTTSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MQTTSourceSpec   `json:"spec,omitempty"`
+	Status MQTTSourceStatus `json:"status,omitempty"`
+}
+
+var (
+	_ runtime.Object     = (*MQTTSource)(nil)
+	_ kmeta.OwnerRefable = (*MQTTSource)(nil)
+	_ apis.Validatable   = (*MQTTSource)(nil)
+	_ apis.Defaultable   = (*MQTTSource)(nil)
+	_ apis.HasSpec       = (*MQTTSource)(nil)
+	_ duckv1.KRShaped    = (*MQTTSource)(nil)
+)
+
+// MQTTSourceSpec defines the desired state of MQTTSource
+type MQTTSourceSpec struct {
+	// inherits duck/v1 SourceSpec, which currently provides:
+	// * Sink - a reference to an object that will resolve to a domain name or
+	//   a URI directly to use as the sink.
+	// * CloudEventOverrides - defines overrides to control the output format
+	//   and modifications of the event sent to the sink.
+	duckv1.SourceSpec `json:",inline"`
+
+	// BrokerURL is the URL of the MQTT broker, like `tcp://broker:1883`, or
+	// `ssl://broker:8883` to connect using TLS.
+	BrokerURL string `json:"brokerURL"`
+
+	// ClientID is the client identifier presented to the broker. Defaults to
+	// a name derived from the namespace and name of the source.
+	// +optional
+	ClientID string `json:"clientID,omitempty"`
+
+	// Topics are the topic filters to subscribe to. They can contain the `+`
+	// single level and `#` multi level wildcards.
+	Topics []MQTTTopic `json:"topics"`
+
+	// Auth is the username and password used to connect to the broker.
+	// +optional
+	Auth *MQTTAuth `json:"auth,omitempty"`
+
+	// TLS configures the TLS connection to the broker.
+	// +optional
+	TLS *MQTTTLS `json:"tls,omitempty"`
+
+	// Reconnect configures the backoff between attempts to reconnect to the
+	// broker after the connection is lost.
+	// +optional
+	Reconnect *MQTTReconnect `json:"reconnect,omitempty"`
+
+	// ServiceAccountName is the name of the ServiceAccount that will be used
+	// to run the source.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+}
+
+// MQTTTopic is an MQTT topic filter and the QoS level of its subscription.
+type MQTTTopic struct {
+	// Filter is the topic filter, for instance `sensors/+/temperature`.
+	Filter string `json:"filter"`
+
+	// QoS is the maximum quality of service level, 0, 1 or 2, at which the
+	// broker sends the messages. Messages received with QoS 1 or 2 are only
+	// acknowledged once they are delivered to the sink. Defaults to 0.
+	// +optional
+	QoS int32 `json:"qos,omitempty"`
+}
+
+// MQTTAuth references the credentials used to connect to the broker.
+type MQTTAuth struct {
+	// Username is the key of a Secret holding the username.
+	Username *corev1.SecretKeySelector `json:"username,omitempty"`
+
+	// Password is the key of a Secret holding the password.
+	// +optional
+	Password *corev1.SecretKeySelector `json:"password,omitempty"`
+}
+
+// MQTTTLS configures the TLS connection to the broker.
+type MQTTTLS struct {
+	// CACert is the key of a Secret holding the PEM encoded certificates used
+	// to verify the broker. Defaults to the system trust store.
+	// +optional
+	CACert *corev1.SecretKeySelector `json:"caCert,omitempty"`
+
+	// Cert is the key of a Secret holding the PEM encoded client certificate.
+	// +optional
+	Cert *corev1.SecretKeySelector `json:"cert,omitempty"`
+
+	// Key is the key of a Secret holding the PEM encoded client private key.
+	// +optional
+	Key *corev1.SecretKeySelector `json:"key,omitempty"`
+}
+
+// MQTTReconnect configures the exponential backoff between attempts to
+// reconnect to the broker.
+type MQTTReconnect struct {
+	// InitialDelay is the delay before the first attempt, expressed as an
+	// ISO-8601 duration. Defaults to 1 second.
+	// +optional
+	InitialDelay *string `json:"initialDelay,omitempty"`
+
+	// MaxDelay is the maximum delay between two attempts, expressed as an
+	// ISO-8601 duration. Defaults to 2 minutes.
+	// +optional
+	MaxDelay *string `json:"maxDelay,omitempty"`
+}
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (*MQTTSource) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("MQTTSource")
+}
+
+// MQTTSourceStatus defines the observed state of MQTTSource
+type MQTTSourceStatus struct {
+	// inherits duck/v1 SourceStatus, which currently provides:
+	// * ObservedGeneration - the 'Generation' of the Service that was last
+	//   processed by the controller.
+	// * Conditions - the latest available observations of a resource's current
+	//   state.
+	// * SinkURI - the current active sink URI that has been configured for the
+	//   Source.
+	duckv1.SourceStatus `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MQTTSourceList contains a list of MQTTSource
+type MQTTSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MQTTSource `json:"items"`
+}
+
+// GetUntypedSpec returns the spec of the MQTTSource.
+func (s *MQTTSource) GetUntypedSpec() interface{} {
+	return s.Spec
+}
+
+// GetStatus retrieves the status of the MQTTSource. Implements the KRShaped interface.
+func (s *MQTTSource) GetStatus() *duckv1.Status {
+	return &s.Status.Status
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rickb777/date/period"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+)
+
+// MQTTBrokerSchemes are the supported schemes of the MQTT broker URL, mapped
+// to whether they connect using TLS.
+var MQTTBrokerSchemes = map[string]bool{
+	"tcp":   false,
+	"mqtt":  false,
+	"ssl":   true,
+	"tls":   true,
+	"mqtts": true,
+}
+
+func (source *MQTTSource) Validate(ctx context.Context) *apis.FieldError {
+	ctx = apis.WithinParent(ctx, source.ObjectMeta)
+	return source.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (spec *MQTTSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	secure := false
+	if spec.BrokerURL == "" {
+		errs = errs.Also(apis.ErrMissingField("brokerURL"))
+	} else if u, err := url.Parse(spec.BrokerURL); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(spec.BrokerURL, "brokerURL", err.Error()))
+	} else if tls, ok := MQTTBrokerSchemes[u.Scheme]; !ok || u.Host == "" {
+		errs = errs.Also(apis.ErrInvalidValue(spec.BrokerURL, "brokerURL", "must be an absolute URL with a tcp, mqtt, ssl, tls or mqtts scheme"))
+	} else {
+		secure = tls
+	}
+
+	if len(spec.Topics) == 0 {
+		errs = errs.Also(apis.ErrMissingField("topics"))
+	}
+	for i, topic := range spec.Topics {
+		errs = errs.Also(topic.Validate(ctx).ViaFieldIndex("topics", i))
+	}
+
+	if spec.Auth != nil {
+		errs = errs.Also(spec.Auth.Validate(ctx).ViaField("auth"))
+	}
+
+	if spec.TLS != nil {
+		if !secure && spec.BrokerURL != "" {
+			errs = errs.Also(apis.ErrGeneric("tls requires a brokerURL with a ssl, tls or mqtts scheme", "tls"))
+		}
+		errs = errs.Also(spec.TLS.Validate(ctx).ViaField("tls"))
+	}
+
+	if spec.Reconnect != nil {
+		errs = errs.Also(spec.Reconnect.Validate(ctx).ViaField("reconnect"))
+	}
+
+	errs = errs.Also(spec.SourceSpec.Validate(ctx))
+	return errs
+}
+
+func (t *MQTTTopic) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if t.Filter == "" {
+		errs = errs.Also(apis.ErrMissingField("filter"))
+	} else {
+		levels := strings.Split(t.Filter, "/")
+		for i, level := range levels {
+			if (strings.Contains(level, "#") && (level != "#" || i != len(levels)-1)) ||
+				(strings.Contains(level, "+") && level != "+") {
+				errs = errs.Also(apis.ErrInvalidValue(t.Filter, "filter", "wildcards must occupy a whole level, and # must be the last level"))
+				break
+			}
+		}
+	}
+
+	if t.QoS < 0 || t.QoS > 2 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(t.QoS, 0, 2, "qos"))
+	}
+	return errs
+}
+
+func (a *MQTTAuth) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if a.Username == nil {
+		errs = errs.Also(apis.ErrMissingField("username"))
+	} else {
+		errs = errs.Also(validateSecretKeySelector(a.Username).ViaField("username"))
+	}
+	if a.Password != nil {
+		errs = errs.Also(validateSecretKeySelector(a.Password).ViaField("password"))
+	}
+	return errs
+}
+
+func (t *MQTTTLS) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if t.CACert != nil {
+		errs = errs.Also(validateSecretKeySelector(t.CACert).ViaField("caCert"))
+	}
+	if t.Cert != nil {
+		errs = errs.Also(validateSecretKeySelector(t.Cert).ViaField("cert"))
+	}
+	if t.Key != nil {
+		errs = errs.Also(validateSecretKeySelector(t.Key).ViaField("key"))
+	}
+	if (t.Cert == nil) != (t.Key == nil) {
+		errs = errs.Also(apis.ErrGeneric("expected both or neither", "cert", "key"))
+	}
+	return errs
+}
+
+func (r *MQTTReconnect) Validate(ctx context.Context) *apis.FieldError {
+	return validateReconnect(r.InitialDelay, r.MaxDelay)
+}
+
+// validateReconnect validates the initial and maximum delays, expressed as
+// ISO-8601 periods, of a reconnection backoff.
+func validateReconnect(initialDelay, maxDelay *string) *apis.FieldError {
+	initial, errs := validatePositivePeriod(initialDelay, "initialDelay")
+	max, err := validatePositivePeriod(maxDelay, "maxDelay")
+	errs = errs.Also(err)
+
+	if errs == nil && initial > 0 && max > 0 && initial > max {
+		errs = errs.Also(apis.ErrInvalidValue(*initialDelay, "initialDelay", "must not be greater than maxDelay"))
+	}
+	return errs
+}
+
+// validatePositivePeriod returns the duration of the ISO-8601 period p, if
+// set.
+func validatePositivePeriod(p *string, field string) (time.Duration, *apis.FieldError) {
+	if p == nil {
+		return 0, nil
+	}
+	parsed, err := period.Parse(*p)
+	if err != nil || parsed.IsNegative() || parsed.IsZero() {
+		return 0, apis.ErrInvalidValue(*p, field)
+	}
+	d, _ := parsed.Duration()
+	return d, nil
+}
+
+func validateSecretKeySelector(s *corev1.SecretKeySelector) *apis.FieldError {
+	var errs *apis.FieldError
+	if s.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	}
+	if s.Key == "" {
+		errs = errs.Also(apis.ErrMissingField("key"))
+	}
+	return errs
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+)
+
+func TestMQTTSourceSpecValidation(t *testing.T) {
+	secret := func(key string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mqtt"},
+			Key:                  key,
+		}
+	}
+
+	spec := func(modify func(spec *MQTTSourceSpec)) MQTTSourceSpec {
+		s := MQTTSourceSpec{
+			BrokerURL: "tcp://broker:1883",
+			Topics:    []MQTTTopic{{Filter: "sensors/+/temperature", QoS: 1}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					URI: apis.HTTP("example.com"),
+				},
+			},
+		}
+		if modify != nil {
+			modify(&s)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name string
+		spec MQTTSourceSpec
+		want *apis.FieldError
+	}{{
+		name: "valid",
+		spec: spec(nil),
+	}, {
+		name: "valid with auth, tls and reconnect",
+		spec: spec(func(s *MQTTSourceSpec) {
+			s.BrokerURL = "ssl://broker:8883"
+			s.Topics = append(s.Topics, MQTTTopic{Filter: "alerts/#", QoS: 2})
+			s.Auth = &MQTTAuth{Username: secret("username"), Password: secret("password")}
+			s.TLS = &MQTTTLS{CACert: secret("ca.crt"), Cert: secret("tls.crt"), Key: secret("tls.key")}
+			s.Reconnect = &MQTTReconnect{InitialDelay: ptr.String("PT1S"), MaxDelay: ptr.String("PT2M")}
+		}),
+	}, {
+		name: "missing broker URL",
+		spec: spec(func(s *MQTTSourceSpec) {
+			s.BrokerURL = ""
+		}),
+		want: apis.ErrMissingField("brokerURL"),
+	}, {
+		name: "unsupported broker URL scheme",
+		spec: spec(func(s *MQTTSourceSpec) {
+			s.BrokerURL = "http://broker"
+		}),
+		want: apis.ErrInvalidValue("http://broker", "brokerURL", "must be an absolute URL with a tcp, mqtt, ssl, tls or mqtts scheme"),
+	}, {
+		name: "missing topics",
+		spec: spec(func(s *MQTTSourceSpec) {
+			s.Topics = nil
+		}),
+		want: apis.ErrMissingField("topics"),
+	}, {
+		name: "invalid wildcard",
+		spec: spec(func(s *MQTTSourceSpec) {
+			s.Topics = []MQTTTopic{{Filter: "sensors/#/temperature"}}
+		}),
+		want: apis.ErrInvalidValue("sensors/#/temperature", "filter", "wildcards must occupy a whole level, and # must be the last level").ViaFieldIndex("topics", 0),
+	}, {
+		name: "invalid qos",
+		spec: spec(func(s *MQTTSourceSpec) {
+			s.Topics = []MQTTTopic{{Filter: "sensors", QoS: 3}}
+		}),
+		want: apis.ErrOutOfBoundsValue(3, 0, 2, "qos").ViaFieldIndex("topics", 0),
+	}, {
+		name: "missing username",
+		spec: spec(func(s *MQTTSourceSpec) {
+			s.Auth = &MQTTAuth{Password: secret("password")}
+		}),
+		want: apis.ErrMissingField("auth.username"),
+	}, {
+		name: "tls without secure scheme",
+		spec: spec(func(s *MQTTSourceSpec) {
+			s.TLS = &MQTTTLS{CACert: secret("ca.crt")}
+		}),
+		want: apis.ErrGeneric("tls requires a brokerURL with a ssl, tls or mqtts scheme", "tls"),
+	}, {
+		name: "client certificate without key",
+		spec: spec(func(s *MQTTSourceSpec) {
+			s.BrokerURL = "mqtts://broker"
+			s.TLS = &MQTTTLS{Cert: secret("tls.crt")}
+		}),
+		want: apis.ErrGeneric("expected both or neither", "tls.cert", "tls.key"),
+	}, {
+		name: "initial delay greater than max delay",
+		spec: spec(func(s *MQTTSourceSpec) {
+			s.Reconnect = &MQTTReconnect{InitialDelay: ptr.String("PT5M"), MaxDelay: ptr.String("PT1M")}
+		}),
+		want: apis.ErrInvalidValue("PT5M", "reconnect.initialDelay", "must not be greater than maxDelay"),
+	}, {
+		name: "invalid delay",
+		spec: spec(func(s *MQTTSourceSpec) {
+			s.Reconnect = &MQTTReconnect{MaxDelay: ptr.String("2m")}
+		}),
+		want: apis.ErrInvalidValue("2m", "reconnect.maxDelay"),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.spec.Validate(context.Background())
+			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+				t.Error("MQTTSourceSpec.Validate (-want, +got) =", diff)
+			}
+		})
+	}
+}
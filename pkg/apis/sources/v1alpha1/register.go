@@ -46,6 +46,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&IntegrationSource{},
 		&IntegrationSourceList{},
+		&MQTTSource{},
+		&MQTTSourceList{},
+		&WebSocketSource{},
+		&WebSocketSourceList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
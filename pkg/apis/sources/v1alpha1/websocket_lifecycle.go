@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// WebSocketSourceConditionReady has status True when the WebSocketSource is ready to send events.
+	WebSocketSourceConditionReady = apis.ConditionReady
+
+	// WebSocketSourceConditionSinkProvided has status True when the WebSocketSource's sink has been resolved.
+	WebSocketSourceConditionSinkProvided apis.ConditionType = "SinkProvided"
+
+	// WebSocketSourceConditionConnected has status True when the receive adapter of the
+	// WebSocketSource is connected to the WebSocket server.
+	WebSocketSourceConditionConnected apis.ConditionType = "Connected"
+)
+
+var WebSocketCondSet = apis.NewLivingConditionSet(
+	WebSocketSourceConditionSinkProvided,
+	WebSocketSourceConditionConnected,
+)
+
+// GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
+func (*WebSocketSource) GetConditionSet() apis.ConditionSet {
+	return WebSocketCondSet
+}
+
+// GetTopLevelCondition returns the top level condition.
+func (s *WebSocketSourceStatus) GetTopLevelCondition() *apis.Condition {
+	return WebSocketCondSet.Manage(s).GetTopLevelCondition()
+}
+
+// GetCondition returns the condition currently associated with the given type, or nil.
+func (s *WebSocketSourceStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return WebSocketCondSet.Manage(s).GetCondition(t)
+}
+
+// InitializeConditions sets relevant unset conditions to Unknown state.
+func (s *WebSocketSourceStatus) InitializeConditions() {
+	WebSocketCondSet.Manage(s).InitializeConditions()
+}
+
+// IsReady returns true if the resource is ready overall.
+func (s *WebSocketSourceStatus) IsReady() bool {
+	return WebSocketCondSet.Manage(s).IsHappy()
+}
+
+// PropagateContainerSourceStatus sets the status of the WebSocketSource from
+// the status of its ContainerSource. The receive adapter is only ready while
+// it is connected to the server, so the readiness of the ContainerSource's
+// receive adapter is the health of the connection.
+func (s *WebSocketSourceStatus) PropagateContainerSourceStatus(status *v1.ContainerSourceStatus) {
+	conditions := s.Conditions
+	s.SourceStatus = *status.SourceStatus.DeepCopy()
+	s.Conditions = conditions
+
+	s.propagateCondition(status, v1.ContainerSourceConditionSinkBindingReady, WebSocketSourceConditionSinkProvided)
+
+	cond := status.GetCondition(v1.ContainerSourceConditionReceiveAdapterReady)
+	if cond != nil && cond.Status == corev1.ConditionFalse {
+		WebSocketCondSet.Manage(s).MarkFalse(WebSocketSourceConditionConnected, "NotConnected",
+			"The receive adapter is not connected to the WebSocket server: %s", cond.Message)
+		return
+	}
+	s.propagateCondition(status, v1.ContainerSourceConditionReceiveAdapterReady, WebSocketSourceConditionConnected)
+}
+
+func (s *WebSocketSourceStatus) propagateCondition(status *v1.ContainerSourceStatus, from, to apis.ConditionType) {
+	cond := status.GetCondition(from)
+	switch {
+	case cond == nil:
+		WebSocketCondSet.Manage(s).MarkUnknown(to, "", "")
+	case cond.Status == corev1.ConditionTrue:
+		WebSocketCondSet.Manage(s).MarkTrue(to)
+	case cond.Status == corev1.ConditionFalse:
+		WebSocketCondSet.Manage(s).MarkFalse(to, cond.Reason, cond.Message)
+	default:
+		WebSocketCondSet.Manage(s).MarkUnknown(to, cond.Reason, cond.Message)
+	}
+}
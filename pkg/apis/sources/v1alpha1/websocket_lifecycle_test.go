@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+func TestWebSocketSourceGetConditionSet(t *testing.T) {
+	r := &WebSocketSource{}
+
+	if got, want := r.GetConditionSet().GetTopLevelConditionType(), apis.ConditionReady; got != want {
+		t.Errorf("GetTopLevelCondition=%v, want=%v", got, want)
+	}
+}
+
+func TestWebSocketSourceStatusIsReady(t *testing.T) {
+	containerSourceStatus := func(sinkBinding, receiveAdapter corev1.ConditionStatus) *v1.ContainerSourceStatus {
+		return &v1.ContainerSourceStatus{
+			SourceStatus: duckv1.SourceStatus{
+				Status: duckv1.Status{
+					Conditions: []apis.Condition{{
+						Type:   v1.ContainerSourceConditionSinkBindingReady,
+						Status: sinkBinding,
+					}, {
+						Type:    v1.ContainerSourceConditionReceiveAdapterReady,
+						Status:  receiveAdapter,
+						Reason:  "MinimumReplicasUnavailable",
+						Message: "Deployment does not have minimum availability.",
+					}},
+				},
+				SinkURI: apis.HTTP("example.com"),
+			},
+		}
+	}
+
+	tests := []struct {
+		name                string
+		s                   *WebSocketSourceStatus
+		wantConditionStatus corev1.ConditionStatus
+		wantConnected       corev1.ConditionStatus
+		want                bool
+	}{{
+		name: "uninitialized",
+		s:    &WebSocketSourceStatus{},
+		want: false,
+	}, {
+		name: "initialized",
+		s: func() *WebSocketSourceStatus {
+			s := &WebSocketSourceStatus{}
+			s.InitializeConditions()
+			return s
+		}(),
+		wantConditionStatus: corev1.ConditionUnknown,
+		wantConnected:       corev1.ConditionUnknown,
+		want:                false,
+	}, {
+		name: "connected",
+		s: func() *WebSocketSourceStatus {
+			s := &WebSocketSourceStatus{}
+			s.InitializeConditions()
+			s.PropagateContainerSourceStatus(containerSourceStatus(corev1.ConditionTrue, corev1.ConditionTrue))
+			return s
+		}(),
+		wantConditionStatus: corev1.ConditionTrue,
+		wantConnected:       corev1.ConditionTrue,
+		want:                true,
+	}, {
+		name: "not connected",
+		s: func() *WebSocketSourceStatus {
+			s := &WebSocketSourceStatus{}
+			s.InitializeConditions()
+			s.PropagateContainerSourceStatus(containerSourceStatus(corev1.ConditionTrue, corev1.ConditionFalse))
+			return s
+		}(),
+		wantConditionStatus: corev1.ConditionFalse,
+		wantConnected:       corev1.ConditionFalse,
+		want:                false,
+	}, {
+		name: "sink not provided",
+		s: func() *WebSocketSourceStatus {
+			s := &WebSocketSourceStatus{}
+			s.InitializeConditions()
+			s.PropagateContainerSourceStatus(containerSourceStatus(corev1.ConditionFalse, corev1.ConditionTrue))
+			return s
+		}(),
+		wantConditionStatus: corev1.ConditionFalse,
+		wantConnected:       corev1.ConditionTrue,
+		want:                false,
+	}, {
+		name: "not ready container source",
+		s: func() *WebSocketSourceStatus {
+			s := &WebSocketSourceStatus{}
+			s.InitializeConditions()
+			s.PropagateContainerSourceStatus(&notReadyContainerSource.Status)
+			return s
+		}(),
+		wantConditionStatus: corev1.ConditionUnknown,
+		wantConnected:       corev1.ConditionUnknown,
+		want:                false,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.wantConditionStatus != "" {
+				gotConditionStatus := test.s.GetTopLevelCondition().Status
+				if gotConditionStatus != test.wantConditionStatus {
+					t.Errorf("unexpected condition status: want %v, got %v", test.wantConditionStatus, gotConditionStatus)
+				}
+			}
+			if test.wantConnected != "" {
+				gotConnected := test.s.GetCondition(WebSocketSourceConditionConnected).Status
+				if gotConnected != test.wantConnected {
+					t.Errorf("unexpected Connected status: want %v, got %v", test.wantConnected, gotConnected)
+				}
+			}
+			got := test.s.IsReady()
+			if got != test.want {
+				t.Errorf("unexpected readiness: want %v, got %v", test.want, got)
+			}
+		})
+	}
+}
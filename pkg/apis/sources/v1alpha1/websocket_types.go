@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/kmeta"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WebSocketSource is an event source that connects to a WebSocket server and
+// sends the messages it receives as CloudEvents.
+type WebSocketSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WebSocketSourceSpec   `json:"spec,omitempty"`
+	Status WebSocketSourceStatus `json:"status,omitempty"`
+}
+
+var (
+	_ runtime.Object     = (*WebSocketSource)(nil)
+	_ kmeta.OwnerRefable = (*WebSocketSource)(nil)
+	_ apis.Validatable   = (*WebSocketSource)(nil)
+	_ apis.Defaultable   = (*WebSocketSource)(nil)
+	_ apis.HasSpec       = (*WebSocketSource)(nil)
+	_ duckv1.KRShaped    = (*WebSocketSource)(nil)
+)
+
+// WebSocketMessageFormat is how the messages received from the server are
+// turned into CloudEvents.
+type WebSocketMessageFormat string
+
+const (
+	// WebSocketMessageFormatAuto sends the messages that are CloudEvents in
+	// the JSON event format as is, and wraps the others.
+	WebSocketMessageFormatAuto WebSocketMessageFormat = "Auto"
+
+	// WebSocketMessageFormatCloudEvent expects every message to be a
+	// CloudEvent in the JSON event format, and drops the others.
+	WebSocketMessageFormatCloudEvent WebSocketMessageFormat = "CloudEvent"
+
+	// WebSocketMessageFormatRaw wraps every message in a CloudEvent whose
+	// data is the payload of the message.
+	WebSocketMessageFormatRaw WebSocketMessageFormat = "Raw"
+)
+
+// WebSocketSourceSpec defines the desired state of WebSocketSource
+type WebSocketSourceSpec struct {
+	// inherits duck/v1 SourceSpec, which currently provides:
+	// * Sink - a reference to an object that will resolve to a domain name or
+	//   a URI directly to use as the sink.
+	// * CloudEventOverrides - defines overrides to control the output format
+	//   and modifications of the event sent to the sink.
+	duckv1.SourceSpec `json:",inline"`
+
+	// URL is the URL of the WebSocket server, like `ws://server/events`, or
+	// `wss://server/events` to connect using TLS.
+	URL string `json:"url"`
+
+	// Headers are the HTTP headers sent in the opening handshake, for
+	// instance to authenticate to the server.
+	// +optional
+	Headers []WebSocketHeader `json:"headers,omitempty"`
+
+	// Subprotocols are the WebSocket subprotocols requested in the opening
+	// handshake, in order of preference.
+	// +optional
+	Subprotocols []string `json:"subprotocols,omitempty"`
+
+	// Format is how the messages, received in text or binary frames, are
+	// turned into CloudEvents, one of Auto, CloudEvent or Raw. Defaults to
+	// Auto.
+	// +optional
+	Format WebSocketMessageFormat `json:"format,omitempty"`
+
+	// EventType is the type of the CloudEvents wrapping the messages that
+	// aren't CloudEvents. Defaults to dev.knative.sources.websocket.message.
+	// +optional
+	EventType string `json:"eventType,omitempty"`
+
+	// Reconnect configures the backoff between attempts to reconnect to the
+	// server after the connection is lost.
+	// +optional
+	Reconnect *WebSocketReconnect `json:"reconnect,omitempty"`
+
+	// ServiceAccountName is the name of the ServiceAccount that will be used
+	// to run the source.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+}
+
+// WebSocketHeader is an HTTP header sent in the opening handshake.
+type WebSocketHeader struct {
+	// Name is the name of the header.
+	Name string `json:"name"`
+
+	// Value is the value of the header.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// ValueFromSecret is the key of a Secret holding the value of the header.
+	// +optional
+	ValueFromSecret *corev1.SecretKeySelector `json:"valueFromSecret,omitempty"`
+}
+
+// WebSocketReconnect configures the exponential backoff between attempts to
+// reconnect to the server.
+type WebSocketReconnect struct {
+	// InitialDelay is the delay before the first attempt, expressed as an
+	// ISO-8601 duration. Defaults to 1 second.
+	// +optional
+	InitialDelay *string `json:"initialDelay,omitempty"`
+
+	// MaxDelay is the maximum delay between two attempts, expressed as an
+	// ISO-8601 duration. Defaults to 2 minutes.
+	// +optional
+	MaxDelay *string `json:"maxDelay,omitempty"`
+}
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (*WebSocketSource) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("WebSocketSource")
+}
+
+// WebSocketSourceStatus defines the observed state of WebSocketSource
+type WebSocketSourceStatus struct {
+	// inherits duck/v1 SourceStatus, which currently provides:
+	// * ObservedGeneration - the 'Generation' of the Service that was last
+	//   processed by the controller.
+	// * Conditions - the latest available observations of a resource's current
+	//   state.
+	// * SinkURI - the current active sink URI that has been configured for the
+	//   Source.
+	duckv1.SourceStatus `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WebSocketSourceList contains a list of WebSocketSource
+type WebSocketSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WebSocketSource `json:"items"`
+}
+
+// GetUntypedSpec returns the spec of the WebSocketSource.
+func (s *WebSocketSource) GetUntypedSpec() interface{} {
+	return s.Spec
+}
+
+// GetStatus retrieves the status of the WebSocketSource. Implements the KRShaped interface.
+func (s *WebSocketSource) GetStatus() *duckv1.Status {
+	return &s.Status.Status
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/apis"
+)
+
+// webSocketHandshakeHeaders are set by the client in the opening handshake
+// and can't be overridden.
+var webSocketHandshakeHeaders = map[string]bool{
+	"Upgrade":                  true,
+	"Connection":               true,
+	"Sec-Websocket-Key":        true,
+	"Sec-Websocket-Version":    true,
+	"Sec-Websocket-Extensions": true,
+	"Sec-Websocket-Protocol":   true,
+}
+
+func (source *WebSocketSource) Validate(ctx context.Context) *apis.FieldError {
+	ctx = apis.WithinParent(ctx, source.ObjectMeta)
+	return source.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (spec *WebSocketSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if spec.URL == "" {
+		errs = errs.Also(apis.ErrMissingField("url"))
+	} else if u, err := url.Parse(spec.URL); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(spec.URL, "url", err.Error()))
+	} else if (u.Scheme != "ws" && u.Scheme != "wss") || u.Host == "" {
+		errs = errs.Also(apis.ErrInvalidValue(spec.URL, "url", "must be an absolute URL with a ws or wss scheme"))
+	}
+
+	names := make(map[string]bool, len(spec.Headers))
+	for i, header := range spec.Headers {
+		errs = errs.Also(header.Validate(ctx).ViaFieldIndex("headers", i))
+
+		name := http.CanonicalHeaderKey(header.Name)
+		if names[name] {
+			errs = errs.Also(apis.ErrInvalidArrayValue(header.Name, "headers", i).ViaField("name"))
+		}
+		names[name] = true
+	}
+
+	for i, subprotocol := range spec.Subprotocols {
+		if subprotocol == "" || strings.ContainsAny(subprotocol, " \t,") {
+			errs = errs.Also(apis.ErrInvalidArrayValue(subprotocol, "subprotocols", i))
+		}
+	}
+
+	switch spec.Format {
+	case WebSocketMessageFormatAuto, WebSocketMessageFormatCloudEvent, WebSocketMessageFormatRaw:
+	case "":
+		errs = errs.Also(apis.ErrMissingField("format"))
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(spec.Format, "format", "must be one of Auto, CloudEvent or Raw"))
+	}
+
+	if spec.EventType == "" {
+		errs = errs.Also(apis.ErrMissingField("eventType"))
+	}
+
+	if spec.Reconnect != nil {
+		errs = errs.Also(validateReconnect(spec.Reconnect.InitialDelay, spec.Reconnect.MaxDelay).ViaField("reconnect"))
+	}
+
+	errs = errs.Also(spec.SourceSpec.Validate(ctx))
+	return errs
+}
+
+func (h *WebSocketHeader) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if h.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	} else if msgs := validation.IsHTTPHeaderName(h.Name); len(msgs) > 0 {
+		errs = errs.Also(apis.ErrInvalidValue(h.Name, "name", msgs...))
+	} else if webSocketHandshakeHeaders[http.CanonicalHeaderKey(h.Name)] {
+		errs = errs.Also(apis.ErrInvalidValue(h.Name, "name", "is set by the WebSocket handshake"))
+	}
+
+	if h.Value != "" && h.ValueFromSecret != nil {
+		errs = errs.Also(apis.ErrMultipleOneOf("value", "valueFromSecret"))
+	} else if h.Value == "" && h.ValueFromSecret == nil {
+		errs = errs.Also(apis.ErrMissingOneOf("value", "valueFromSecret"))
+	} else if h.ValueFromSecret != nil {
+		errs = errs.Also(validateSecretKeySelector(h.ValueFromSecret).ViaField("valueFromSecret"))
+	}
+
+	return errs
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+)
+
+func TestWebSocketSourceSpecValidation(t *testing.T) {
+	token := &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "websocket"},
+		Key:                  "token",
+	}
+
+	spec := func(modify func(spec *WebSocketSourceSpec)) WebSocketSourceSpec {
+		s := WebSocketSourceSpec{
+			URL:       "wss://server/events",
+			Format:    WebSocketMessageFormatAuto,
+			EventType: "dev.knative.sources.websocket.message",
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					URI: apis.HTTP("example.com"),
+				},
+			},
+		}
+		if modify != nil {
+			modify(&s)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name string
+		spec WebSocketSourceSpec
+		want *apis.FieldError
+	}{{
+		name: "valid",
+		spec: spec(nil),
+	}, {
+		name: "valid with headers, subprotocols and reconnect",
+		spec: spec(func(s *WebSocketSourceSpec) {
+			s.Headers = []WebSocketHeader{
+				{Name: "Authorization", ValueFromSecret: token},
+				{Name: "X-Client", Value: "knative"},
+			}
+			s.Subprotocols = []string{"cloudevents.json", "v2.events"}
+			s.Format = WebSocketMessageFormatRaw
+			s.Reconnect = &WebSocketReconnect{InitialDelay: ptr.String("PT1S"), MaxDelay: ptr.String("PT2M")}
+		}),
+	}, {
+		name: "missing URL",
+		spec: spec(func(s *WebSocketSourceSpec) {
+			s.URL = ""
+		}),
+		want: apis.ErrMissingField("url"),
+	}, {
+		name: "unsupported URL scheme",
+		spec: spec(func(s *WebSocketSourceSpec) {
+			s.URL = "https://server/events"
+		}),
+		want: apis.ErrInvalidValue("https://server/events", "url", "must be an absolute URL with a ws or wss scheme"),
+	}, {
+		name: "header without value",
+		spec: spec(func(s *WebSocketSourceSpec) {
+			s.Headers = []WebSocketHeader{{Name: "Authorization"}}
+		}),
+		want: apis.ErrMissingOneOf("value", "valueFromSecret").ViaFieldIndex("headers", 0),
+	}, {
+		name: "header with value and secret",
+		spec: spec(func(s *WebSocketSourceSpec) {
+			s.Headers = []WebSocketHeader{{Name: "Authorization", Value: "Bearer token", ValueFromSecret: token}}
+		}),
+		want: apis.ErrMultipleOneOf("value", "valueFromSecret").ViaFieldIndex("headers", 0),
+	}, {
+		name: "invalid header name",
+		spec: spec(func(s *WebSocketSourceSpec) {
+			s.Headers = []WebSocketHeader{{Name: "X Client", Value: "knative"}}
+		}),
+		want: apis.ErrInvalidValue("X Client", "name", "a valid HTTP header must consist of alphanumeric characters or '-' (e.g. 'X-Header-Name', regex used for validation is '[-A-Za-z0-9]+')").ViaFieldIndex("headers", 0),
+	}, {
+		name: "handshake header",
+		spec: spec(func(s *WebSocketSourceSpec) {
+			s.Headers = []WebSocketHeader{{Name: "sec-websocket-protocol", Value: "v2.events"}}
+		}),
+		want: apis.ErrInvalidValue("sec-websocket-protocol", "name", "is set by the WebSocket handshake").ViaFieldIndex("headers", 0),
+	}, {
+		name: "duplicate header",
+		spec: spec(func(s *WebSocketSourceSpec) {
+			s.Headers = []WebSocketHeader{
+				{Name: "Authorization", ValueFromSecret: token},
+				{Name: "authorization", Value: "Bearer token"},
+			}
+		}),
+		want: apis.ErrInvalidArrayValue("authorization", "headers", 1).ViaField("name"),
+	}, {
+		name: "invalid subprotocol",
+		spec: spec(func(s *WebSocketSourceSpec) {
+			s.Subprotocols = []string{"v1, v2"}
+		}),
+		want: apis.ErrInvalidArrayValue("v1, v2", "subprotocols", 0),
+	}, {
+		name: "invalid format",
+		spec: spec(func(s *WebSocketSourceSpec) {
+			s.Format = "Binary"
+		}),
+		want: apis.ErrInvalidValue("Binary", "format", "must be one of Auto, CloudEvent or Raw"),
+	}, {
+		name: "initial delay greater than max delay",
+		spec: spec(func(s *WebSocketSourceSpec) {
+			s.Reconnect = &WebSocketReconnect{InitialDelay: ptr.String("PT5M"), MaxDelay: ptr.String("PT1M")}
+		}),
+		want: apis.ErrInvalidValue("PT5M", "reconnect.initialDelay", "must not be greater than maxDelay"),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.spec.Validate(context.Background())
+			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+				t.Error("WebSocketSourceSpec.Validate (-want, +got) =", diff)
+			}
+		})
+	}
+}
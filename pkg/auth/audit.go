@@ -0,0 +1,357 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"sync/atomic"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/system"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// AuditConfigName is the name of the config map configuring the audit events of authorization decisions
+	AuditConfigName = "config-authorization-audit"
+
+	// AuditConfigKey is the key of the audit configuration in the config map
+	AuditConfigKey = "audit-config"
+
+	// AuditEventType is the type of the audit events sent for authorization decisions
+	AuditEventType = "dev.knative.eventing.authorization.decision"
+
+	// AuditEventSource is the source of the audit events sent for authorization decisions
+	AuditEventSource = "knative.dev/eventing/authorization"
+
+	// auditQueueSize is the number of audit events which can wait to be sent. Audit
+	// events are dropped when the queue is full, so that auditing never blocks requests.
+	auditQueueSize = 1000
+
+	// auditSendTimeout is the timeout for sending a single audit event
+	auditSendTimeout = 5 * time.Second
+)
+
+// AuditMode defines which authorization decisions are audited
+type AuditMode string
+
+const (
+	// AuditModeDisabled audits no decisions
+	AuditModeDisabled AuditMode = "disabled"
+
+	// AuditModeDenied audits denied requests only
+	AuditModeDenied AuditMode = "denied"
+
+	// AuditModeSampled audits a sample of all decisions, as given by the sample rate
+	AuditModeSampled AuditMode = "sampled"
+
+	// AuditModeAll audits all decisions
+	AuditModeAll AuditMode = "all"
+)
+
+// AuditConfig configures the audit events of authorization decisions
+type AuditConfig struct {
+	// NamespaceDefaults are the audit configs for resources in the given namespaces.
+	// Namespace is the key, the value is the audit config for the namespace.
+	NamespaceDefaults map[string]*AuditSinkConfig `json:"namespaceDefaults,omitempty"`
+
+	// ClusterDefault is the audit config for resources in all the namespaces that
+	// are not in NamespaceDefaults.
+	ClusterDefault *AuditSinkConfig `json:"clusterDefault,omitempty"`
+}
+
+// AuditSinkConfig defines which decisions are audited and where the audit events are sent to
+type AuditSinkConfig struct {
+	// Mode defines which decisions are audited. Defaults to disabled.
+	Mode AuditMode `json:"mode,omitempty"`
+
+	// Sink is the URL the audit events are sent to
+	Sink *apis.URL `json:"sink,omitempty"`
+
+	// SinkCACerts are the CA certs trusted for an https sink, in addition to the trust bundles
+	SinkCACerts *string `json:"sinkCACerts,omitempty"`
+
+	// SinkAudience is the OIDC audience of the sink. When it is set together with the
+	// OIDCServiceAccountName, the audit events are sent with an OIDC token for it.
+	SinkAudience *string `json:"sinkAudience,omitempty"`
+
+	// OIDCServiceAccountName is the name of the service account in the system namespace,
+	// whose OIDC token is sent with the audit events
+	OIDCServiceAccountName string `json:"oidcServiceAccountName,omitempty"`
+
+	// SampleRate is the ratio of decisions audited in the sampled mode, between 0 and 1
+	SampleRate float64 `json:"sampleRate,omitempty"`
+}
+
+// NewAuditConfigFromConfigMap creates an AuditConfig from the supplied config map.
+// Auditing is disabled, when the config map has no audit configuration.
+func NewAuditConfigFromConfigMap(config *corev1.ConfigMap) (*AuditConfig, error) {
+	ac := &AuditConfig{}
+
+	value, present := config.Data[AuditConfigKey]
+	if !present || value == "" {
+		return ac, nil
+	}
+
+	if err := yaml.Unmarshal([]byte(value), ac); err != nil {
+		return nil, fmt.Errorf("failed to parse %q: %w", AuditConfigKey, err)
+	}
+
+	if err := ac.ClusterDefault.validate(); err != nil {
+		return nil, fmt.Errorf("invalid clusterDefault: %w", err)
+	}
+	for ns, c := range ac.NamespaceDefaults {
+		if err := c.validate(); err != nil {
+			return nil, fmt.Errorf("invalid namespaceDefaults for namespace %q: %w", ns, err)
+		}
+	}
+
+	return ac, nil
+}
+
+// ForNamespace returns the audit config for resources in the given namespace,
+// falling back to the cluster default. It returns nil if auditing is not configured.
+func (c *AuditConfig) ForNamespace(namespace string) *AuditSinkConfig {
+	if c == nil {
+		return nil
+	}
+	if nsConfig, ok := c.NamespaceDefaults[namespace]; ok && nsConfig != nil {
+		return nsConfig
+	}
+	return c.ClusterDefault
+}
+
+func (c *AuditSinkConfig) validate() error {
+	if c == nil {
+		return nil
+	}
+
+	switch c.Mode {
+	case "", AuditModeDisabled:
+		return nil
+	case AuditModeDenied, AuditModeAll:
+	case AuditModeSampled:
+		if c.SampleRate <= 0 || c.SampleRate > 1 {
+			return fmt.Errorf("sampleRate must be greater than 0 and at most 1, got %v", c.SampleRate)
+		}
+	default:
+		return fmt.Errorf("unknown mode %q, must be one of %q, %q, %q or %q", c.Mode, AuditModeDisabled, AuditModeDenied, AuditModeSampled, AuditModeAll)
+	}
+
+	if c.Sink == nil || !c.Sink.URL().IsAbs() {
+		return fmt.Errorf("an absolute sink URL is required for mode %q", c.Mode)
+	}
+	return nil
+}
+
+// audits returns true if the decision is to be audited. random returns a number in [0,1)
+// and is used to sample decisions.
+func (c *AuditSinkConfig) audits(allowed bool, random func() float64) bool {
+	if c == nil {
+		return false
+	}
+
+	switch c.Mode {
+	case AuditModeAll:
+		return true
+	case AuditModeDenied:
+		return !allowed
+	case AuditModeSampled:
+		return random() < c.SampleRate
+	default:
+		return false
+	}
+}
+
+// AuditRecord is the data of an audit event describing an authorization decision
+type AuditRecord struct {
+	// Allowed is true if the request was allowed
+	Allowed bool `json:"allowed"`
+
+	// Reason describes why the request was allowed or denied
+	Reason string `json:"reason"`
+
+	// Subject is the OIDC identity which sent the request
+	Subject string `json:"subject"`
+
+	// Audience is the audience of the token of the request, identifying the target
+	Audience []string `json:"audience,omitempty"`
+
+	// Namespace is the namespace of the target
+	Namespace string `json:"namespace"`
+
+	// Policy is the event policy which decided. It is empty if the default authorization mode decided.
+	Policy string `json:"policy,omitempty"`
+
+	// EventType is the type of the authorized event, if it was decoded
+	EventType string `json:"eventType,omitempty"`
+
+	// EventID is the id of the authorized event, if it was decoded
+	EventID string `json:"eventId,omitempty"`
+}
+
+// AuditSender sends an audit event to the sink, authenticated with an OIDC token of the
+// oidcServiceAccount, if it is set. It is implemented by the kncloudevents dispatcher,
+// which trusts the CA certs of the sink and the trust bundles.
+type AuditSender func(ctx context.Context, event cloudevents.Event, sink duckv1.Addressable, oidcServiceAccount *types.NamespacedName) error
+
+type auditEntry struct {
+	sink               duckv1.Addressable
+	oidcServiceAccount *types.NamespacedName
+	event              cloudevents.Event
+}
+
+// auditor sends audit events for authorization decisions. The events are queued and
+// sent asynchronously, so that auditing does not slow down the authorization.
+type auditor struct {
+	logger *zap.SugaredLogger
+	config atomic.Pointer[AuditConfig]
+	queue  chan auditEntry
+	random func() float64
+	send   atomic.Pointer[AuditSender]
+}
+
+func newAuditor(logger *zap.SugaredLogger) *auditor {
+	a := &auditor{
+		logger: logger,
+		queue:  make(chan auditEntry, auditQueueSize),
+		random: rand.Float64,
+	}
+	a.config.Store(&AuditConfig{})
+	return a
+}
+
+// watchConfig observes the audit config map. Only watchers supporting defaults are
+// used, as the config map is optional.
+func (a *auditor) watchConfig(cmw configmap.Watcher) {
+	dcmw, ok := cmw.(configmap.DefaultingWatcher)
+	if !ok {
+		return
+	}
+
+	dcmw.WatchWithDefault(corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: AuditConfigName},
+		Data:       map[string]string{},
+	}, a.updateFromConfigMap)
+}
+
+func (a *auditor) updateFromConfigMap(cm *corev1.ConfigMap) {
+	config, err := NewAuditConfigFromConfigMap(cm)
+	if err != nil {
+		a.logger.Errorw("Failed to parse audit config, keeping the previous config", zap.Error(err))
+		return
+	}
+	a.config.Store(config)
+}
+
+// audit queues an audit event for the decision on a request of the given token to a
+// resource in resourceNamespace, if it is to be audited. It drops the event if the
+// queue is full.
+func (a *auditor) audit(decision *Decision, idToken *IDToken, resourceNamespace string, authorizedEvent *cloudevents.Event) {
+	if a == nil {
+		return
+	}
+
+	sinkConfig := a.config.Load().ForNamespace(resourceNamespace)
+	if !sinkConfig.audits(decision.Allowed, a.random) {
+		return
+	}
+
+	record := auditRecord(decision, idToken, resourceNamespace, authorizedEvent)
+
+	event := cloudevents.NewEvent()
+	event.SetID(uuid.NewString())
+	event.SetType(AuditEventType)
+	event.SetSource(AuditEventSource)
+	event.SetSubject(record.Subject)
+	event.SetTime(time.Now())
+	if err := event.SetData(cloudevents.ApplicationJSON, record); err != nil {
+		a.logger.Errorw("Failed to set audit event data", zap.Error(err))
+		return
+	}
+
+	entry := auditEntry{
+		sink: duckv1.Addressable{
+			URL:      sinkConfig.Sink,
+			CACerts:  sinkConfig.SinkCACerts,
+			Audience: sinkConfig.SinkAudience,
+		},
+		event: event,
+	}
+	if sinkConfig.SinkAudience != nil && sinkConfig.OIDCServiceAccountName != "" {
+		entry.oidcServiceAccount = &types.NamespacedName{Namespace: system.Namespace(), Name: sinkConfig.OIDCServiceAccountName}
+	}
+
+	select {
+	case a.queue <- entry:
+	default:
+		a.logger.Debugw("Audit queue is full, dropping audit event", zap.String("subject", record.Subject), zap.String("namespace", record.Namespace))
+	}
+}
+
+// run sends the queued audit events until the context is done
+func (a *auditor) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case entry := <-a.queue:
+			sendCtx, cancel := context.WithTimeout(ctx, auditSendTimeout)
+			if err := a.sendEntry(sendCtx, entry); err != nil {
+				a.logger.Warnw("Failed to send audit event", zap.Stringer("sink", entry.sink.URL), zap.Error(err))
+			}
+			cancel()
+		}
+	}
+}
+
+func (a *auditor) sendEntry(ctx context.Context, entry auditEntry) error {
+	send := a.send.Load()
+	if send == nil {
+		return fmt.Errorf("no sender for audit events")
+	}
+	return (*send)(ctx, entry.event, entry.sink, entry.oidcServiceAccount)
+}
+
+// auditRecord creates the audit record of the decision for a request of the given token to
+// a resource in resourceNamespace
+func auditRecord(decision *Decision, idToken *IDToken, resourceNamespace string, event *cloudevents.Event) *AuditRecord {
+	record := &AuditRecord{
+		Allowed:   decision.Allowed,
+		Reason:    decision.Reason,
+		Subject:   idToken.Subject,
+		Audience:  idToken.Audience,
+		Namespace: resourceNamespace,
+		Policy:    decision.Policy,
+	}
+	if event != nil {
+		record.EventType = event.Type()
+		record.EventID = event.ID()
+	}
+	return record
+}
@@ -0,0 +1,350 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cetest "github.com/cloudevents/sdk-go/v2/test"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/system"
+	_ "knative.dev/pkg/system/testing"
+)
+
+func TestNewAuditConfigFromConfigMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    *AuditConfig
+		wantErr bool
+	}{
+		{
+			name: "no audit config",
+			data: map[string]string{},
+			want: &AuditConfig{},
+		}, {
+			name: "cluster and namespace config",
+			data: map[string]string{
+				AuditConfigKey: `
+clusterDefault:
+  mode: denied
+  sink: http://audit.knative-eventing.svc
+namespaceDefaults:
+  my-ns:
+    mode: sampled
+    sampleRate: 0.5
+    sink: http://audit.my-ns.svc
+`,
+			},
+			want: &AuditConfig{
+				ClusterDefault: &AuditSinkConfig{
+					Mode: AuditModeDenied,
+					Sink: apis.HTTP("audit.knative-eventing.svc"),
+				},
+				NamespaceDefaults: map[string]*AuditSinkConfig{
+					"my-ns": {
+						Mode:       AuditModeSampled,
+						SampleRate: 0.5,
+						Sink:       apis.HTTP("audit.my-ns.svc"),
+					},
+				},
+			},
+		}, {
+			name: "disabled without sink",
+			data: map[string]string{
+				AuditConfigKey: "clusterDefault:\n  mode: disabled\n",
+			},
+			want: &AuditConfig{
+				ClusterDefault: &AuditSinkConfig{Mode: AuditModeDisabled},
+			},
+		}, {
+			name: "missing sink",
+			data: map[string]string{
+				AuditConfigKey: "clusterDefault:\n  mode: all\n",
+			},
+			wantErr: true,
+		}, {
+			name: "unknown mode",
+			data: map[string]string{
+				AuditConfigKey: "clusterDefault:\n  mode: some\n  sink: http://audit.svc\n",
+			},
+			wantErr: true,
+		}, {
+			name: "invalid sample rate",
+			data: map[string]string{
+				AuditConfigKey: "namespaceDefaults:\n  my-ns:\n    mode: sampled\n    sampleRate: 2\n    sink: http://audit.svc\n",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAuditConfigFromConfigMap(&corev1.ConfigMap{Data: tt.data})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewAuditConfigFromConfigMap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("NewAuditConfigFromConfigMap() (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
+
+func TestAuditorAudit(t *testing.T) {
+	config := &AuditConfig{
+		ClusterDefault: &AuditSinkConfig{
+			Mode: AuditModeDenied,
+			Sink: apis.HTTP("audit.cluster.svc"),
+		},
+		NamespaceDefaults: map[string]*AuditSinkConfig{
+			"all-ns": {
+				Mode: AuditModeAll,
+				Sink: apis.HTTP("audit.all-ns.svc"),
+			},
+			"sampled-ns": {
+				Mode:       AuditModeSampled,
+				SampleRate: 0.5,
+				Sink:       apis.HTTP("audit.sampled-ns.svc"),
+			},
+		},
+	}
+
+	idToken := &IDToken{
+		Subject:  "system:serviceaccount:my-ns:my-sa",
+		Audience: []string{"my-audience"},
+	}
+
+	tests := []struct {
+		name      string
+		namespace string
+		decision  *Decision
+		random    float64
+		wantSink  string
+	}{
+		{
+			name:      "denied request is audited by cluster default",
+			namespace: "my-ns",
+			decision:  &Decision{Allowed: false, Reason: "denied", Policy: "my-policy"},
+			wantSink:  "http://audit.cluster.svc",
+		}, {
+			name:      "allowed request is not audited by cluster default",
+			namespace: "my-ns",
+			decision:  &Decision{Allowed: true, Reason: "allowed"},
+		}, {
+			name:      "allowed request is audited by namespace config",
+			namespace: "all-ns",
+			decision:  &Decision{Allowed: true, Reason: "allowed"},
+			wantSink:  "http://audit.all-ns.svc",
+		}, {
+			name:      "sampled request",
+			namespace: "sampled-ns",
+			decision:  &Decision{Allowed: true, Reason: "allowed"},
+			random:    0.2,
+			wantSink:  "http://audit.sampled-ns.svc",
+		}, {
+			name:      "request not sampled",
+			namespace: "sampled-ns",
+			decision:  &Decision{Allowed: false, Reason: "denied"},
+			random:    0.7,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newAuditor(zap.NewNop().Sugar())
+			a.config.Store(config)
+			a.random = func() float64 { return tt.random }
+
+			event := cetest.MinEvent()
+			a.audit(tt.decision, idToken, tt.namespace, &event)
+
+			if tt.wantSink == "" {
+				if len(a.queue) != 0 {
+					t.Fatalf("expected no audit event, got %d", len(a.queue))
+				}
+				return
+			}
+
+			if len(a.queue) != 1 {
+				t.Fatalf("expected one audit event, got %d", len(a.queue))
+			}
+			entry := <-a.queue
+			if got := entry.sink.URL.String(); got != tt.wantSink {
+				t.Errorf("unexpected sink %q, want %q", got, tt.wantSink)
+			}
+			if entry.event.Type() != AuditEventType {
+				t.Errorf("unexpected event type %q", entry.event.Type())
+			}
+
+			got := &AuditRecord{}
+			if err := entry.event.DataAs(got); err != nil {
+				t.Fatalf("failed to decode audit record: %v", err)
+			}
+			want := &AuditRecord{
+				Allowed:   tt.decision.Allowed,
+				Reason:    tt.decision.Reason,
+				Subject:   idToken.Subject,
+				Audience:  idToken.Audience,
+				Namespace: tt.namespace,
+				Policy:    tt.decision.Policy,
+				EventType: event.Type(),
+				EventID:   event.ID(),
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("unexpected audit record (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
+
+func TestAuditorDropsWhenQueueIsFull(t *testing.T) {
+	a := newAuditor(zap.NewNop().Sugar())
+	a.config.Store(&AuditConfig{
+		ClusterDefault: &AuditSinkConfig{
+			Mode: AuditModeAll,
+			Sink: apis.HTTP("audit.svc"),
+		},
+	})
+
+	for i := 0; i < auditQueueSize+10; i++ {
+		a.audit(&Decision{Allowed: true}, &IDToken{Subject: "sub"}, "my-ns", nil)
+	}
+
+	if len(a.queue) != auditQueueSize {
+		t.Errorf("expected %d queued audit events, got %d", auditQueueSize, len(a.queue))
+	}
+}
+
+func TestAuditorRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	a := newAuditor(zap.NewNop().Sugar())
+	a.config.Store(&AuditConfig{
+		ClusterDefault: &AuditSinkConfig{
+			Mode: AuditModeAll,
+			Sink: apis.HTTP("audit.svc"),
+		},
+	})
+
+	sent := make(chan string, 1)
+	send := AuditSender(func(_ context.Context, _ cloudevents.Event, sink duckv1.Addressable, _ *types.NamespacedName) error {
+		sent <- sink.URL.String()
+		return nil
+	})
+	a.send.Store(&send)
+	go a.run(ctx)
+
+	a.audit(&Decision{Allowed: true}, &IDToken{Subject: "sub"}, "my-ns", nil)
+
+	if got := <-sent; got != "http://audit.svc" {
+		t.Errorf("unexpected sink %q", got)
+	}
+}
+
+func TestAuditorOIDCAuthentication(t *testing.T) {
+	tests := []struct {
+		name       string
+		sinkConfig *AuditSinkConfig
+		want       *types.NamespacedName
+	}{
+		{
+			name: "audience and service account",
+			sinkConfig: &AuditSinkConfig{
+				Mode:                   AuditModeAll,
+				Sink:                   apis.HTTPS("audit.svc"),
+				SinkAudience:           ptr.To("audit"),
+				OIDCServiceAccountName: "audit-sender",
+			},
+			want: &types.NamespacedName{Namespace: system.Namespace(), Name: "audit-sender"},
+		}, {
+			name: "no audience",
+			sinkConfig: &AuditSinkConfig{
+				Mode:                   AuditModeAll,
+				Sink:                   apis.HTTPS("audit.svc"),
+				OIDCServiceAccountName: "audit-sender",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newAuditor(zap.NewNop().Sugar())
+			a.config.Store(&AuditConfig{ClusterDefault: tt.sinkConfig})
+
+			a.audit(&Decision{Allowed: true}, &IDToken{Subject: "sub"}, "my-ns", nil)
+
+			entry := <-a.queue
+			if diff := cmp.Diff(tt.want, entry.oidcServiceAccount); diff != "" {
+				t.Errorf("unexpected OIDC service account (-want, +got) = %v", diff)
+			}
+			if diff := cmp.Diff(tt.sinkConfig.SinkAudience, entry.sink.Audience); diff != "" {
+				t.Errorf("unexpected sink audience (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
+
+func TestVerifierAuditsAuthenticationFailures(t *testing.T) {
+	a := newAuditor(zap.NewNop().Sugar())
+	a.config.Store(&AuditConfig{
+		ClusterDefault: &AuditSinkConfig{
+			Mode: AuditModeDenied,
+			Sink: apis.HTTP("audit.svc"),
+		},
+	})
+	v := &Verifier{auditor: a}
+
+	v.auditAuthenticationFailure(http.StatusInternalServerError, ptr.To("my-audience"), "my-ns", errors.New("no audience"))
+	if len(a.queue) != 0 {
+		t.Fatalf("expected no audit event for a server error, got %d", len(a.queue))
+	}
+
+	v.auditAuthenticationFailure(http.StatusUnauthorized, ptr.To("my-audience"), "my-ns", errors.New("no JWT token found in request"))
+	if len(a.queue) != 1 {
+		t.Fatalf("expected one audit event, got %d", len(a.queue))
+	}
+
+	entry := <-a.queue
+	got := &AuditRecord{}
+	if err := entry.event.DataAs(got); err != nil {
+		t.Fatalf("failed to decode audit record: %v", err)
+	}
+	want := &AuditRecord{
+		Allowed:   false,
+		Reason:    "authentication failed: no JWT token found in request",
+		Audience:  []string{"my-audience"},
+		Namespace: "my-ns",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected audit record (-want, +got) = %v", diff)
+	}
+}
+
+func TestNilAuditor(t *testing.T) {
+	var a *auditor
+	// must not panic for verifiers created without NewVerifier
+	a.audit(&Decision{}, &IDToken{}, "my-ns", ptr.To(cetest.MinEvent()))
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/go-jose/go-jose/v3"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/types"
+
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/eventing/pkg/eventfilter"
+	"knative.dev/eventing/pkg/eventfilter/subscriptionsapi"
+	"knative.dev/eventing/pkg/eventsigning"
+)
+
+// Decision is the result of authorizing a subject sending an event to a resource
+type Decision struct {
+	// Allowed is true if the event is allowed to be sent to the resource
+	Allowed bool `json:"allowed"`
+
+	// Reason describes why the event is allowed or denied
+	Reason string `json:"reason"`
+
+	// Policy is the event policy which decided. It is empty if the default authorization mode decided.
+	Policy string `json:"policy,omitempty"`
+
+	// Rules are the evaluations of the allow and deny rules of the applying event policies
+	Rules []RuleEvaluation `json:"rules,omitempty"`
+}
+
+// RuleEvaluation is the evaluation of the subjects with filters of an event policy for a subject and an event
+type RuleEvaluation struct {
+	// Policy is the name of the event policy the rule belongs to
+	Policy string `json:"policy,omitempty"`
+
+	// Deny is true if the rule is a deny rule
+	Deny bool `json:"deny,omitempty"`
+
+	// SubjectMatched is true if the subject matches any of the subjects of the rule
+	SubjectMatched bool `json:"subjectMatched"`
+
+	// FailedFilters are the filters of the rule the event did not pass. Filters are only
+	// evaluated when the subject matched.
+	FailedFilters []eventingv1.SubscriptionsAPIFilter `json:"failedFilters,omitempty"`
+
+	// SignatureError describes why the signature of the event is not valid, when the rule
+	// requires signed events. It is only set when the subject matched.
+	SignatureError string `json:"signatureError,omitempty"`
+}
+
+// Matched returns true if the subject matched the rule and the event passed all its filters
+// and has a valid signature, if required
+func (e *RuleEvaluation) Matched() bool {
+	return e.SubjectMatched && len(e.FailedFilters) == 0 && e.SignatureError == ""
+}
+
+// Authorize decides if the event from sub is allowed by the subjects with filters of the applying
+// event policies of a resource in resourceNamespace. Deny rules take precedence over allow rules.
+// When no allow rules apply, the default authorization mode decides.
+func Authorize(ctx context.Context, features feature.Flags, sub, resourceNamespace string, subjectsWithFilters []SubjectsWithFilters, event *cloudevents.Event, logger *zap.SugaredLogger) *Decision {
+	decision := &Decision{
+		Rules: make([]RuleEvaluation, 0, len(subjectsWithFilters)),
+	}
+	for _, swf := range subjectsWithFilters {
+		decision.Rules = append(decision.Rules, evaluateRule(ctx, sub, swf, event, logger))
+	}
+
+	for _, rule := range decision.Rules {
+		if rule.Deny && rule.Matched() {
+			decision.Policy = rule.Policy
+			decision.Reason = fmt.Sprintf("token is from subject %q, which is denied by event policy %q", sub, rule.Policy)
+			return decision
+		}
+	}
+
+	if hasAllowRules(subjectsWithFilters) {
+		// the first allow rule matching the subject decides
+		for _, rule := range decision.Rules {
+			if rule.Deny || !rule.SubjectMatched {
+				continue
+			}
+
+			decision.Allowed = rule.Matched()
+			decision.Policy = rule.Policy
+			if decision.Allowed {
+				decision.Reason = fmt.Sprintf("token is from subject %q, which is allowed by event policy %q", sub, rule.Policy)
+			} else if len(rule.FailedFilters) == 0 {
+				decision.Reason = fmt.Sprintf("token is from subject %q, which is allowed by event policy %q, but the event does not have a valid signature: %s", sub, rule.Policy, rule.SignatureError)
+			} else {
+				decision.Reason = fmt.Sprintf("token is from subject %q, which is allowed by event policy %q, but the event does not pass its filters", sub, rule.Policy)
+			}
+			return decision
+		}
+
+		decision.Reason = fmt.Sprintf("token is from subject %q, but only %#v are part of applying event policies", sub, subjectsWithFilters)
+		return decision
+	}
+
+	if features.IsAuthorizationDefaultModeDenyAll() {
+		decision.Reason = fmt.Sprintf("no event policies apply for resource and %s is set to %s", feature.AuthorizationDefaultMode, feature.AuthorizationDenyAll)
+	} else if features.IsAuthorizationDefaultModeSameNamespace() {
+		decision.Allowed = strings.HasPrefix(sub, fmt.Sprintf("%s:%s:", kubernetesServiceAccountPrefix, resourceNamespace))
+		if decision.Allowed {
+			decision.Reason = fmt.Sprintf("no policies apply for resource. %s is set to %s and token is from subject %q, which is part of %q namespace", feature.AuthorizationDefaultMode, feature.AuthorizationAllowSameNamespace, sub, resourceNamespace)
+		} else {
+			decision.Reason = fmt.Sprintf("no policies apply for resource. %s is set to %s, but token is from subject %q, which is not part of %q namespace", feature.AuthorizationDefaultMode, feature.AuthorizationAllowSameNamespace, sub, resourceNamespace)
+		}
+	} else {
+		decision.Allowed = true
+		decision.Reason = fmt.Sprintf("no policies apply for resource and %s is set to %s", feature.AuthorizationDefaultMode, features[feature.AuthorizationDefaultMode])
+	}
+
+	return decision
+}
+
+// evaluateRule evaluates the subjects with filters for the given subject and event.
+// Without an event, none of the filters of a matching rule pass.
+func evaluateRule(ctx context.Context, sub string, swf SubjectsWithFilters, event *cloudevents.Event, logger *zap.SugaredLogger) RuleEvaluation {
+	evaluation := RuleEvaluation{
+		Policy: swf.Policy,
+		Deny:   swf.Deny,
+	}
+
+	for _, s := range swf.Subjects {
+		if SubjectMatches(s, sub) {
+			evaluation.SubjectMatched = true
+			break
+		}
+	}
+	if !evaluation.SubjectMatched {
+		return evaluation
+	}
+
+	for _, f := range swf.Filters {
+		if event == nil {
+			evaluation.FailedFilters = append(evaluation.FailedFilters, f)
+			continue
+		}
+
+		filter := subscriptionsapi.CreateSubscriptionsAPIFilters(logger.Desugar(), []eventingv1.SubscriptionsAPIFilter{f})
+		if filter.Filter(ctx, *event) == eventfilter.FailFilter {
+			evaluation.FailedFilters = append(evaluation.FailedFilters, f)
+		}
+	}
+
+	if swf.SignatureKeys != "" {
+		keySet := swf.signatureKeySet
+		if keySet == nil {
+			keySet = parseSignatureKeySet(swf.SignatureKeys)
+		}
+		evaluation.SignatureError = keySet.verify(event)
+	}
+
+	return evaluation
+}
+
+// signatureKeySet is a parsed JSON Web Key Set of an event policy
+type signatureKeySet struct {
+	jwks string
+	keys *jose.JSONWebKeySet
+	err  error
+}
+
+// signatureKeySets caches the parsed signature keys by the UID of the event policy, so they are only parsed
+// again when the JSON Web Key Set of the policy changes
+var signatureKeySets sync.Map
+
+// signatureKeySetFor returns the parsed JSON Web Key Set of the event policy with the given UID
+func signatureKeySetFor(uid types.UID, jwks string) *signatureKeySet {
+	if cached, ok := signatureKeySets.Load(uid); ok && cached.(*signatureKeySet).jwks == jwks {
+		return cached.(*signatureKeySet)
+	}
+
+	keySet := parseSignatureKeySet(jwks)
+	signatureKeySets.Store(uid, keySet)
+	return keySet
+}
+
+func parseSignatureKeySet(jwks string) *signatureKeySet {
+	keys, err := eventsigning.ParseKeySet(jwks)
+	return &signatureKeySet{jwks: jwks, keys: keys, err: err}
+}
+
+// verify verifies the signature of the event with the keys and returns the reason if it is not valid
+func (s *signatureKeySet) verify(event *cloudevents.Event) string {
+	if event == nil {
+		return "no event to verify"
+	}
+	if s.err != nil {
+		return fmt.Sprintf("invalid signature keys: %v", s.err)
+	}
+	if _, err := eventsigning.Verify(event, s.keys); err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+// hasAllowRules returns true if any of the subjects with filters is not a deny rule
+func hasAllowRules(subjectsWithFilters []SubjectsWithFilters) bool {
+	for _, swf := range subjectsWithFilters {
+		if !swf.Deny {
+			return true
+		}
+	}
+	return false
+}
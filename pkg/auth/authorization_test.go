@@ -0,0 +1,391 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cetest "github.com/cloudevents/sdk-go/v2/test"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+	"knative.dev/eventing/pkg/apis/feature"
+	clustereventpolicyinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy/fake"
+	eventpolicyinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy/fake"
+	"knative.dev/eventing/pkg/eventsigning"
+	namespaceinformerfake "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace/fake"
+	reconcilertesting "knative.dev/pkg/reconciler/testing"
+)
+
+func TestAuthorize(t *testing.T) {
+	failingFilter := eventingv1.SubscriptionsAPIFilter{CESQL: "false"}
+
+	tests := []struct {
+		name                string
+		features            feature.Flags
+		sub                 string
+		subjectsWithFilters []SubjectsWithFilters
+		wantAllowed         bool
+		wantRules           []RuleEvaluation
+	}{
+		{
+			name: "allowed by policy",
+			sub:  "system:serviceaccount:my-ns:my-sa",
+			subjectsWithFilters: []SubjectsWithFilters{
+				{Policy: "policy-1", Subjects: []string{"system:serviceaccount:my-ns:*"}},
+			},
+			wantAllowed: true,
+			wantRules: []RuleEvaluation{
+				{Policy: "policy-1", SubjectMatched: true},
+			},
+		}, {
+			name: "subject not part of policies",
+			sub:  "system:serviceaccount:my-ns:my-sa",
+			subjectsWithFilters: []SubjectsWithFilters{
+				{Policy: "policy-1", Subjects: []string{"system:serviceaccount:other-ns:*"}},
+			},
+			wantAllowed: false,
+			wantRules: []RuleEvaluation{
+				{Policy: "policy-1"},
+			},
+		}, {
+			name: "failed filter",
+			sub:  "system:serviceaccount:my-ns:my-sa",
+			subjectsWithFilters: []SubjectsWithFilters{
+				{Policy: "policy-1", Subjects: []string{"*"}, Filters: []eventingv1.SubscriptionsAPIFilter{{CESQL: "true"}, failingFilter}},
+			},
+			wantAllowed: false,
+			wantRules: []RuleEvaluation{
+				{Policy: "policy-1", SubjectMatched: true, FailedFilters: []eventingv1.SubscriptionsAPIFilter{failingFilter}},
+			},
+		}, {
+			name: "denied by policy",
+			sub:  "system:serviceaccount:my-ns:my-sa",
+			subjectsWithFilters: []SubjectsWithFilters{
+				{Policy: "policy-1", Subjects: []string{"*"}},
+				{Policy: "policy-2", Subjects: []string{"system:serviceaccount:my-ns:my-sa"}, Deny: true},
+			},
+			wantAllowed: false,
+			wantRules: []RuleEvaluation{
+				{Policy: "policy-1", SubjectMatched: true},
+				{Policy: "policy-2", SubjectMatched: true, Deny: true},
+			},
+		}, {
+			name:     "only deny rules, default mode applies",
+			features: feature.Flags{feature.AuthorizationDefaultMode: feature.AuthorizationAllowSameNamespace},
+			sub:      "system:serviceaccount:my-ns:my-sa",
+			subjectsWithFilters: []SubjectsWithFilters{
+				{Policy: "policy-1", Subjects: []string{"system:serviceaccount:my-ns:other-sa"}, Deny: true},
+			},
+			wantAllowed: true,
+			wantRules: []RuleEvaluation{
+				{Policy: "policy-1", Deny: true},
+			},
+		}, {
+			name:        "no policies, deny all",
+			features:    feature.Flags{feature.AuthorizationDefaultMode: feature.AuthorizationDenyAll},
+			sub:         "system:serviceaccount:my-ns:my-sa",
+			wantAllowed: false,
+			wantRules:   []RuleEvaluation{},
+		}, {
+			name:        "no policies, other namespace",
+			features:    feature.Flags{feature.AuthorizationDefaultMode: feature.AuthorizationAllowSameNamespace},
+			sub:         "system:serviceaccount:other-ns:my-sa",
+			wantAllowed: false,
+			wantRules:   []RuleEvaluation{},
+		}, {
+			name:        "no policies, allow all",
+			features:    feature.Flags{feature.AuthorizationDefaultMode: feature.AuthorizationAllowAll},
+			sub:         "system:serviceaccount:other-ns:my-sa",
+			wantAllowed: true,
+			wantRules:   []RuleEvaluation{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Authorize(context.Background(), tt.features, tt.sub, "my-ns", tt.subjectsWithFilters, ptr.To(cetest.MinEvent()), zap.NewNop().Sugar())
+			if got.Allowed != tt.wantAllowed {
+				t.Errorf("Authorize() allowed = %v, want %v (reason: %s)", got.Allowed, tt.wantAllowed, got.Reason)
+			}
+			if got.Reason == "" {
+				t.Error("Authorize() returned no reason")
+			}
+			if diff := cmp.Diff(tt.wantRules, got.Rules); diff != "" {
+				t.Errorf("Authorize() rules (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
+
+func TestAuthorizeSignature(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jwks, err := json.Marshal(jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{{Key: &key.PublicKey, KeyID: "my-key"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signedEvent := func(key *ecdsa.PrivateKey) *cloudevents.Event {
+		event := cetest.MinEvent()
+		signer, err := eventsigning.NewSigner(key, "my-key")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := signer.Sign(&event); err != nil {
+			t.Fatal(err)
+		}
+		return &event
+	}
+
+	tests := []struct {
+		name        string
+		event       *cloudevents.Event
+		wantAllowed bool
+	}{
+		{
+			name:        "signed by trusted key",
+			event:       signedEvent(key),
+			wantAllowed: true,
+		}, {
+			name:        "signed by other key",
+			event:       signedEvent(otherKey),
+			wantAllowed: false,
+		}, {
+			name:        "not signed",
+			event:       ptr.To(cetest.MinEvent()),
+			wantAllowed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subjectsWithFilters := []SubjectsWithFilters{
+				{Policy: "policy-1", Subjects: []string{"*"}, SignatureKeys: string(jwks)},
+			}
+			got := Authorize(context.Background(), nil, "system:serviceaccount:my-ns:my-sa", "my-ns", subjectsWithFilters, tt.event, zap.NewNop().Sugar())
+			if got.Allowed != tt.wantAllowed {
+				t.Errorf("Authorize() allowed = %v, want %v (reason: %s)", got.Allowed, tt.wantAllowed, got.Reason)
+			}
+			if !tt.wantAllowed && got.Rules[0].SignatureError == "" {
+				t.Error("Authorize() expected a signature error")
+			}
+		})
+	}
+}
+
+func TestSignatureKeySetFor(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks, err := json.Marshal(jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{{Key: &key.PublicKey, KeyID: "my-key"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := signatureKeySetFor("policy-uid", string(jwks))
+	if first.err != nil {
+		t.Fatalf("signatureKeySetFor() unexpected error: %v", first.err)
+	}
+	if got := signatureKeySetFor("policy-uid", string(jwks)); got != first {
+		t.Error("signatureKeySetFor() parsed the unchanged keys again")
+	}
+
+	changed := signatureKeySetFor("policy-uid", "{}")
+	if changed == first {
+		t.Error("signatureKeySetFor() returned the cached keys after the keys changed")
+	}
+	if changed.err == nil {
+		t.Error("signatureKeySetFor() expected an error for a key set without keys")
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	ctx, _ := reconcilertesting.SetupFakeContext(t)
+
+	readyPolicy := &v1alpha1.EventPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ready-policy",
+			Namespace: "my-ns",
+		},
+		Spec: v1alpha1.EventPolicySpec{
+			From: []v1alpha1.EventPolicySpecFrom{{Sub: ptr.To("system:serviceaccount:my-ns:*")}},
+		},
+		Status: v1alpha1.EventPolicyStatus{
+			From: []string{"system:serviceaccount:my-ns:*"},
+		},
+	}
+	readyPolicy.Status.MarkOIDCAuthenticationEnabled()
+	readyPolicy.Status.MarkSubjectsResolvedSucceeded()
+
+	unreadyPolicy := &v1alpha1.EventPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "unready-policy",
+			Namespace: "my-ns",
+		},
+		Spec: v1alpha1.EventPolicySpec{
+			Deny: []v1alpha1.EventPolicySpecDeny{{From: []v1alpha1.EventPolicySpecFrom{{Sub: ptr.To("system:serviceaccount:my-ns:my-sa")}}}},
+		},
+	}
+
+	for _, p := range []*v1alpha1.EventPolicy{readyPolicy, unreadyPolicy} {
+		if err := eventpolicyinformerfake.Get(ctx).Informer().GetStore().Add(p); err != nil {
+			t.Fatalf("error adding policies: %v", err)
+		}
+	}
+
+	clusterPolicy := &v1alpha1.ClusterEventPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster-policy",
+		},
+		Spec: v1alpha1.ClusterEventPolicySpec{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			EventPolicySpec: v1alpha1.EventPolicySpec{
+				From: []v1alpha1.EventPolicySpecFrom{{Sub: ptr.To("system:serviceaccount:other-ns:*")}},
+			},
+		},
+		Status: v1alpha1.EventPolicyStatus{
+			From: []string{"system:serviceaccount:other-ns:*"},
+		},
+	}
+	clusterPolicy.Status.MarkOIDCAuthenticationEnabled()
+	clusterPolicy.Status.MarkSubjectsResolvedSucceeded()
+	if err := clustereventpolicyinformerfake.Get(ctx).Informer().GetStore().Add(clusterPolicy); err != nil {
+		t.Fatalf("error adding cluster policy: %v", err)
+	}
+	if err := namespaceinformerfake.Get(ctx).Informer().GetStore().Add(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-ns", Labels: map[string]string{"team": "a"}},
+	}); err != nil {
+		t.Fatalf("error adding namespace: %v", err)
+	}
+
+	request := &SimulationRequest{
+		Target: SimulationTarget{
+			APIVersion: "eventing.knative.dev/v1",
+			Kind:       "Broker",
+			Name:       "my-broker",
+			Namespace:  "my-ns",
+		},
+		Sub:   "system:serviceaccount:my-ns:my-sa",
+		Event: ptr.To(cetest.MinEvent()),
+	}
+	targetObjectMeta := metav1.ObjectMeta{Name: "my-broker", Namespace: "my-ns"}
+	features := feature.Flags{feature.OIDCAuthentication: feature.Enabled}
+
+	got, err := Simulate(ctx, features, eventpolicyinformerfake.Get(ctx).Lister(), clustereventpolicyinformerfake.Get(ctx).Lister(), namespaceinformerfake.Get(ctx).Lister(), request, targetObjectMeta, zap.NewNop().Sugar())
+	if err != nil {
+		t.Fatalf("Simulate() error = %v", err)
+	}
+
+	want := &SimulationResult{
+		Decision: Decision{
+			Allowed: true,
+			Reason:  got.Reason,
+			Policy:  "ready-policy",
+			Rules: []RuleEvaluation{
+				{Policy: "ready-policy", SubjectMatched: true},
+				{Policy: "ClusterEventPolicy/cluster-policy"},
+			},
+		},
+		Policies: []SimulatedPolicy{
+			{Name: "ready-policy", Applied: true},
+			{Name: "unready-policy", Applied: false},
+			{Name: "cluster-policy", Kind: "ClusterEventPolicy", Applied: true},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Simulate() (-want, +got) = %v", diff)
+	}
+}
+
+func TestAppendSubjectsWithFiltersDenyRules(t *testing.T) {
+	filter := eventingv1.SubscriptionsAPIFilter{Exact: map[string]string{"type": "a"}}
+	spec := &v1alpha1.EventPolicySpec{
+		Deny: []v1alpha1.EventPolicySpecDeny{{
+			From:    []v1alpha1.EventPolicySpecFrom{{Sub: ptr.To("system:serviceaccount:my-ns:my-sa")}},
+			Filters: []eventingv1.SubscriptionsAPIFilter{filter},
+		}},
+	}
+
+	tests := []struct {
+		name       string
+		generation int64
+		status     v1alpha1.EventPolicyStatus
+		want       []string
+	}{
+		{
+			name:       "reconciled",
+			generation: 2,
+			status:     eventPolicyStatus(2, "system:serviceaccount:my-ns:my-sa"),
+			want:       []string{"system:serviceaccount:my-ns:my-sa"},
+		},
+		{
+			name:       "status of a previous generation",
+			generation: 3,
+			status:     eventPolicyStatus(2, "system:serviceaccount:my-ns:other-sa"),
+			want:       []string{"*"},
+		},
+		{
+			name:       "deny rules not yet resolved",
+			generation: 2,
+			status:     eventPolicyStatus(2),
+			want:       []string{"*"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := appendSubjectsWithFilters(nil, "policy", "uid", tt.generation, spec, &tt.status)
+			want := []SubjectsWithFilters{{Subjects: tt.want, Filters: []eventingv1.SubscriptionsAPIFilter{filter}, Deny: true, Policy: "policy"}}
+			if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(SubjectsWithFilters{})); diff != "" {
+				t.Errorf("unexpected subjects with filters (-want, +got) = %s", diff)
+			}
+		})
+	}
+}
+
+// eventPolicyStatus returns the status of an event policy with one deny rule per denied subject
+func eventPolicyStatus(observedGeneration int64, deniedSubs ...string) v1alpha1.EventPolicyStatus {
+	status := v1alpha1.EventPolicyStatus{}
+	status.ObservedGeneration = observedGeneration
+	for _, sub := range deniedSubs {
+		status.Deny = append(status.Deny, v1alpha1.EventPolicyStatusDeny{From: []string{sub}})
+	}
+	return status
+}
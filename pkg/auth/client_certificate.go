@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"k8s.io/client-go/tools/cache"
+	filteredconfigmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered"
+	"knative.dev/pkg/controller"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+
+	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/eventing/pkg/eventingtls"
+)
+
+const spiffeScheme = "spiffe"
+
+// SpiffeIDFromCertificate returns the SPIFFE ID of the certificate, which is its URI SAN
+// with the spiffe scheme, or an empty string if it has none.
+func SpiffeIDFromCertificate(cert *x509.Certificate) string {
+	if u := spiffeURIFromCertificate(cert); u != nil {
+		return u.String()
+	}
+	return ""
+}
+
+func spiffeURIFromCertificate(cert *x509.Certificate) *url.URL {
+	for _, uri := range cert.URIs {
+		if uri.Scheme == spiffeScheme {
+			return uri
+		}
+	}
+	return nil
+}
+
+// isSpiffeID returns true if the subject is a SPIFFE ID. SPIFFE IDs are only
+// accepted as subjects of client certificates, never from JWTs.
+func isSpiffeID(subject string) bool {
+	return strings.HasPrefix(subject, spiffeScheme+"://")
+}
+
+// hasClientCertificate returns true if the client presented a certificate in the TLS handshake
+func hasClientCertificate(state *tls.ConnectionState) bool {
+	return state != nil && len(state.PeerCertificates) > 0
+}
+
+// watchTrustBundles drops the cached pool of the trust bundles whenever a trust bundle
+// ConfigMap changes, so that it is rebuilt from the lister on its next use.
+func (v *Verifier) watchTrustBundles(ctx context.Context) {
+	filteredconfigmapinformer.Get(ctx, eventingtls.TrustBundleLabelSelector).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: pkgreconciler.NamespaceFilterFunc(system.Namespace()),
+		Handler: controller.HandleAll(func(any) {
+			v.trustBundlePool.Store(nil)
+		}),
+	})
+}
+
+// trustBundleRoots returns the pool of the trust bundle ConfigMaps, which are the only
+// CAs trusted to issue client certificates. Unlike the pool of eventingtls, it does not
+// include the system roots.
+func (v *Verifier) trustBundleRoots() (*x509.CertPool, error) {
+	if pool := v.trustBundlePool.Load(); pool != nil {
+		return pool, nil
+	}
+
+	if v.trustBundleConfigMapLister == nil {
+		return nil, errors.New("no trust bundles configured")
+	}
+
+	cms, err := v.trustBundleConfigMapLister.List(eventingtls.TrustBundleSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trust bundle ConfigMaps: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	for _, cm := range cms {
+		for _, pem := range cm.Data {
+			pool.AppendCertsFromPEM([]byte(pem))
+		}
+		for _, pem := range cm.BinaryData {
+			pool.AppendCertsFromPEM(pem)
+		}
+	}
+
+	v.trustBundlePool.Store(pool)
+	return pool, nil
+}
+
+// verifyClientCertificate verifies the client certificate of the connection against the
+// trust bundles and returns an IDToken with its SPIFFE ID as subject. The SPIFFE ID must
+// be in the configured trust domain. The handshake already proved that the client holds
+// the private key of the certificate.
+func (v *Verifier) verifyClientCertificate(features feature.Flags, state *tls.ConnectionState) (*IDToken, error) {
+	leaf := state.PeerCertificates[0]
+
+	spiffeID := spiffeURIFromCertificate(leaf)
+	if spiffeID == nil {
+		return nil, fmt.Errorf("client certificate has no SPIFFE ID")
+	}
+	if trustDomain := features.SpiffeTrustDomain(); spiffeID.Host != trustDomain {
+		return nil, fmt.Errorf("SPIFFE ID %q of the client certificate is not in the trust domain %q", spiffeID, trustDomain)
+	}
+
+	roots, err := v.trustBundleRoots()
+	if err != nil {
+		return nil, fmt.Errorf("could not get trust bundles: %w", err)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return nil, fmt.Errorf("failed to verify client certificate: %w", err)
+	}
+
+	return &IDToken{
+		Issuer:   leaf.Issuer.String(),
+		Subject:  spiffeID.String(),
+		Expiry:   leaf.NotAfter,
+		IssuedAt: leaf.NotBefore,
+	}, nil
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/eventing/pkg/eventingtls"
+)
+
+func TestVerifyAuthNWithClientCertificate(t *testing.T) {
+	ca, caKey := newTestCertificate(t, nil, nil, "")
+	trustedCert, _ := newTestCertificate(t, ca, caKey, "spiffe://cluster.local/ns/my-ns/sa/my-sa")
+	certWithoutSpiffeID, _ := newTestCertificate(t, ca, caKey, "")
+	untrustedCA, untrustedCAKey := newTestCertificate(t, nil, nil, "")
+	untrustedCert, _ := newTestCertificate(t, untrustedCA, untrustedCAKey, "spiffe://cluster.local/ns/my-ns/sa/my-sa")
+	otherTrustDomainCert, _ := newTestCertificate(t, ca, caKey, "spiffe://other.domain/ns/my-ns/sa/my-sa")
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	if err := indexer.Add(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "trust-bundle",
+			Namespace: "knative-eventing",
+			Labels:    map[string]string{eventingtls.TrustBundleLabelKey: eventingtls.TrustBundleLabelValue},
+		},
+		Data: map[string]string{
+			"ca.crt": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw})),
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	v := &Verifier{
+		trustBundleConfigMapLister: corev1listers.NewConfigMapLister(indexer).ConfigMaps("knative-eventing"),
+	}
+
+	strict := feature.Flags{feature.TransportEncryption: feature.Strict}
+
+	tests := []struct {
+		name        string
+		features    feature.Flags
+		certs       []*x509.Certificate
+		wantSubject string
+		wantStatus  int
+	}{
+		{
+			name:        "trusted client certificate",
+			features:    strict,
+			certs:       []*x509.Certificate{trustedCert},
+			wantSubject: "spiffe://cluster.local/ns/my-ns/sa/my-sa",
+		}, {
+			name:       "untrusted client certificate",
+			features:   strict,
+			certs:      []*x509.Certificate{untrustedCert},
+			wantStatus: http.StatusUnauthorized,
+		}, {
+			name:       "client certificate of another trust domain",
+			features:   strict,
+			certs:      []*x509.Certificate{otherTrustDomainCert},
+			wantStatus: http.StatusUnauthorized,
+		}, {
+			name:        "client certificate of the configured trust domain",
+			features:    feature.Flags{feature.TransportEncryption: feature.Strict, feature.SpiffeTrustDomain: "other.domain"},
+			certs:       []*x509.Certificate{otherTrustDomainCert},
+			wantSubject: "spiffe://other.domain/ns/my-ns/sa/my-sa",
+		}, {
+			name:       "client certificate without SPIFFE ID",
+			features:   strict,
+			certs:      []*x509.Certificate{certWithoutSpiffeID},
+			wantStatus: http.StatusUnauthorized,
+		}, {
+			name:       "client certificate is ignored without strict transport encryption",
+			features:   feature.Flags{feature.TransportEncryption: feature.Permissive},
+			certs:      []*x509.Certificate{trustedCert},
+			wantStatus: http.StatusUnauthorized,
+		}, {
+			name:       "no client certificate",
+			features:   strict,
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.TLS = &tls.ConnectionState{PeerCertificates: tt.certs}
+			resp := httptest.NewRecorder()
+
+			audience := "my-audience"
+			idToken, err := v.verifyAuthN(context.Background(), tt.features, &audience, "my-ns", req, resp)
+
+			if tt.wantSubject != "" {
+				if err != nil {
+					t.Fatalf("verifyAuthN() unexpected error: %v", err)
+				}
+				if idToken.Subject != tt.wantSubject {
+					t.Errorf("verifyAuthN() subject = %q, want %q", idToken.Subject, tt.wantSubject)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("verifyAuthN() expected error")
+			}
+			if resp.Code != tt.wantStatus {
+				t.Errorf("verifyAuthN() status = %d, want %d", resp.Code, tt.wantStatus)
+			}
+		})
+	}
+}
+
+// newTestCertificate creates a CA certificate when parent is nil, otherwise a client
+// certificate signed by parent with the given SPIFFE ID as URI SAN.
+func newTestCertificate(t *testing.T, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, spiffeID string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	} else {
+		template.KeyUsage = x509.KeyUsageDigitalSignature
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+		if spiffeID != "" {
+			uri, err := url.Parse(spiffeID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			template.URIs = []*url.URL{uri}
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
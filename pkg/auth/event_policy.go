@@ -24,8 +24,6 @@ import (
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"go.uber.org/zap"
-	"knative.dev/eventing/pkg/eventfilter"
-	"knative.dev/eventing/pkg/eventfilter/subscriptionsapi"
 
 	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	"knative.dev/eventing/pkg/apis/feature"
@@ -37,8 +35,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	corev1listers "k8s.io/client-go/listers/core/v1"
 	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
 	listerseventingv1alpha1 "knative.dev/eventing/pkg/client/listers/eventing/v1alpha1"
+	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/resolver"
 )
 
@@ -78,24 +78,74 @@ func GetEventPoliciesForResource(lister listerseventingv1alpha1.EventPolicyListe
 			}
 
 			if to.Selector != nil {
-				selectorGV, err := schema.ParseGroupVersion(to.Selector.APIVersion)
+				matches, err := selectorMatchesResource(to.Selector, resourceGVK, resourceObjectMeta)
 				if err != nil {
-					return nil, fmt.Errorf("cannot split apiVersion into group and version: %s", to.Selector.APIVersion)
+					return nil, err
 				}
 
-				if strings.EqualFold(selectorGV.Group, resourceGVK.Group) &&
-					strings.EqualFold(to.Selector.Kind, resourceGVK.Kind) {
+				if matches {
+					relevantPolicies = append(relevantPolicies, policy)
+					break // no need to check the other .spec.to's from this policy
+				}
+			}
+		}
+	}
 
-					selector, err := metav1.LabelSelectorAsSelector(to.Selector.LabelSelector)
-					if err != nil {
-						return nil, fmt.Errorf("failed to parse selector: %w", err)
-					}
+	// Sort the policies by name to ensure deterministic order
+	sort.Slice(relevantPolicies, func(i, j int) bool {
+		return relevantPolicies[i].Name < relevantPolicies[j].Name
+	})
 
-					if selector.Matches(labels.Set(resourceObjectMeta.Labels)) {
-						relevantPolicies = append(relevantPolicies, policy)
-						break // no need to check the other .spec.to's from this policy
-					}
-				}
+	return relevantPolicies, nil
+}
+
+// GetClusterEventPoliciesForResource returns the applying ClusterEventPolicies for a given resource
+func GetClusterEventPoliciesForResource(lister listerseventingv1alpha1.ClusterEventPolicyLister, namespaceLister corev1listers.NamespaceLister, resourceGVK schema.GroupVersionKind, resourceObjectMeta metav1.ObjectMeta) ([]*v1alpha1.ClusterEventPolicy, error) {
+	policies, err := lister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clustereventpolicies: %w", err)
+	}
+
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	namespace, err := namespaceLister.Get(resourceObjectMeta.GetNamespace())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace %q: %w", resourceObjectMeta.GetNamespace(), err)
+	}
+
+	relevantPolicies := []*v1alpha1.ClusterEventPolicy{}
+
+	for _, policy := range policies {
+		namespaceSelector, err := namespaceSelectorOf(policy)
+		if err != nil {
+			return nil, err
+		}
+
+		if !namespaceSelector.Matches(labels.Set(namespace.Labels)) {
+			continue
+		}
+
+		if len(policy.Spec.To) == 0 {
+			// policy applies to all resources in the selected namespaces
+			relevantPolicies = append(relevantPolicies, policy)
+			continue
+		}
+
+		for _, to := range policy.Spec.To {
+			if to.Selector == nil {
+				continue
+			}
+
+			matches, err := selectorMatchesResource(to.Selector, resourceGVK, resourceObjectMeta)
+			if err != nil {
+				return nil, err
+			}
+
+			if matches {
+				relevantPolicies = append(relevantPolicies, policy)
+				break // no need to check the other .spec.to's from this policy
 			}
 		}
 	}
@@ -108,6 +158,40 @@ func GetEventPoliciesForResource(lister listerseventingv1alpha1.EventPolicyListe
 	return relevantPolicies, nil
 }
 
+// selectorMatchesResource checks if the resource is of the GK of the selector and its labels match the selector
+func selectorMatchesResource(eventPolicySelector *v1alpha1.EventPolicySelector, resourceGVK schema.GroupVersionKind, resourceObjectMeta metav1.ObjectMeta) (bool, error) {
+	selectorGV, err := schema.ParseGroupVersion(eventPolicySelector.APIVersion)
+	if err != nil {
+		return false, fmt.Errorf("cannot split apiVersion into group and version: %s", eventPolicySelector.APIVersion)
+	}
+
+	if !strings.EqualFold(selectorGV.Group, resourceGVK.Group) ||
+		!strings.EqualFold(eventPolicySelector.Kind, resourceGVK.Kind) {
+		return false, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(eventPolicySelector.LabelSelector)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse selector: %w", err)
+	}
+
+	return selector.Matches(labels.Set(resourceObjectMeta.Labels)), nil
+}
+
+// namespaceSelectorOf returns the namespace selector of the ClusterEventPolicy. A policy
+// without namespace selector selects all namespaces.
+func namespaceSelectorOf(policy *v1alpha1.ClusterEventPolicy) (labels.Selector, error) {
+	if policy.Spec.NamespaceSelector == nil {
+		return labels.Everything(), nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(policy.Spec.NamespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse namespace selector of clustereventpolicy %q: %w", policy.Name, err)
+	}
+	return selector, nil
+}
+
 // GetApplyingResourcesOfEventPolicyForGK returns all applying resource names of GK of the given event policy.
 // It returns only the names, as the resources are part of the same namespace as the event policy.
 //
@@ -173,31 +257,152 @@ func GetApplyingResourcesOfEventPolicyForGK(eventPolicy *v1alpha1.EventPolicy, g
 	return res, nil
 }
 
+// GetApplyingResourcesOfClusterEventPolicyForGK returns the keys of all applying resources of GK of the given
+// cluster event policy in the namespaces selected by its namespace selector.
+//
+// This function is kind of the "inverse" of GetClusterEventPoliciesForResource.
+func GetApplyingResourcesOfClusterEventPolicyForGK(clusterEventPolicy *v1alpha1.ClusterEventPolicy, gk schema.GroupKind, gkIndexer cache.Indexer, namespaceLister corev1listers.NamespaceLister) ([]types.NamespacedName, error) {
+	namespaceSelector, err := namespaceSelectorOf(clusterEventPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces, err := namespaceLister.List(namespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces for selector %v: %w", namespaceSelector, err)
+	}
+
+	selectors, err := resourceSelectorsOfClusterEventPolicyForGK(clusterEventPolicy, gk)
+	if err != nil {
+		return nil, err
+	}
+
+	applyingResources := map[types.NamespacedName]struct{}{}
+	for _, namespace := range namespaces {
+		for _, selector := range selectors {
+			err := cache.ListAllByNamespace(gkIndexer, namespace.Name, selector, func(i interface{}) {
+				applyingResources[types.NamespacedName{
+					Namespace: namespace.Name,
+					Name:      i.(metav1.Object).GetName(),
+				}] = struct{}{}
+			})
+			if err != nil {
+				return nil, fmt.Errorf("could not list resources of GK in %q namespace for selector %v: %w", namespace.Name, selector, err)
+			}
+		}
+	}
+
+	res := []types.NamespacedName{}
+	for key := range applyingResources {
+		res = append(res, key)
+	}
+	return res, nil
+}
+
+// resourceSelectorsOfClusterEventPolicyForGK returns the label selectors of the resources of GK the given
+// cluster event policy applies to in the namespaces selected by its namespace selector.
+func resourceSelectorsOfClusterEventPolicyForGK(clusterEventPolicy *v1alpha1.ClusterEventPolicy, gk schema.GroupKind) ([]labels.Selector, error) {
+	selectors := []labels.Selector{}
+	if len(clusterEventPolicy.Spec.To) == 0 {
+		// empty .spec.to matches everything in the selected namespaces
+		selectors = append(selectors, labels.Everything())
+	} else {
+		for _, to := range clusterEventPolicy.Spec.To {
+			if to.Selector == nil {
+				continue
+			}
+
+			selectorGV, err := schema.ParseGroupVersion(to.Selector.APIVersion)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse group version of %q: %w", to.Selector.APIVersion, err)
+			}
+
+			if strings.EqualFold(selectorGV.Group, gk.Group) &&
+				strings.EqualFold(to.Selector.Kind, gk.Kind) {
+
+				selector, err := metav1.LabelSelectorAsSelector(to.Selector.LabelSelector)
+				if err != nil {
+					return nil, fmt.Errorf("could not parse label selector %v: %w", to.Selector.LabelSelector, err)
+				}
+				selectors = append(selectors, selector)
+			}
+		}
+	}
+
+	return selectors, nil
+}
+
 // ResolveSubjects returns the OIDC service accounts names for the objects referenced in the EventPolicySpecFrom.
 func ResolveSubjects(resolver *resolver.AuthenticatableResolver, eventPolicy *v1alpha1.EventPolicy) ([]string, error) {
+	return resolveSubjectsFromList(resolver, eventPolicy.Spec.From, eventPolicy)
+}
+
+// ResolveDenySubjects returns the OIDC service accounts names for the objects referenced in each EventPolicySpecDeny.
+// Deny rules without any from entries resolve to the "*" pattern, as they apply to all subjects.
+func ResolveDenySubjects(resolver *resolver.AuthenticatableResolver, eventPolicy *v1alpha1.EventPolicy) ([]v1alpha1.EventPolicyStatusDeny, error) {
+	return resolveDenySubjectsFromList(resolver, eventPolicy.Spec.Deny, eventPolicy)
+}
+
+// ResolveClusterSubjects returns the OIDC service accounts names for the objects referenced in the
+// EventPolicySpecFrom of a ClusterEventPolicy.
+func ResolveClusterSubjects(resolver *resolver.AuthenticatableResolver, clusterEventPolicy *v1alpha1.ClusterEventPolicy) ([]string, error) {
+	return resolveSubjectsFromList(resolver, clusterEventPolicy.Spec.From, clusterEventPolicy)
+}
+
+// ResolveClusterDenySubjects returns the OIDC service accounts names for the objects referenced in each
+// EventPolicySpecDeny of a ClusterEventPolicy.
+func ResolveClusterDenySubjects(resolver *resolver.AuthenticatableResolver, clusterEventPolicy *v1alpha1.ClusterEventPolicy) ([]v1alpha1.EventPolicyStatusDeny, error) {
+	return resolveDenySubjectsFromList(resolver, clusterEventPolicy.Spec.Deny, clusterEventPolicy)
+}
+
+func resolveDenySubjectsFromList(resolver *resolver.AuthenticatableResolver, denyList []v1alpha1.EventPolicySpecDeny, trackingPolicy kmeta.Accessor) ([]v1alpha1.EventPolicyStatusDeny, error) {
+	if len(denyList) == 0 {
+		return nil, nil
+	}
+
+	denied := make([]v1alpha1.EventPolicyStatusDeny, 0, len(denyList))
+	for _, deny := range denyList {
+		if len(deny.From) == 0 {
+			denied = append(denied, v1alpha1.EventPolicyStatusDeny{From: []string{"*"}})
+			continue
+		}
+
+		sas, err := resolveSubjectsFromList(resolver, deny.From, trackingPolicy)
+		if err != nil {
+			return nil, err
+		}
+		denied = append(denied, v1alpha1.EventPolicyStatusDeny{From: sas})
+	}
+
+	return denied, nil
+}
+
+func resolveSubjectsFromList(resolver *resolver.AuthenticatableResolver, fromList []v1alpha1.EventPolicySpecFrom, trackingPolicy kmeta.Accessor) ([]string, error) {
 	allSAs := []string{}
-	for _, from := range eventPolicy.Spec.From {
+	for _, from := range fromList {
 		if from.Ref != nil {
-			sas, err := resolveSubjectsFromReference(resolver, *from.Ref, eventPolicy)
+			sas, err := resolveSubjectsFromReference(resolver, *from.Ref, trackingPolicy)
 			if err != nil {
 				return nil, fmt.Errorf("could not resolve subjects from reference: %w", err)
 			}
 			allSAs = append(allSAs, sas...)
 		} else if from.Sub != nil {
 			allSAs = append(allSAs, *from.Sub)
+		} else if from.SpiffeID != nil {
+			allSAs = append(allSAs, *from.SpiffeID)
 		}
 	}
 
 	return allSAs, nil
 }
 
-func resolveSubjectsFromReference(resolver *resolver.AuthenticatableResolver, reference v1alpha1.EventPolicyFromReference, trackingEventPolicy *v1alpha1.EventPolicy) ([]string, error) {
+func resolveSubjectsFromReference(resolver *resolver.AuthenticatableResolver, reference v1alpha1.EventPolicyFromReference, trackingPolicy kmeta.Accessor) ([]string, error) {
 	authStatus, err := resolver.AuthStatusFromObjectReference(&corev1.ObjectReference{
 		APIVersion: reference.APIVersion,
 		Kind:       reference.Kind,
 		Namespace:  reference.Namespace,
 		Name:       reference.Name,
-	}, trackingEventPolicy)
+	}, trackingPolicy)
 
 	if err != nil {
 		return nil, fmt.Errorf("could not resolve auth status: %w", err)
@@ -218,23 +423,52 @@ func resolveSubjectsFromReference(resolver *resolver.AuthenticatableResolver, re
 
 // SubjectAndFiltersPass checks if the given sub is contained in the list of allowedSubs
 // or if it matches a prefix pattern in subs (e.g. system:serviceaccounts:my-ns:*), as
-// well as if the event passes any filters associated with the subjects for an event policy
+// well as if the event passes any filters associated with the subjects for an event policy.
+// Deny rules take precedence: if the sub and event match any deny rule, the check fails
+// regardless of the allowed subjects.
 func SubjectAndFiltersPass(ctx context.Context, sub string, allowedSubsWithFilters []SubjectsWithFilters, event *cloudevents.Event, logger *zap.SugaredLogger) bool {
 	if event == nil {
 		return false
 	}
 
+	if SubjectAndFiltersDenied(ctx, sub, allowedSubsWithFilters, event, logger) {
+		return false
+	}
+
 	for _, swf := range allowedSubsWithFilters {
-		for _, s := range swf.Subjects {
-			if strings.EqualFold(s, sub) || (strings.HasSuffix(s, "*") && strings.HasPrefix(sub, strings.TrimSuffix(s, "*"))) {
-				return subscriptionsapi.CreateSubscriptionsAPIFilters(logger.Desugar(), swf.Filters).Filter(ctx, *event) != eventfilter.FailFilter
-			}
+		if swf.Deny {
+			continue
+		}
+		if rule := evaluateRule(ctx, sub, swf, event, logger); rule.SubjectMatched {
+			return rule.Matched()
 		}
 	}
 
 	return false
 }
 
+// SubjectAndFiltersDenied checks if the given sub and event match any of the deny rules
+// in subsWithFilters. A deny rule matches if the sub matches one of its subjects and the
+// event passes all of its filters.
+func SubjectAndFiltersDenied(ctx context.Context, sub string, subsWithFilters []SubjectsWithFilters, event *cloudevents.Event, logger *zap.SugaredLogger) bool {
+	for _, swf := range subsWithFilters {
+		if !swf.Deny {
+			continue
+		}
+		if rule := evaluateRule(ctx, sub, swf, event, logger); rule.Matched() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SubjectMatches checks if the given sub is equal to allowedSub or matches it
+// when allowedSub is a prefix pattern (e.g. system:serviceaccounts:my-ns:*).
+func SubjectMatches(allowedSub, sub string) bool {
+	return strings.EqualFold(allowedSub, sub) || (strings.HasSuffix(allowedSub, "*") && strings.HasPrefix(sub, strings.TrimSuffix(allowedSub, "*")))
+}
+
 func handleApplyingResourcesOfEventPolicy(eventPolicy *v1alpha1.EventPolicy, gk schema.GroupKind, indexer cache.Indexer, handlerFn func(key types.NamespacedName) error) error {
 	applyingResources, err := GetApplyingResourcesOfEventPolicyForGK(eventPolicy, gk, indexer)
 	if err != nil {
@@ -309,6 +543,119 @@ func EventPolicyEventHandler(indexer cache.Indexer, gk schema.GroupKind, enqueue
 	}
 }
 
+// ClusterEventPolicyEventHandler returns an ResourceEventHandler, which passes the referencing resources of the
+// ClusterEventPolicy to the enqueueFn if the ClusterEventPolicy was referencing or got updated and now is
+// referencing the resource of the given GVK.
+func ClusterEventPolicyEventHandler(indexer cache.Indexer, gk schema.GroupKind, namespaceLister corev1listers.NamespaceLister, enqueueFn func(key types.NamespacedName)) cache.ResourceEventHandler {
+	enqueueApplyingResources := func(clusterEventPolicy *v1alpha1.ClusterEventPolicy, handlerFn func(key types.NamespacedName)) {
+		applyingResources, err := GetApplyingResourcesOfClusterEventPolicyForGK(clusterEventPolicy, gk, indexer, namespaceLister)
+		if err != nil {
+			return
+		}
+		for _, key := range applyingResources {
+			handlerFn(key)
+		}
+	}
+
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			clusterEventPolicy, ok := obj.(*v1alpha1.ClusterEventPolicy)
+			if !ok {
+				return
+			}
+
+			enqueueApplyingResources(clusterEventPolicy, enqueueFn)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			// Here we need to check if the old or the new ClusterEventPolicy was referencing the given GVK
+			oldClusterEventPolicy, ok := oldObj.(*v1alpha1.ClusterEventPolicy)
+			if !ok {
+				return
+			}
+			newClusterEventPolicy, ok := newObj.(*v1alpha1.ClusterEventPolicy)
+			if !ok {
+				return
+			}
+
+			// make sure, we handle the keys only once
+			toHandle := map[types.NamespacedName]struct{}{}
+			addToHandleList := func(key types.NamespacedName) {
+				toHandle[key] = struct{}{}
+			}
+
+			enqueueApplyingResources(oldClusterEventPolicy, addToHandleList)
+			enqueueApplyingResources(newClusterEventPolicy, addToHandleList)
+
+			for k := range toHandle {
+				enqueueFn(k)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			clusterEventPolicy, ok := obj.(*v1alpha1.ClusterEventPolicy)
+			if !ok {
+				return
+			}
+
+			enqueueApplyingResources(clusterEventPolicy, enqueueFn)
+		},
+	}
+}
+
+// ClusterEventPolicyNamespaceEventHandler returns an ResourceEventHandler, which passes the resources of the given GK
+// in a namespace to the enqueueFn, if the labels of the namespace got updated and a ClusterEventPolicy applying to the
+// resources now starts or stops selecting the namespace.
+func ClusterEventPolicyNamespaceEventHandler(clusterEventPolicyLister listerseventingv1alpha1.ClusterEventPolicyLister, indexer cache.Indexer, gk schema.GroupKind, enqueueFn func(key types.NamespacedName)) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldNamespace, ok := oldObj.(*corev1.Namespace)
+			if !ok {
+				return
+			}
+			newNamespace, ok := newObj.(*corev1.Namespace)
+			if !ok {
+				return
+			}
+			if labels.Equals(oldNamespace.Labels, newNamespace.Labels) {
+				return
+			}
+
+			policies, err := clusterEventPolicyLister.List(labels.Everything())
+			if err != nil {
+				return
+			}
+
+			// make sure, we handle the keys only once
+			toHandle := map[types.NamespacedName]struct{}{}
+			for _, policy := range policies {
+				namespaceSelector, err := namespaceSelectorOf(policy)
+				if err != nil {
+					continue
+				}
+				if namespaceSelector.Matches(labels.Set(oldNamespace.Labels)) == namespaceSelector.Matches(labels.Set(newNamespace.Labels)) {
+					continue
+				}
+
+				selectors, err := resourceSelectorsOfClusterEventPolicyForGK(policy, gk)
+				if err != nil {
+					continue
+				}
+				for _, selector := range selectors {
+					_ = cache.ListAllByNamespace(indexer, newNamespace.Name, selector, func(i interface{}) {
+						toHandle[types.NamespacedName{
+							Namespace: newNamespace.Name,
+							Name:      i.(metav1.Object).GetName(),
+						}] = struct{}{}
+					})
+				}
+			}
+
+			for k := range toHandle {
+				enqueueFn(k)
+			}
+		},
+	}
+}
+
 type EventPolicyStatusMarker interface {
 	MarkEventPoliciesFailed(reason, messageFormat string, messageA ...interface{})
 	MarkEventPoliciesUnknown(reason, messageFormat string, messageA ...interface{})
@@ -316,10 +663,10 @@ type EventPolicyStatusMarker interface {
 	MarkEventPoliciesTrueWithReason(reason, messageFormat string, messageA ...interface{})
 }
 
-func UpdateStatusWithProvidedEventPolicies(featureFlags feature.Flags, status *eventingduckv1.AppliedEventPoliciesStatus, statusMarker EventPolicyStatusMarker, applyingEventPolicies []*v1alpha1.EventPolicy) error {
+func UpdateStatusWithProvidedEventPolicies(featureFlags feature.Flags, status *eventingduckv1.AppliedEventPoliciesStatus, statusMarker EventPolicyStatusMarker, applyingEventPolicies []*v1alpha1.EventPolicy, applyingClusterEventPolicies []*v1alpha1.ClusterEventPolicy) error {
 	status.Policies = nil
 
-	if len(applyingEventPolicies) > 0 {
+	if len(applyingEventPolicies) > 0 || len(applyingClusterEventPolicies) > 0 {
 		unreadyEventPolicies := []string{}
 		for _, policy := range applyingEventPolicies {
 			if !policy.Status.IsReady() {
@@ -332,6 +679,17 @@ func UpdateStatusWithProvidedEventPolicies(featureFlags feature.Flags, status *e
 				})
 			}
 		}
+		for _, policy := range applyingClusterEventPolicies {
+			if !policy.Status.IsReady() {
+				unreadyEventPolicies = append(unreadyEventPolicies, v1alpha1.ClusterEventPolicyKind+"/"+policy.Name)
+			} else {
+				status.Policies = append(status.Policies, eventingduckv1.AppliedEventPolicyRef{
+					Name:       policy.Name,
+					APIVersion: v1alpha1.SchemeGroupVersion.String(),
+					Kind:       v1alpha1.ClusterEventPolicyKind,
+				})
+			}
+		}
 
 		if len(unreadyEventPolicies) == 0 {
 			statusMarker.MarkEventPoliciesTrue()
@@ -352,12 +710,19 @@ func UpdateStatusWithProvidedEventPolicies(featureFlags feature.Flags, status *e
 	return nil
 }
 
-func UpdateStatusWithEventPolicies(featureFlags feature.Flags, status *eventingduckv1.AppliedEventPoliciesStatus, statusMarker EventPolicyStatusMarker, eventPolicyLister listerseventingv1alpha1.EventPolicyLister, gvk schema.GroupVersionKind, objectMeta metav1.ObjectMeta) error {
+// UpdateStatusWithEventPolicies updates the status with the applying EventPolicies and ClusterEventPolicies of the given resource.
+func UpdateStatusWithEventPolicies(featureFlags feature.Flags, status *eventingduckv1.AppliedEventPoliciesStatus, statusMarker EventPolicyStatusMarker, eventPolicyLister listerseventingv1alpha1.EventPolicyLister, clusterEventPolicyLister listerseventingv1alpha1.ClusterEventPolicyLister, namespaceLister corev1listers.NamespaceLister, gvk schema.GroupVersionKind, objectMeta metav1.ObjectMeta) error {
 	applyingEvenPolicies, err := GetEventPoliciesForResource(eventPolicyLister, gvk, objectMeta)
 	if err != nil {
 		statusMarker.MarkEventPoliciesFailed("EventPoliciesGetFailed", "Failed to get applying event policies")
 		return fmt.Errorf("unable to get applying event policies: %w", err)
 	}
 
-	return UpdateStatusWithProvidedEventPolicies(featureFlags, status, statusMarker, applyingEvenPolicies)
+	applyingClusterEventPolicies, err := GetClusterEventPoliciesForResource(clusterEventPolicyLister, namespaceLister, gvk, objectMeta)
+	if err != nil {
+		statusMarker.MarkEventPoliciesFailed("EventPoliciesGetFailed", "Failed to get applying cluster event policies")
+		return fmt.Errorf("unable to get applying cluster event policies: %w", err)
+	}
+
+	return UpdateStatusWithProvidedEventPolicies(featureFlags, status, statusMarker, applyingEvenPolicies, applyingClusterEventPolicies)
 }
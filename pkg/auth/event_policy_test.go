@@ -29,6 +29,8 @@ import (
 	cetest "github.com/cloudevents/sdk-go/v2/test"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -38,9 +40,11 @@ import (
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 	"knative.dev/eventing/pkg/client/clientset/versioned/scheme"
 	brokerinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1/broker/fake"
+	clustereventpolicyinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy/fake"
 	eventpolicyinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy/fake"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/client/injection/ducks/duck/v1/authstatus"
+	namespaceinformerfake "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace/fake"
 	fakedynamicclient "knative.dev/pkg/injection/clients/dynamicclient/fake"
 	reconcilertesting "knative.dev/pkg/reconciler/testing"
 	"knative.dev/pkg/resolver"
@@ -523,6 +527,111 @@ func TestGetEventPoliciesForResource(t *testing.T) {
 	}
 }
 
+func TestGetClusterEventPoliciesForResource(t *testing.T) {
+	namespaces := []*corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"team": "b"}}},
+	}
+	brokerSelector := func(matchLabels map[string]string) []v1alpha1.EventPolicySpecTo {
+		return []v1alpha1.EventPolicySpecTo{{
+			Selector: &v1alpha1.EventPolicySelector{
+				TypeMeta: &metav1.TypeMeta{
+					APIVersion: eventingv1.SchemeGroupVersion.String(),
+					Kind:       "Broker",
+				},
+				LabelSelector: &metav1.LabelSelector{MatchLabels: matchLabels},
+			},
+		}}
+	}
+
+	tests := []struct {
+		name               string
+		resourceObjectMeta metav1.ObjectMeta
+		existingPolicies   []v1alpha1.ClusterEventPolicy
+		want               []string
+		wantErr            bool
+	}{
+		{
+			name:               "No policies",
+			resourceObjectMeta: metav1.ObjectMeta{Name: "my-broker", Namespace: "unknown-namespace"},
+			want:               []string{},
+		}, {
+			name:               "Match all namespaces (empty namespace selector)",
+			resourceObjectMeta: metav1.ObjectMeta{Name: "my-broker", Namespace: "team-a"},
+			existingPolicies: []v1alpha1.ClusterEventPolicy{
+				{ObjectMeta: metav1.ObjectMeta{Name: "my-policy"}},
+			},
+			want: []string{"my-policy"},
+		}, {
+			name:               "Match by namespace and resource selector",
+			resourceObjectMeta: metav1.ObjectMeta{Name: "my-broker", Namespace: "team-a", Labels: map[string]string{"key": "value"}},
+			existingPolicies: []v1alpha1.ClusterEventPolicy{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-policy-1"},
+					Spec: v1alpha1.ClusterEventPolicySpec{
+						NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+						EventPolicySpec:   v1alpha1.EventPolicySpec{To: brokerSelector(map[string]string{"key": "value"})},
+					},
+				}, {
+					ObjectMeta: metav1.ObjectMeta{Name: "my-policy-2"},
+					Spec: v1alpha1.ClusterEventPolicySpec{
+						NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}},
+					},
+				}, {
+					ObjectMeta: metav1.ObjectMeta{Name: "my-policy-3"},
+					Spec: v1alpha1.ClusterEventPolicySpec{
+						EventPolicySpec: v1alpha1.EventPolicySpec{To: brokerSelector(map[string]string{"key": "other-value"})},
+					},
+				},
+			},
+			want: []string{"my-policy-1"},
+		}, {
+			name:               "Unknown namespace",
+			resourceObjectMeta: metav1.ObjectMeta{Name: "my-broker", Namespace: "unknown-namespace"},
+			existingPolicies: []v1alpha1.ClusterEventPolicy{
+				{ObjectMeta: metav1.ObjectMeta{Name: "my-policy"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := reconcilertesting.SetupFakeContext(t)
+
+			for i := range tt.existingPolicies {
+				err := clustereventpolicyinformerfake.Get(ctx).Informer().GetStore().Add(&tt.existingPolicies[i])
+				if err != nil {
+					t.Fatalf("error adding policies: %v", err)
+				}
+			}
+			for _, ns := range namespaces {
+				err := namespaceinformerfake.Get(ctx).Informer().GetStore().Add(ns)
+				if err != nil {
+					t.Fatalf("error adding namespaces: %v", err)
+				}
+			}
+
+			brokerGVK := eventingv1.SchemeGroupVersion.WithKind("Broker")
+			got, err := GetClusterEventPoliciesForResource(clustereventpolicyinformerfake.Get(ctx).Lister(), namespaceinformerfake.Get(ctx).Lister(), brokerGVK, tt.resourceObjectMeta)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetClusterEventPoliciesForResource() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			gotNames := make([]string, 0, len(got))
+			for _, p := range got {
+				gotNames = append(gotNames, p.Name)
+			}
+			if diff := cmp.Diff(tt.want, gotNames); diff != "" {
+				t.Errorf("GetClusterEventPoliciesForResource() (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
+
 func TestResolveSubjects(t *testing.T) {
 	namespace := "my-ns"
 
@@ -576,6 +685,19 @@ func TestResolveSubjects(t *testing.T) {
 				"system:serviceaccount:my-ns:my-app",
 				"system:serviceaccount:my-ns:my-app-2",
 			},
+		}, {
+			name: "spiffe ids",
+			froms: []v1alpha1.EventPolicySpecFrom{
+				{
+					SpiffeID: ptr.To("spiffe://cluster.local/ns/my-ns/sa/my-app"),
+				}, {
+					Sub: ptr.To("system:serviceaccount:my-ns:my-app"),
+				},
+			},
+			want: []string{
+				"spiffe://cluster.local/ns/my-ns/sa/my-app",
+				"system:serviceaccount:my-ns:my-app",
+			},
 		}, {
 			name: "multiple references",
 			froms: []v1alpha1.EventPolicySpecFrom{
@@ -831,6 +953,96 @@ func TestSubjectAndFiltersContained(t *testing.T) {
 				},
 			},
 			want: false,
+		}, {
+			name: "deny takes precedence over allow",
+			sub:  "system:serviceaccounts:my-ns:my-sa",
+			allowedSubsAndFilters: []SubjectsWithFilters{
+				{
+					Subjects: []string{
+						"system:serviceaccounts:my-ns:*",
+					},
+				},
+				{
+					Subjects: []string{
+						"system:serviceaccounts:my-ns:my-sa",
+					},
+					Deny: true,
+				},
+			},
+			want: false,
+		}, {
+			name: "deny of another subject",
+			sub:  "system:serviceaccounts:my-ns:my-sa",
+			allowedSubsAndFilters: []SubjectsWithFilters{
+				{
+					Subjects: []string{
+						"system:serviceaccounts:my-ns:*",
+					},
+				},
+				{
+					Subjects: []string{
+						"system:serviceaccounts:my-ns:another-sa",
+					},
+					Deny: true,
+				},
+			},
+			want: true,
+		}, {
+			name: "deny with matching event filter",
+			sub:  "system:serviceaccounts:my-ns:my-sa",
+			allowedSubsAndFilters: []SubjectsWithFilters{
+				{
+					Subjects: []string{
+						"system:serviceaccounts:my-ns:*",
+					},
+				},
+				{
+					Subjects: []string{
+						"*",
+					},
+					Filters: []eventingv1.SubscriptionsAPIFilter{
+						{
+							CESQL: "true",
+						},
+					},
+					Deny: true,
+				},
+			},
+			want: false,
+		}, {
+			name: "deny with failing event filter",
+			sub:  "system:serviceaccounts:my-ns:my-sa",
+			allowedSubsAndFilters: []SubjectsWithFilters{
+				{
+					Subjects: []string{
+						"system:serviceaccounts:my-ns:*",
+					},
+				},
+				{
+					Subjects: []string{
+						"*",
+					},
+					Filters: []eventingv1.SubscriptionsAPIFilter{
+						{
+							CESQL: "false",
+						},
+					},
+					Deny: true,
+				},
+			},
+			want: true,
+		}, {
+			name: "deny rules only",
+			sub:  "system:serviceaccounts:my-ns:my-sa",
+			allowedSubsAndFilters: []SubjectsWithFilters{
+				{
+					Subjects: []string{
+						"system:serviceaccounts:my-ns:another-sa",
+					},
+					Deny: true,
+				},
+			},
+			want: false,
 		},
 	}
 	for _, tt := range tests {
@@ -1070,6 +1282,180 @@ func TestGetApplyingResourcesOfEventPolicyForGK(t *testing.T) {
 	}
 }
 
+func TestGetApplyingResourcesOfClusterEventPolicyForGK(t *testing.T) {
+	ctx, _ := reconcilertesting.SetupFakeContext(t)
+
+	for _, ns := range []*corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"team": "b"}}},
+	} {
+		if err := namespaceinformerfake.Get(ctx).Informer().GetStore().Add(ns); err != nil {
+			t.Fatalf("could not add namespace: %v", err)
+		}
+	}
+
+	brokerIndexer := brokerinformerfake.Get(ctx).Informer().GetIndexer()
+	for _, b := range []*eventingv1.Broker{
+		{ObjectMeta: metav1.ObjectMeta{Name: "broker-1", Namespace: "team-a", Labels: map[string]string{"key": "value"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "broker-2", Namespace: "team-a"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "broker-3", Namespace: "team-b", Labels: map[string]string{"key": "value"}}},
+	} {
+		if err := brokerIndexer.Add(b); err != nil {
+			t.Fatalf("could not add broker object to indexer: %v", err)
+		}
+	}
+
+	gk := schema.GroupKind{Group: eventingv1.SchemeGroupVersion.Group, Kind: "Broker"}
+
+	tests := []struct {
+		name string
+		spec v1alpha1.ClusterEventPolicySpec
+		want []types.NamespacedName
+	}{
+		{
+			name: "Returns all resources of all namespaces (empty selectors)",
+			want: []types.NamespacedName{
+				{Namespace: "team-a", Name: "broker-1"},
+				{Namespace: "team-a", Name: "broker-2"},
+				{Namespace: "team-b", Name: "broker-3"},
+			},
+		}, {
+			name: "Returns resources of selected namespaces",
+			spec: v1alpha1.ClusterEventPolicySpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			},
+			want: []types.NamespacedName{
+				{Namespace: "team-a", Name: "broker-1"},
+				{Namespace: "team-a", Name: "broker-2"},
+			},
+		}, {
+			name: "Returns resources matching the selector in selected namespaces",
+			spec: v1alpha1.ClusterEventPolicySpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+				EventPolicySpec: v1alpha1.EventPolicySpec{
+					To: []v1alpha1.EventPolicySpecTo{{
+						Selector: &v1alpha1.EventPolicySelector{
+							TypeMeta: &metav1.TypeMeta{
+								APIVersion: eventingv1.SchemeGroupVersion.String(),
+								Kind:       "Broker",
+							},
+							LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"key": "value"}},
+						},
+					}},
+				},
+			},
+			want: []types.NamespacedName{
+				{Namespace: "team-a", Name: "broker-1"},
+			},
+		}, {
+			name: "Ignores selectors of other Group&Kind",
+			spec: v1alpha1.ClusterEventPolicySpec{
+				EventPolicySpec: v1alpha1.EventPolicySpec{
+					To: []v1alpha1.EventPolicySpecTo{{
+						Selector: &v1alpha1.EventPolicySelector{
+							TypeMeta: &metav1.TypeMeta{
+								APIVersion: eventingv1.SchemeGroupVersion.String(),
+								Kind:       "Trigger",
+							},
+							LabelSelector: &metav1.LabelSelector{},
+						},
+					}},
+				},
+			},
+			want: []types.NamespacedName{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusterEventPolicy := &v1alpha1.ClusterEventPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-policy"},
+				Spec:       tt.spec,
+			}
+
+			got, err := GetApplyingResourcesOfClusterEventPolicyForGK(clusterEventPolicy, gk, brokerIndexer, namespaceinformerfake.Get(ctx).Lister())
+			if err != nil {
+				t.Fatalf("GetApplyingResourcesOfClusterEventPolicyForGK() unexpected error = %v", err)
+			}
+
+			sortKeys := cmpopts.SortSlices(func(a, b types.NamespacedName) bool { return a.String() < b.String() })
+			if diff := cmp.Diff(tt.want, got, sortKeys); diff != "" {
+				t.Errorf("GetApplyingResourcesOfClusterEventPolicyForGK() (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
+
+func TestClusterEventPolicyNamespaceEventHandler(t *testing.T) {
+	ctx, _ := reconcilertesting.SetupFakeContext(t)
+
+	clusterEventPolicy := &v1alpha1.ClusterEventPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-policy"},
+		Spec: v1alpha1.ClusterEventPolicySpec{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+		},
+	}
+	if err := clustereventpolicyinformerfake.Get(ctx).Informer().GetStore().Add(clusterEventPolicy); err != nil {
+		t.Fatalf("could not add clustereventpolicy: %v", err)
+	}
+
+	brokerIndexer := brokerinformerfake.Get(ctx).Informer().GetIndexer()
+	for _, b := range []*eventingv1.Broker{
+		{ObjectMeta: metav1.ObjectMeta{Name: "broker-1", Namespace: "my-ns"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "broker-2", Namespace: "other-ns"}},
+	} {
+		if err := brokerIndexer.Add(b); err != nil {
+			t.Fatalf("could not add broker object to indexer: %v", err)
+		}
+	}
+
+	gk := schema.GroupKind{Group: eventingv1.SchemeGroupVersion.Group, Kind: "Broker"}
+
+	namespace := func(labels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "my-ns", Labels: labels}}
+	}
+
+	tests := []struct {
+		name      string
+		oldLabels map[string]string
+		newLabels map[string]string
+		want      []types.NamespacedName
+	}{
+		{
+			name:      "Enqueues the resources, if the policy starts selecting the namespace",
+			oldLabels: map[string]string{"team": "b"},
+			newLabels: map[string]string{"team": "a"},
+			want:      []types.NamespacedName{{Namespace: "my-ns", Name: "broker-1"}},
+		}, {
+			name:      "Enqueues the resources, if the policy stops selecting the namespace",
+			oldLabels: map[string]string{"team": "a"},
+			newLabels: map[string]string{},
+			want:      []types.NamespacedName{{Namespace: "my-ns", Name: "broker-1"}},
+		}, {
+			name:      "Ignores label changes not changing the selection",
+			oldLabels: map[string]string{"team": "a"},
+			newLabels: map[string]string{"team": "a", "key": "value"},
+		}, {
+			name:      "Ignores updates without label changes",
+			oldLabels: map[string]string{"team": "a"},
+			newLabels: map[string]string{"team": "a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []types.NamespacedName
+			handler := ClusterEventPolicyNamespaceEventHandler(clustereventpolicyinformerfake.Get(ctx).Lister(), brokerIndexer, gk, func(key types.NamespacedName) {
+				got = append(got, key)
+			})
+
+			handler.OnUpdate(namespace(tt.oldLabels), namespace(tt.newLabels))
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ClusterEventPolicyNamespaceEventHandler() enqueued (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
+
 func TestEventPolicyEventHandler_AddAndDelete(t *testing.T) {
 	eventPolicy := &v1alpha1.EventPolicy{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1207,3 +1593,24 @@ func TestEventPolicyEventHandler_Update(t *testing.T) {
 		}
 	}
 }
+
+func TestSubjectMatches(t *testing.T) {
+	tests := []struct {
+		allowedSub string
+		sub        string
+		want       bool
+	}{
+		{allowedSub: "system:serviceaccounts:my-ns:my-sa", sub: "system:serviceaccounts:my-ns:my-sa", want: true},
+		{allowedSub: "system:serviceaccounts:my-ns:my-sa", sub: "system:serviceaccounts:my-ns:other-sa", want: false},
+		{allowedSub: "system:serviceaccounts:my-ns:*", sub: "system:serviceaccounts:my-ns:my-sa", want: true},
+		{allowedSub: "system:serviceaccounts:my-ns:*", sub: "system:serviceaccounts:other-ns:my-sa", want: false},
+		{allowedSub: "*", sub: "system:serviceaccounts:my-ns:my-sa", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.allowedSub+"/"+tt.sub, func(t *testing.T) {
+			if got := SubjectMatches(tt.allowedSub, tt.sub); got != tt.want {
+				t.Errorf("SubjectMatches(%q, %q) = %v, want %v", tt.allowedSub, tt.sub, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/configmap"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ExternalIssuersConfigName is the name of the config map holding the trusted external OIDC issuers
+	ExternalIssuersConfigName = "config-external-oidc-issuers"
+
+	// ExternalIssuersConfigKey is the key of the list of trusted external OIDC issuers in the config map
+	ExternalIssuersConfigKey = "issuers"
+
+	// defaultSubjectClaim is the claim used as subject of tokens from external issuers, if none is configured
+	defaultSubjectClaim = "sub"
+
+	// externalSubjectSeparator separates the issuer and the subject in subjects of external issuers
+	externalSubjectSeparator = "#"
+)
+
+// ExternalIssuer is an OIDC issuer outside the cluster, whose tokens are trusted
+type ExternalIssuer struct {
+	// Issuer is the issuer URL, which must match the iss claim of the tokens
+	Issuer string `json:"issuer"`
+
+	// JWKSURL is the URL of the JSON Web Key Set holding the keys the tokens are signed with
+	JWKSURL string `json:"jwksURL"`
+
+	// Audiences restrict the tokens from the issuer to the ones issued for one of the audiences.
+	// They are required in addition to the audience of the target resource.
+	Audiences []string `json:"audiences,omitempty"`
+
+	// TargetAudiences map the audience of a target resource to the audiences of the issuer
+	// which are accepted in its place, for issuers which can't issue tokens for the
+	// audiences of the target resources.
+	TargetAudiences map[string][]string `json:"targetAudiences,omitempty"`
+
+	// SubjectClaim is the claim of the tokens holding the identity of the sender.
+	// Defaults to sub.
+	SubjectClaim string `json:"subjectClaim,omitempty"`
+}
+
+// ExternalSubject returns the subject of a token from an external issuer, as it is
+// matched against the subjects of event policies, e.g. https://idp.example.com#my-client
+func ExternalSubject(issuer, sub string) string {
+	return issuer + externalSubjectSeparator + sub
+}
+
+// NewExternalIssuersFromConfigMap parses the trusted external OIDC issuers from the supplied config map
+func NewExternalIssuersFromConfigMap(config *corev1.ConfigMap) ([]ExternalIssuer, error) {
+	value, present := config.Data[ExternalIssuersConfigKey]
+	if !present || value == "" {
+		return nil, nil
+	}
+
+	var issuers []ExternalIssuer
+	if err := yaml.Unmarshal([]byte(value), &issuers); err != nil {
+		return nil, fmt.Errorf("failed to parse %q: %w", ExternalIssuersConfigKey, err)
+	}
+
+	seen := make(map[string]bool, len(issuers))
+	for i, issuer := range issuers {
+		if err := issuer.validate(); err != nil {
+			return nil, fmt.Errorf("invalid issuer at index %d: %w", i, err)
+		}
+		if seen[issuer.Issuer] {
+			return nil, fmt.Errorf("issuer %q is configured multiple times", issuer.Issuer)
+		}
+		seen[issuer.Issuer] = true
+	}
+
+	return issuers, nil
+}
+
+func (i *ExternalIssuer) validate() error {
+	if i.Issuer == "" {
+		return fmt.Errorf("issuer is required")
+	}
+	if strings.Contains(i.Issuer, externalSubjectSeparator) {
+		return fmt.Errorf("issuer %q must not contain %q", i.Issuer, externalSubjectSeparator)
+	}
+	if i.JWKSURL == "" {
+		return fmt.Errorf("jwksURL is required")
+	}
+	if u, err := apis.ParseURL(i.JWKSURL); err != nil || !u.URL().IsAbs() {
+		return fmt.Errorf("jwksURL %q must be an absolute URL", i.JWKSURL)
+	}
+	for target, audiences := range i.TargetAudiences {
+		if target == "" || len(audiences) == 0 || slices.Contains(audiences, "") {
+			return fmt.Errorf("targetAudiences must map non-empty target audiences to non-empty audiences")
+		}
+	}
+	return nil
+}
+
+// externalIssuerVerifier verifies tokens of an external issuer
+type externalIssuerVerifier struct {
+	config   ExternalIssuer
+	verifier *oidc.IDTokenVerifier
+}
+
+func newExternalIssuerVerifier(ctx context.Context, config ExternalIssuer) *externalIssuerVerifier {
+	return &externalIssuerVerifier{
+		config: config,
+		verifier: oidc.NewVerifier(config.Issuer, oidc.NewRemoteKeySet(ctx, config.JWKSURL), &oidc.Config{
+			// the audience is checked in verify, as the issuer can have multiple accepted audiences
+			SkipClientIDCheck: true,
+		}),
+	}
+}
+
+// verify verifies the token for the audience of the target, or for any target when the audience
+// is nil, and returns it with the mapped subject claim as subject
+func (e *externalIssuerVerifier) verify(ctx context.Context, jwt string, audience *string) (*IDToken, error) {
+	token, err := e.verifier.Verify(ctx, jwt)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify JWT of external issuer %q: %w", e.config.Issuer, err)
+	}
+
+	// the token must be for the target, so that it can't be replayed to other targets
+	if audience != nil {
+		targetAudiences := append([]string{*audience}, e.config.TargetAudiences[*audience]...)
+		if !hasAudience(token.Audience, targetAudiences) {
+			return nil, fmt.Errorf("JWT of external issuer %q is for audience %q, but only %q are accepted for the target", e.config.Issuer, token.Audience, targetAudiences)
+		}
+	}
+	if len(e.config.Audiences) > 0 && !hasAudience(token.Audience, e.config.Audiences) {
+		return nil, fmt.Errorf("JWT of external issuer %q is for audience %q, but only %q are accepted", e.config.Issuer, token.Audience, e.config.Audiences)
+	}
+
+	subjectClaim := e.config.SubjectClaim
+	if subjectClaim == "" {
+		subjectClaim = defaultSubjectClaim
+	}
+
+	claims := map[string]interface{}{}
+	if err := token.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("could not get claims of JWT: %w", err)
+	}
+	sub, ok := claims[subjectClaim].(string)
+	if !ok || sub == "" {
+		return nil, fmt.Errorf("JWT of external issuer %q has no string claim %q", e.config.Issuer, subjectClaim)
+	}
+
+	return &IDToken{
+		Issuer:          token.Issuer,
+		Audience:        token.Audience,
+		Subject:         ExternalSubject(token.Issuer, sub),
+		Expiry:          token.Expiry,
+		IssuedAt:        token.IssuedAt,
+		AccessTokenHash: token.AccessTokenHash,
+	}, nil
+}
+
+// hasAudience returns true if one of the audiences of a token is accepted
+func hasAudience(tokenAudiences, accepted []string) bool {
+	return slices.ContainsFunc(tokenAudiences, func(aud string) bool { return slices.Contains(accepted, aud) })
+}
+
+// watchExternalIssuers observes the external issuers config map. Only watchers supporting
+// defaults are used, as the config map is optional.
+func (v *Verifier) watchExternalIssuers(ctx context.Context, cmw configmap.Watcher) {
+	dcmw, ok := cmw.(configmap.DefaultingWatcher)
+	if !ok {
+		return
+	}
+
+	dcmw.WatchWithDefault(corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: ExternalIssuersConfigName},
+		Data:       map[string]string{},
+	}, func(cm *corev1.ConfigMap) {
+		if err := v.updateExternalIssuers(ctx, cm); err != nil {
+			v.logger.Errorw("Failed to update external OIDC issuers, keeping the previous issuers", zap.Error(err))
+		}
+	})
+}
+
+func (v *Verifier) updateExternalIssuers(ctx context.Context, cm *corev1.ConfigMap) error {
+	issuers, err := NewExternalIssuersFromConfigMap(cm)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := v.getTLSHTTPClient()
+	if err != nil {
+		return fmt.Errorf("could not get HTTP client: %w", err)
+	}
+	ctx = oidc.ClientContext(ctx, httpClient)
+
+	verifiers := make(map[string]*externalIssuerVerifier, len(issuers))
+	for _, issuer := range issuers {
+		verifiers[issuer.Issuer] = newExternalIssuerVerifier(ctx, issuer)
+	}
+
+	v.m.Lock()
+	defer v.m.Unlock()
+	v.externalIssuers = verifiers
+
+	return nil
+}
+
+// externalIssuerVerifierFor returns the verifier of the external issuer of the JWT, or
+// nil if it is not from a trusted external issuer
+func (v *Verifier) externalIssuerVerifierFor(jwt string) *externalIssuerVerifier {
+	v.m.RLock()
+	defer v.m.RUnlock()
+
+	if len(v.externalIssuers) == 0 {
+		return nil
+	}
+
+	issuer, err := unverifiedIssuer(jwt)
+	if err != nil {
+		return nil
+	}
+	return v.externalIssuers[issuer]
+}
+
+// unverifiedIssuer returns the iss claim of the JWT without verifying it
+func unverifiedIssuer(jwt string) (string, error) {
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("malformed JWT, expected 3 parts got %d", len(parts))
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("malformed JWT payload: %w", err)
+	}
+
+	claims := struct {
+		Issuer string `json:"iss"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", fmt.Errorf("failed to unmarshal JWT claims: %w", err)
+	}
+	return claims.Issuer, nil
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestNewExternalIssuersFromConfigMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    []ExternalIssuer
+		wantErr bool
+	}{
+		{
+			name: "no issuers",
+			data: map[string]string{},
+		}, {
+			name: "issuers",
+			data: map[string]string{
+				ExternalIssuersConfigKey: `
+- issuer: https://idp.example.com
+  jwksURL: https://idp.example.com/jwks
+  audiences:
+  - knative-eventing
+  targetAudiences:
+    eventing.knative.dev/broker/my-ns/my-broker:
+    - my-broker
+  subjectClaim: client_id
+- issuer: https://other.example.com
+  jwksURL: https://other.example.com/jwks
+`,
+			},
+			want: []ExternalIssuer{
+				{
+					Issuer:          "https://idp.example.com",
+					JWKSURL:         "https://idp.example.com/jwks",
+					Audiences:       []string{"knative-eventing"},
+					TargetAudiences: map[string][]string{"eventing.knative.dev/broker/my-ns/my-broker": {"my-broker"}},
+					SubjectClaim:    "client_id",
+				}, {
+					Issuer:  "https://other.example.com",
+					JWKSURL: "https://other.example.com/jwks",
+				},
+			},
+		}, {
+			name: "target audience without audiences",
+			data: map[string]string{
+				ExternalIssuersConfigKey: "- issuer: https://idp.example.com\n  jwksURL: https://idp.example.com/jwks\n  targetAudiences:\n    my-target: []\n",
+			},
+			wantErr: true,
+		}, {
+			name: "missing jwks url",
+			data: map[string]string{
+				ExternalIssuersConfigKey: "- issuer: https://idp.example.com\n",
+			},
+			wantErr: true,
+		}, {
+			name: "relative jwks url",
+			data: map[string]string{
+				ExternalIssuersConfigKey: "- issuer: https://idp.example.com\n  jwksURL: /jwks\n",
+			},
+			wantErr: true,
+		}, {
+			name: "duplicate issuer",
+			data: map[string]string{
+				ExternalIssuersConfigKey: `
+- issuer: https://idp.example.com
+  jwksURL: https://idp.example.com/jwks
+- issuer: https://idp.example.com
+  jwksURL: https://idp.example.com/other-jwks
+`,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewExternalIssuersFromConfigMap(&corev1.ConfigMap{Data: tt.data})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewExternalIssuersFromConfigMap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("NewExternalIssuersFromConfigMap() (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
+
+func TestVerifyJWTOfExternalIssuer(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// local JWKS stub of the external issuer
+	jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{
+			Keys: []jose.JSONWebKey{{Key: &key.PublicKey, KeyID: "key-1", Algorithm: string(jose.RS256), Use: "sig"}},
+		})
+	}))
+	defer jwks.Close()
+
+	const issuer = "https://idp.example.com"
+
+	v := &Verifier{logger: zap.NewNop().Sugar()}
+	err = v.updateExternalIssuers(context.Background(), &corev1.ConfigMap{
+		Data: map[string]string{
+			ExternalIssuersConfigKey: `
+- issuer: https://idp.example.com
+  jwksURL: ` + jwks.URL + `
+  audiences:
+  - knative-eventing
+  targetAudiences:
+    eventing.knative.dev/broker/my-ns/mapped-broker:
+    - mapped-broker
+  subjectClaim: client_id
+- issuer: https://target-audience.example.com
+  jwksURL: ` + jwks.URL + `
+`,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to update external issuers: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		key         *rsa.PrivateKey
+		claims      map[string]interface{}
+		audience    *string
+		wantSubject string
+		wantErr     bool
+	}{
+		{
+			name:        "valid token with mapped subject claim",
+			key:         key,
+			claims:      map[string]interface{}{"iss": issuer, "aud": []string{"knative-eventing", "eventing.knative.dev/broker/my-ns/my-broker"}, "sub": "ignored", "client_id": "my-client"},
+			audience:    ptr.To("eventing.knative.dev/broker/my-ns/my-broker"),
+			wantSubject: "https://idp.example.com#my-client",
+		}, {
+			name:        "valid token for the mapped audience of the target",
+			key:         key,
+			claims:      map[string]interface{}{"iss": issuer, "aud": []string{"knative-eventing", "mapped-broker"}, "client_id": "my-client"},
+			audience:    ptr.To("eventing.knative.dev/broker/my-ns/mapped-broker"),
+			wantSubject: "https://idp.example.com#my-client",
+		}, {
+			name:     "token for the configured audiences only",
+			key:      key,
+			claims:   map[string]interface{}{"iss": issuer, "aud": "knative-eventing", "client_id": "my-client"},
+			audience: ptr.To("eventing.knative.dev/broker/my-ns/my-broker"),
+			wantErr:  true,
+		}, {
+			name:     "token for the target without the configured audiences",
+			key:      key,
+			claims:   map[string]interface{}{"iss": issuer, "aud": "eventing.knative.dev/broker/my-ns/my-broker", "client_id": "my-client"},
+			audience: ptr.To("eventing.knative.dev/broker/my-ns/my-broker"),
+			wantErr:  true,
+		}, {
+			name:     "token for the mapped audience of another target",
+			key:      key,
+			claims:   map[string]interface{}{"iss": issuer, "aud": []string{"knative-eventing", "mapped-broker"}, "client_id": "my-client"},
+			audience: ptr.To("eventing.knative.dev/broker/my-ns/my-broker"),
+			wantErr:  true,
+		}, {
+			name:        "valid token for the audience of the target",
+			key:         key,
+			claims:      map[string]interface{}{"iss": "https://target-audience.example.com", "aud": "eventing.knative.dev/broker/my-ns/my-broker", "sub": "my-client"},
+			audience:    ptr.To("eventing.knative.dev/broker/my-ns/my-broker"),
+			wantSubject: "https://target-audience.example.com#my-client",
+		}, {
+			name:     "not accepted audience",
+			key:      key,
+			claims:   map[string]interface{}{"iss": issuer, "aud": "other", "client_id": "my-client"},
+			audience: ptr.To("eventing.knative.dev/broker/my-ns/my-broker"),
+			wantErr:  true,
+		}, {
+			name:     "missing subject claim",
+			key:      key,
+			claims:   map[string]interface{}{"iss": issuer, "aud": []string{"knative-eventing", "eventing.knative.dev/broker/my-ns/my-broker"}, "sub": "my-client"},
+			audience: ptr.To("eventing.knative.dev/broker/my-ns/my-broker"),
+			wantErr:  true,
+		}, {
+			name:     "signed with unknown key",
+			key:      otherKey,
+			claims:   map[string]interface{}{"iss": issuer, "aud": []string{"knative-eventing", "eventing.knative.dev/broker/my-ns/my-broker"}, "client_id": "my-client"},
+			audience: ptr.To("eventing.knative.dev/broker/my-ns/my-broker"),
+			wantErr:  true,
+		}, {
+			name:        "valid token for any target",
+			key:         key,
+			claims:      map[string]interface{}{"iss": issuer, "aud": []string{"knative-eventing", "mapped-broker"}, "client_id": "my-client"},
+			wantSubject: "https://idp.example.com#my-client",
+		}, {
+			name:    "token for any target without the configured audiences",
+			key:     key,
+			claims:  map[string]interface{}{"iss": issuer, "aud": "mapped-broker", "client_id": "my-client"},
+			wantErr: true,
+		}, {
+			name:     "untrusted issuer",
+			key:      key,
+			claims:   map[string]interface{}{"iss": "https://untrusted.example.com", "aud": "knative-eventing", "sub": "my-client"},
+			audience: ptr.To("knative-eventing"),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.claims["exp"] = time.Now().Add(time.Hour).Unix()
+			tt.claims["iat"] = time.Now().Unix()
+
+			signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: tt.key}, (&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "key-1"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			token, err := jwt.Signed(signer).Claims(tt.claims).CompactSerialize()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := v.verifyJWT(context.Background(), token, tt.audience)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("verifyJWT() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.Subject != tt.wantSubject {
+				t.Errorf("verifyJWT() subject = %q, want %q", got.Subject, tt.wantSubject)
+			}
+		})
+	}
+}
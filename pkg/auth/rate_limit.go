@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+)
+
+const (
+	// maxRateLimiters is the number of rate limiters after which idle rate limiters are removed
+	maxRateLimiters = 10000
+
+	// rateLimiterIdleTimeout is the time after which an unused rate limiter is considered idle
+	rateLimiterIdleTimeout = time.Minute
+)
+
+// RateLimitedError is returned when an event is rejected because it exceeds the rate limit of
+// the event policy which allowed it
+type RateLimitedError struct {
+	// Policy is the event policy whose rate limit is exceeded
+	Policy string
+
+	// RetryAfter is the time after which the sender can retry
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("rate limit of event policy %q exceeded, retry after %s", e.Policy, e.RetryAfter)
+}
+
+// SetRetryAfter sets the Retry-After header for the error in whole seconds, rounded up
+func (e *RateLimitedError) SetRetryAfter(header http.Header) {
+	header.Set("Retry-After", strconv.Itoa(int(math.Ceil(e.RetryAfter.Seconds()))))
+}
+
+type rateLimiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// rateLimiters holds the rate limiters of the event policies, per policy or per subject of a policy
+type rateLimiters struct {
+	m        sync.Mutex
+	limiters map[string]*rateLimiterEntry
+	now      func() time.Time
+}
+
+func newRateLimiters() *rateLimiters {
+	return &rateLimiters{
+		limiters: make(map[string]*rateLimiterEntry),
+		now:      time.Now,
+	}
+}
+
+// reserve takes an event from the rate limit of the policy in namespace for the subject.
+// If the rate limit is exceeded, it returns a RateLimitedError.
+func (r *rateLimiters) reserve(namespace, policy, sub string, rateLimit *v1alpha1.EventPolicySpecRateLimit) error {
+	if r == nil || rateLimit == nil {
+		return nil
+	}
+
+	limit := rate.Limit(rateLimit.EventsPerSecond)
+	burst := int(rateLimit.EventsPerSecond)
+	if rateLimit.Burst != nil {
+		burst = int(*rateLimit.Burst)
+	}
+
+	key := namespace + "/" + policy
+	if rateLimit.Per != v1alpha1.EventPolicyRateLimitPerPolicy {
+		key += "/" + sub
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	now := r.now()
+	entry, ok := r.limiters[key]
+	if !ok || entry.limiter.Limit() != limit || entry.limiter.Burst() != burst {
+		// new rate limiter or the rate limit of the policy changed
+		if len(r.limiters) >= maxRateLimiters {
+			r.removeIdle(now)
+		}
+		entry = &rateLimiterEntry{limiter: rate.NewLimiter(limit, burst)}
+		r.limiters[key] = entry
+	}
+	entry.lastSeen = now
+
+	reservation := entry.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return &RateLimitedError{Policy: policy, RetryAfter: time.Second}
+	}
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return &RateLimitedError{Policy: policy, RetryAfter: delay}
+	}
+	return nil
+}
+
+func (r *rateLimiters) removeIdle(now time.Time) {
+	for key, entry := range r.limiters {
+		if now.Sub(entry.lastSeen) > rateLimiterIdleTimeout {
+			delete(r.limiters, key)
+		}
+	}
+}
+
+// rateLimitOf returns the rate limit of the allow rule of the given policy
+func rateLimitOf(policy string, subjectsWithFilters []SubjectsWithFilters) *v1alpha1.EventPolicySpecRateLimit {
+	for _, swf := range subjectsWithFilters {
+		if swf.Policy == policy && !swf.Deny {
+			return swf.RateLimit
+		}
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cetest "github.com/cloudevents/sdk-go/v2/test"
+	"go.uber.org/zap"
+	"k8s.io/utils/ptr"
+
+	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+	"knative.dev/eventing/pkg/apis/feature"
+)
+
+func TestRateLimitersReserve(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		rateLimit *v1alpha1.EventPolicySpecRateLimit
+		subjects  []string
+		wantErrs  []bool
+	}{
+		{
+			name:     "no rate limit",
+			subjects: []string{"sub-1", "sub-1", "sub-1"},
+			wantErrs: []bool{false, false, false},
+		}, {
+			name:      "per subject",
+			rateLimit: &v1alpha1.EventPolicySpecRateLimit{EventsPerSecond: 1, Burst: ptr.To[int32](2), Per: v1alpha1.EventPolicyRateLimitPerSubject},
+			subjects:  []string{"sub-1", "sub-1", "sub-2", "sub-1", "sub-2"},
+			wantErrs:  []bool{false, false, false, true, false},
+		}, {
+			name:      "per policy",
+			rateLimit: &v1alpha1.EventPolicySpecRateLimit{EventsPerSecond: 1, Burst: ptr.To[int32](2), Per: v1alpha1.EventPolicyRateLimitPerPolicy},
+			subjects:  []string{"sub-1", "sub-2", "sub-3"},
+			wantErrs:  []bool{false, false, true},
+		}, {
+			name:      "burst defaults to events per second",
+			rateLimit: &v1alpha1.EventPolicySpecRateLimit{EventsPerSecond: 1},
+			subjects:  []string{"sub-1", "sub-1"},
+			wantErrs:  []bool{false, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRateLimiters()
+			r.now = func() time.Time { return now }
+
+			for i, sub := range tt.subjects {
+				err := r.reserve("my-ns", "policy-1", sub, tt.rateLimit)
+				if (err != nil) != tt.wantErrs[i] {
+					t.Errorf("reserve() #%d for %q error = %v, wantErr %v", i, sub, err, tt.wantErrs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimitersRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	r := newRateLimiters()
+	r.now = func() time.Time { return now }
+	rateLimit := &v1alpha1.EventPolicySpecRateLimit{EventsPerSecond: 2, Burst: ptr.To[int32](1)}
+
+	if err := r.reserve("my-ns", "policy-1", "sub-1", rateLimit); err != nil {
+		t.Fatalf("reserve() unexpected error = %v", err)
+	}
+
+	err := r.reserve("my-ns", "policy-1", "sub-1", rateLimit)
+	var rateLimitedErr *RateLimitedError
+	if !errors.As(err, &rateLimitedErr) {
+		t.Fatalf("reserve() error = %v, want RateLimitedError", err)
+	}
+	if rateLimitedErr.Policy != "policy-1" {
+		t.Errorf("RateLimitedError policy = %q, want %q", rateLimitedErr.Policy, "policy-1")
+	}
+	if rateLimitedErr.RetryAfter != 500*time.Millisecond {
+		t.Errorf("RateLimitedError retry after = %s, want %s", rateLimitedErr.RetryAfter, 500*time.Millisecond)
+	}
+
+	header := http.Header{}
+	rateLimitedErr.SetRetryAfter(header)
+	if got := header.Get("Retry-After"); got != "1" {
+		t.Errorf("Retry-After = %q, want %q", got, "1")
+	}
+
+	// rejected events don't take from the rate limit
+	now = now.Add(500 * time.Millisecond)
+	if err := r.reserve("my-ns", "policy-1", "sub-1", rateLimit); err != nil {
+		t.Errorf("reserve() after retry after unexpected error = %v", err)
+	}
+}
+
+func TestRateLimitersChangedRateLimit(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	r := newRateLimiters()
+	r.now = func() time.Time { return now }
+
+	if err := r.reserve("my-ns", "policy-1", "sub-1", &v1alpha1.EventPolicySpecRateLimit{EventsPerSecond: 1}); err != nil {
+		t.Fatalf("reserve() unexpected error = %v", err)
+	}
+	if err := r.reserve("my-ns", "policy-1", "sub-1", &v1alpha1.EventPolicySpecRateLimit{EventsPerSecond: 1}); err == nil {
+		t.Fatal("reserve() expected rate limit to be exceeded")
+	}
+	if err := r.reserve("my-ns", "policy-1", "sub-1", &v1alpha1.EventPolicySpecRateLimit{EventsPerSecond: 10}); err != nil {
+		t.Errorf("reserve() with changed rate limit unexpected error = %v", err)
+	}
+}
+
+func TestRateLimitersRemoveIdle(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	r := newRateLimiters()
+	r.now = func() time.Time { return now }
+	rateLimit := &v1alpha1.EventPolicySpecRateLimit{EventsPerSecond: 1}
+
+	if err := r.reserve("my-ns", "policy-1", "idle", rateLimit); err != nil {
+		t.Fatalf("reserve() unexpected error = %v", err)
+	}
+	now = now.Add(2 * rateLimiterIdleTimeout)
+	if err := r.reserve("my-ns", "policy-1", "active", rateLimit); err != nil {
+		t.Fatalf("reserve() unexpected error = %v", err)
+	}
+
+	r.removeIdle(now)
+
+	if _, ok := r.limiters["my-ns/policy-1/idle"]; ok {
+		t.Error("idle rate limiter was not removed")
+	}
+	if _, ok := r.limiters["my-ns/policy-1/active"]; !ok {
+		t.Error("active rate limiter was removed")
+	}
+}
+
+func TestAuthorizeRateLimited(t *testing.T) {
+	v := &Verifier{
+		logger:       zap.NewNop().Sugar(),
+		rateLimiters: newRateLimiters(),
+	}
+	subjectsWithFilters := []SubjectsWithFilters{
+		{Policy: "policy-1", Subjects: []string{"*"}, RateLimit: &v1alpha1.EventPolicySpecRateLimit{EventsPerSecond: 1}},
+	}
+	idToken := &IDToken{Subject: "system:serviceaccount:my-ns:my-sa"}
+	getEvent := func() (*cloudevents.Event, error) {
+		event := cetest.MinEvent()
+		return &event, nil
+	}
+
+	status, err := v.authorize(context.Background(), feature.Flags{}, idToken, "my-ns", subjectsWithFilters, getEvent)
+	if err != nil || status != http.StatusOK {
+		t.Fatalf("authorize() = %d, %v, want %d", status, err, http.StatusOK)
+	}
+
+	// Requests without an event are not counted against the rate limit.
+	status, err = v.authorize(context.Background(), feature.Flags{}, idToken, "my-ns", subjectsWithFilters, nil)
+	if err != nil || status != http.StatusOK {
+		t.Fatalf("authorize() without rate limit = %d, %v, want %d", status, err, http.StatusOK)
+	}
+
+	status, err = v.authorize(context.Background(), feature.Flags{}, idToken, "my-ns", subjectsWithFilters, getEvent)
+	var rateLimitedErr *RateLimitedError
+	if status != http.StatusTooManyRequests || !errors.As(err, &rateLimitedErr) {
+		t.Errorf("authorize() = %d, %v, want %d with RateLimitedError", status, err, http.StatusTooManyRequests)
+	}
+}
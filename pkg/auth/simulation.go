@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+
+	duckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+	"knative.dev/eventing/pkg/apis/feature"
+	listerseventingv1alpha1 "knative.dev/eventing/pkg/client/listers/eventing/v1alpha1"
+)
+
+// SimulationPath is the path of the event policy simulator endpoint, which accepts a SimulationRequest and
+// responds with a SimulationResult
+const SimulationPath = "/simulate"
+
+// SimulationRequest describes an event sent by a subject to a target resource, of which the authorization is simulated
+type SimulationRequest struct {
+	// Target is the resource receiving the event
+	Target SimulationTarget `json:"target"`
+
+	// Sub is the OIDC identity sending the event
+	Sub string `json:"sub"`
+
+	// Event is the sample event which is sent
+	Event *cloudevents.Event `json:"event,omitempty"`
+}
+
+// SimulationTarget references the resource receiving the event of a SimulationRequest
+type SimulationTarget struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace"`
+}
+
+// GroupVersionKind returns the GroupVersionKind of the target
+func (t SimulationTarget) GroupVersionKind() schema.GroupVersionKind {
+	return schema.FromAPIVersionAndKind(t.APIVersion, t.Kind)
+}
+
+// SimulationResult is the authorization decision for a SimulationRequest
+type SimulationResult struct {
+	Decision `json:",inline"`
+
+	// Policies are the event policies applying to the target
+	Policies []SimulatedPolicy `json:"policies,omitempty"`
+}
+
+// SimulatedPolicy is an event policy applying to the target of a SimulationRequest
+type SimulatedPolicy struct {
+	Name string `json:"name"`
+
+	// Kind is ClusterEventPolicy for cluster event policies and empty for event policies
+	Kind string `json:"kind,omitempty"`
+
+	// Applied is false if the event policy is not ready, in which case it is not enforced yet
+	Applied bool `json:"applied"`
+}
+
+// Simulate decides if the event of the request would be allowed by the event policies applying
+// to the target with the given metadata. It uses the same evaluation as the Verifier, but
+// resolves the applying event policies directly instead of from the status of the target.
+func Simulate(ctx context.Context, features feature.Flags, eventPolicyLister listerseventingv1alpha1.EventPolicyLister, clusterEventPolicyLister listerseventingv1alpha1.ClusterEventPolicyLister, namespaceLister corev1listers.NamespaceLister, request *SimulationRequest, targetObjectMeta metav1.ObjectMeta, logger *zap.SugaredLogger) (*SimulationResult, error) {
+	if !features.IsOIDCAuthentication() {
+		return &SimulationResult{
+			Decision: Decision{
+				Allowed: true,
+				Reason:  fmt.Sprintf("%s feature is disabled, so all events are allowed", feature.OIDCAuthentication),
+			},
+		}, nil
+	}
+
+	applyingPolicies, err := GetEventPoliciesForResource(eventPolicyLister, request.Target.GroupVersionKind(), targetObjectMeta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get applying event policies: %w", err)
+	}
+
+	applyingClusterPolicies, err := GetClusterEventPoliciesForResource(clusterEventPolicyLister, namespaceLister, request.Target.GroupVersionKind(), targetObjectMeta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get applying cluster event policies: %w", err)
+	}
+
+	result := &SimulationResult{
+		Policies: make([]SimulatedPolicy, 0, len(applyingPolicies)+len(applyingClusterPolicies)),
+	}
+
+	// as on the target status, only ready policies are applied
+	policyRefs := make([]duckv1.AppliedEventPolicyRef, 0, len(applyingPolicies)+len(applyingClusterPolicies))
+	for _, policy := range applyingPolicies {
+		ready := policy.Status.IsReady()
+		result.Policies = append(result.Policies, SimulatedPolicy{
+			Name:    policy.Name,
+			Applied: ready,
+		})
+		if ready {
+			policyRefs = append(policyRefs, duckv1.AppliedEventPolicyRef{
+				Name:       policy.Name,
+				APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			})
+		}
+	}
+	for _, policy := range applyingClusterPolicies {
+		ready := policy.Status.IsReady()
+		result.Policies = append(result.Policies, SimulatedPolicy{
+			Name:    policy.Name,
+			Kind:    v1alpha1.ClusterEventPolicyKind,
+			Applied: ready,
+		})
+		if ready {
+			policyRefs = append(policyRefs, duckv1.AppliedEventPolicyRef{
+				Name:       policy.Name,
+				APIVersion: v1alpha1.SchemeGroupVersion.String(),
+				Kind:       v1alpha1.ClusterEventPolicyKind,
+			})
+		}
+	}
+
+	subjectsWithFilters, err := SubjectWithFiltersFromPolicyRef(eventPolicyLister, clusterEventPolicyLister, targetObjectMeta.Namespace, policyRefs)
+	if err != nil {
+		return nil, fmt.Errorf("could not get subjects with filters from policy: %w", err)
+	}
+
+	result.Decision = *Authorize(ctx, features, request.Sub, targetObjectMeta.Namespace, subjectsWithFilters, request.Event, logger)
+	return result, nil
+}
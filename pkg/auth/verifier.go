@@ -18,17 +18,20 @@ package auth
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
-	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
+	"k8s.io/apimachinery/pkg/types"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"knative.dev/eventing/pkg/eventingtls"
 	"knative.dev/eventing/pkg/utils"
@@ -39,12 +42,14 @@ import (
 	duckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	"knative.dev/eventing/pkg/client/listers/eventing/v1alpha1"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/cloudevents/sdk-go/v2/binding"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
 	"github.com/coreos/go-oidc/v3/oidc"
 	"go.uber.org/zap"
 	"k8s.io/client-go/rest"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	eventingv1alpha1 "knative.dev/eventing/pkg/apis/eventing/v1alpha1"
 	"knative.dev/eventing/pkg/apis/feature"
 	listerseventingv1alpha1 "knative.dev/eventing/pkg/client/listers/eventing/v1alpha1"
 	"knative.dev/pkg/injection"
@@ -55,9 +60,14 @@ type Verifier struct {
 	logger                     *zap.SugaredLogger
 	restConfig                 *rest.Config
 	eventPolicyLister          v1alpha1.EventPolicyLister
+	clusterEventPolicyLister   v1alpha1.ClusterEventPolicyLister
 	trustBundleConfigMapLister corev1listers.ConfigMapNamespaceLister
 	m                          sync.RWMutex
 	provider                   *oidc.Provider
+	externalIssuers            map[string]*externalIssuerVerifier
+	auditor                    *auditor
+	rateLimiters               *rateLimiters
+	trustBundlePool            atomic.Pointer[x509.CertPool]
 }
 
 type IDToken struct {
@@ -69,12 +79,23 @@ type IDToken struct {
 	AccessTokenHash string
 }
 
-func NewVerifier(ctx context.Context, eventPolicyLister listerseventingv1alpha1.EventPolicyLister, trustBundleConfigMapLister corev1listers.ConfigMapNamespaceLister, cmw configmap.Watcher) *Verifier {
+func NewVerifier(ctx context.Context, eventPolicyLister listerseventingv1alpha1.EventPolicyLister, clusterEventPolicyLister listerseventingv1alpha1.ClusterEventPolicyLister, trustBundleConfigMapLister corev1listers.ConfigMapNamespaceLister, cmw configmap.Watcher) *Verifier {
 	tokenHandler := &Verifier{
 		logger:                     logging.FromContext(ctx).With("component", "oidc-token-handler"),
 		restConfig:                 injection.GetConfig(ctx),
 		eventPolicyLister:          eventPolicyLister,
+		clusterEventPolicyLister:   clusterEventPolicyLister,
 		trustBundleConfigMapLister: trustBundleConfigMapLister,
+		rateLimiters:               newRateLimiters(),
+	}
+
+	tokenHandler.auditor = newAuditor(tokenHandler.logger.Named("audit"))
+	tokenHandler.auditor.watchConfig(cmw)
+	go tokenHandler.auditor.run(ctx)
+
+	tokenHandler.watchExternalIssuers(ctx, cmw)
+	if trustBundleConfigMapLister != nil {
+		tokenHandler.watchTrustBundles(ctx)
 	}
 
 	featureStore := feature.NewStore(logging.FromContext(ctx).Named("feature-config-store"), func(name string, value interface{}) {
@@ -93,19 +114,42 @@ func NewVerifier(ctx context.Context, eventPolicyLister listerseventingv1alpha1.
 	return tokenHandler
 }
 
-// VerifyRequest verifies AuthN and AuthZ in the request. On verification errors, it sets the
-// responses HTTP status and returns an error
+// SetAuditSender sets the sender of the audit events of the authorization decisions.
+// Audit events are dropped until it is set.
+func (v *Verifier) SetAuditSender(send AuditSender) {
+	if v == nil || v.auditor == nil {
+		return
+	}
+	v.auditor.send.Store(&send)
+}
+
+// VerifyRequest verifies AuthN and AuthZ in the request sending an event. Allowed requests are
+// checked against the rate limit of the deciding event policy. On verification errors, it sets
+// the responses HTTP status and returns an error
 func (v *Verifier) VerifyRequest(ctx context.Context, features feature.Flags, requiredOIDCAudience *string, resourceNamespace string, policyRefs []duckv1.AppliedEventPolicyRef, req *http.Request, resp http.ResponseWriter) error {
+	return v.verifyRequest(ctx, features, requiredOIDCAudience, resourceNamespace, policyRefs, req, resp, true)
+}
+
+// VerifyReadRequest verifies AuthN and AuthZ in the request like VerifyRequest, for requests
+// which read the state of a resource instead of sending an event to it, like the requests for
+// the status of a JobSink Job. As they have no event, the filters of the event policies don't
+// pass and they are not counted against the rate limits of the event policies. On verification
+// errors, it sets the responses HTTP status and returns an error
+func (v *Verifier) VerifyReadRequest(ctx context.Context, features feature.Flags, requiredOIDCAudience *string, resourceNamespace string, policyRefs []duckv1.AppliedEventPolicyRef, req *http.Request, resp http.ResponseWriter) error {
+	return v.verifyRequest(ctx, features, requiredOIDCAudience, resourceNamespace, policyRefs, req, resp, false)
+}
+
+func (v *Verifier) verifyRequest(ctx context.Context, features feature.Flags, requiredOIDCAudience *string, resourceNamespace string, policyRefs []duckv1.AppliedEventPolicyRef, req *http.Request, resp http.ResponseWriter, withEvent bool) error {
 	if !features.IsOIDCAuthentication() {
 		return nil
 	}
 
-	idToken, err := v.verifyAuthN(ctx, requiredOIDCAudience, req, resp)
+	idToken, err := v.verifyAuthN(ctx, features, requiredOIDCAudience, resourceNamespace, req, resp)
 	if err != nil {
 		return fmt.Errorf("authentication of request could not be verified: %w", err)
 	}
 
-	err = v.verifyAuthZ(ctx, features, idToken, resourceNamespace, policyRefs, req, resp)
+	err = v.verifyAuthZ(ctx, features, idToken, resourceNamespace, policyRefs, req, resp, withEvent)
 	if err != nil {
 		return fmt.Errorf("authorization of request could not be verified: %w", err)
 	}
@@ -113,6 +157,72 @@ func (v *Verifier) VerifyRequest(ctx context.Context, features feature.Flags, re
 	return nil
 }
 
+// VerifyEvent verifies AuthN and AuthZ of an event which was not received
+// over HTTP, like events published over MQTT, and the JWT presented by its
+// sender. On verification errors, it returns the HTTP status describing the
+// failure and an error.
+func (v *Verifier) VerifyEvent(ctx context.Context, features feature.Flags, requiredOIDCAudience *string, resourceNamespace string, policyRefs []duckv1.AppliedEventPolicyRef, token string, event *cloudevents.Event) (int, error) {
+	if !features.IsOIDCAuthentication() {
+		return http.StatusOK, nil
+	}
+
+	idToken, status, err := v.authenticate(ctx, requiredOIDCAudience, token)
+	if err != nil {
+		v.auditAuthenticationFailure(status, requiredOIDCAudience, resourceNamespace, err)
+		return status, fmt.Errorf("authentication of event could not be verified: %w", err)
+	}
+
+	subjectsWithFilters, err := SubjectWithFiltersFromPolicyRef(v.eventPolicyLister, v.clusterEventPolicyLister, resourceNamespace, policyRefs)
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("authorization of event could not be verified: could not get subjects with filters from policy: %w", err)
+	}
+
+	status, err = v.authorize(ctx, features, idToken, resourceNamespace, subjectsWithFilters, func() (*cloudevents.Event, error) {
+		return event, nil
+	})
+	if err != nil {
+		return status, fmt.Errorf("authorization of event could not be verified: %w", err)
+	}
+
+	return http.StatusOK, nil
+}
+
+// VerifySubscriber verifies AuthN and AuthZ of a client subscribing to the
+// events of a resource, like the clients of a StreamSink, and presenting the
+// given JWT. In the AuthZ part it checks if the token is from one of the
+// allowedSubjects, which can be prefix patterns, or, when there are none,
+// applies the default authorization mode. As subscribers receive the events
+// sent to the resource, they are refused when the OIDC authentication feature
+// is disabled. On verification errors, it returns the HTTP status describing
+// the failure and an error.
+func (v *Verifier) VerifySubscriber(ctx context.Context, features feature.Flags, requiredOIDCAudience *string, resourceNamespace string, allowedSubjects []string, token string) (int, error) {
+	if !features.IsOIDCAuthentication() {
+		return http.StatusForbidden, fmt.Errorf("subscribers can't be authenticated, as the %s feature is disabled", feature.OIDCAuthentication)
+	}
+
+	idToken, status, err := v.authenticate(ctx, requiredOIDCAudience, token)
+	if err != nil {
+		v.auditAuthenticationFailure(status, requiredOIDCAudience, resourceNamespace, err)
+		return status, fmt.Errorf("authentication of subscriber could not be verified: %w", err)
+	}
+
+	if len(allowedSubjects) > 0 {
+		for _, s := range allowedSubjects {
+			if SubjectMatches(s, idToken.Subject) {
+				return http.StatusOK, nil
+			}
+		}
+		return http.StatusForbidden, fmt.Errorf("authorization of subscriber could not be verified: token is from subject %q, but only %q are allowed", idToken.Subject, allowedSubjects)
+	}
+
+	status, err = v.authorize(ctx, features, idToken, resourceNamespace, nil, nil)
+	if err != nil {
+		return status, fmt.Errorf("authorization of subscriber could not be verified: %w", err)
+	}
+
+	return http.StatusOK, nil
+}
+
 // VerifyRequestFromSubject verifies AuthN and AuthZ in the request.
 // In the AuthZ part it checks if the request comes from the given allowedSubject.
 // On verification errors, it sets the responses HTTP status and returns an error.
@@ -123,7 +233,8 @@ func (v *Verifier) VerifyRequestFromSubject(ctx context.Context, features featur
 		return nil
 	}
 
-	idToken, err := v.verifyAuthN(ctx, requiredOIDCAudience, req, resp)
+	// the resource of the request is unknown, so authentication failures are audited with the cluster default
+	idToken, err := v.verifyAuthN(ctx, features, requiredOIDCAudience, "", req, resp)
 	if err != nil {
 		return fmt.Errorf("authentication of request could not be verified: %w", err)
 	}
@@ -147,12 +258,12 @@ func (v *Verifier) VerifyRequestFromSubjectsWithFilters(ctx context.Context, fea
 		return nil
 	}
 
-	idToken, err := v.verifyAuthN(ctx, requiredOIDCAudience, req, resp)
+	idToken, err := v.verifyAuthN(ctx, features, requiredOIDCAudience, resourceNamespace, req, resp)
 	if err != nil {
 		return fmt.Errorf("authentication of request could not be verified: %w", err)
 	}
 
-	err = v.verifyAuthZBySubjectsWithFilters(ctx, features, idToken, resourceNamespace, allowedSubjectsWithFilters, req, resp)
+	err = v.verifyAuthZBySubjectsWithFilters(ctx, features, idToken, resourceNamespace, allowedSubjectsWithFilters, req, resp, true)
 	if err != nil {
 		return fmt.Errorf("authorization of request could not be verified: %w", err)
 	}
@@ -160,84 +271,191 @@ func (v *Verifier) VerifyRequestFromSubjectsWithFilters(ctx context.Context, fea
 	return nil
 }
 
-// verifyAuthN verifies if the incoming request contains a correct JWT token
-func (v *Verifier) verifyAuthN(ctx context.Context, audience *string, req *http.Request, resp http.ResponseWriter) (*IDToken, error) {
+// verifyAuthN verifies if the incoming request contains a correct JWT token. Requests
+// without a token are authenticated by the SPIFFE ID of their client certificate, when
+// the transport-encryption feature is strict. Authentication failures are audited, if
+// configured for the resourceNamespace.
+func (v *Verifier) verifyAuthN(ctx context.Context, features feature.Flags, audience *string, resourceNamespace string, req *http.Request, resp http.ResponseWriter) (*IDToken, error) {
 	token := GetJWTFromHeader(req.Header)
+	if token == "" && features.IsStrictTransportEncryption() && hasClientCertificate(req.TLS) {
+		idToken, err := v.verifyClientCertificate(features, req.TLS)
+		if err != nil {
+			v.auditAuthenticationFailure(http.StatusUnauthorized, audience, resourceNamespace, err)
+			resp.WriteHeader(http.StatusUnauthorized)
+			return nil, err
+		}
+		return idToken, nil
+	}
+
+	idToken, status, err := v.authenticate(ctx, audience, token)
+	if err != nil {
+		v.auditAuthenticationFailure(status, audience, resourceNamespace, err)
+		resp.WriteHeader(status)
+		return nil, err
+	}
+
+	return idToken, nil
+}
+
+// auditAuthenticationFailure audits the denial of an unauthenticated request to a resource
+// in resourceNamespace, if configured. Failures other than 401 are not decisions about
+// the sender, so they are not audited.
+func (v *Verifier) auditAuthenticationFailure(status int, audience *string, resourceNamespace string, err error) {
+	if status != http.StatusUnauthorized {
+		return
+	}
+
+	idToken := &IDToken{}
+	if audience != nil {
+		idToken.Audience = []string{*audience}
+	}
+	v.auditor.audit(&Decision{Allowed: false, Reason: fmt.Sprintf("authentication failed: %v", err)}, idToken, resourceNamespace, nil)
+}
+
+// AuthenticateClient verifies the JWT presented by a client when it connects over a long-lived
+// connection, like MQTT clients, before the targets of its events are known. The audience of the
+// token is verified for every event with VerifyEvent. On verification errors, it returns the HTTP
+// status describing the failure and an error.
+func (v *Verifier) AuthenticateClient(ctx context.Context, features feature.Flags, token string) (int, error) {
+	if !features.IsOIDCAuthentication() {
+		return http.StatusOK, nil
+	}
+
+	if token == "" {
+		return http.StatusUnauthorized, fmt.Errorf("no JWT token found in connection")
+	}
+
+	if _, err := v.verifyIDToken(ctx, token, nil); err != nil {
+		v.auditAuthenticationFailure(http.StatusUnauthorized, nil, "", err)
+		return http.StatusUnauthorized, fmt.Errorf("authentication of client could not be verified: %w", err)
+	}
+
+	return http.StatusOK, nil
+}
+
+// authenticate verifies the given JWT for the audience. On verification
+// errors, it returns the HTTP status describing the failure.
+func (v *Verifier) authenticate(ctx context.Context, audience *string, token string) (*IDToken, int, error) {
 	if token == "" {
-		resp.WriteHeader(http.StatusUnauthorized)
-		return nil, fmt.Errorf("no JWT token found in request")
+		return nil, http.StatusUnauthorized, fmt.Errorf("no JWT token found in request")
 	}
 
 	if audience == nil {
-		resp.WriteHeader(http.StatusInternalServerError)
-		return nil, fmt.Errorf("no audience is provided")
+		return nil, http.StatusInternalServerError, fmt.Errorf("no audience is provided")
 	}
 
-	idToken, err := v.verifyJWT(ctx, token, *audience)
+	idToken, err := v.verifyIDToken(ctx, token, audience)
+	if err != nil {
+		return nil, http.StatusUnauthorized, err
+	}
+
+	return idToken, http.StatusOK, nil
+}
+
+// verifyIDToken verifies the given JWT for the audience, or for any audience when it is nil.
+func (v *Verifier) verifyIDToken(ctx context.Context, token string, audience *string) (*IDToken, error) {
+	idToken, err := v.verifyJWT(ctx, token, audience)
 	if err != nil {
-		resp.WriteHeader(http.StatusUnauthorized)
 		return nil, fmt.Errorf("failed to verify JWT: %w", err)
 	}
 
+	// SPIFFE IDs authenticate client certificates only, so that a JWT can't match the rules for them
+	if isSpiffeID(idToken.Subject) {
+		return nil, fmt.Errorf("JWT subject %q is a SPIFFE ID, which is only accepted from client certificates", idToken.Subject)
+	}
+
 	return idToken, nil
 }
 
 // verifyAuthZ verifies if the given idToken is allowed by the resources eventPolicyStatus
-func (v *Verifier) verifyAuthZ(ctx context.Context, features feature.Flags, idToken *IDToken, resourceNamespace string, policyRefs []duckv1.AppliedEventPolicyRef, req *http.Request, resp http.ResponseWriter) error {
-	subjectsWithFiltersFromApplyingPolicies, err := SubjectWithFiltersFromPolicyRef(v.eventPolicyLister, resourceNamespace, policyRefs)
+func (v *Verifier) verifyAuthZ(ctx context.Context, features feature.Flags, idToken *IDToken, resourceNamespace string, policyRefs []duckv1.AppliedEventPolicyRef, req *http.Request, resp http.ResponseWriter, withEvent bool) error {
+	subjectsWithFiltersFromApplyingPolicies, err := SubjectWithFiltersFromPolicyRef(v.eventPolicyLister, v.clusterEventPolicyLister, resourceNamespace, policyRefs)
 	if err != nil {
 		resp.WriteHeader(http.StatusInternalServerError)
 		return fmt.Errorf("could not get subjects with filters from policy: %w", err)
 	}
 
-	return v.verifyAuthZBySubjectsWithFilters(ctx, features, idToken, resourceNamespace, subjectsWithFiltersFromApplyingPolicies, req, resp)
+	return v.verifyAuthZBySubjectsWithFilters(ctx, features, idToken, resourceNamespace, subjectsWithFiltersFromApplyingPolicies, req, resp, withEvent)
 }
 
 // verifyAuthZBySubjectsWithFilters verifies if the given idToken is allowed by the resources eventPolicyStatus
 // it does the same as verifyAuthZ but taking a subjectWithFilters slice instead
-func (v *Verifier) verifyAuthZBySubjectsWithFilters(ctx context.Context, features feature.Flags, idToken *IDToken, resourceNamespace string, subjectsWithFiltersFromApplyingPolicies []SubjectsWithFilters, req *http.Request, resp http.ResponseWriter) error {
-	if len(subjectsWithFiltersFromApplyingPolicies) > 0 {
-		req, err := utils.CopyRequest(req)
-		if err != nil {
-			resp.WriteHeader(http.StatusInternalServerError)
-			return fmt.Errorf("failed to copy request body: %w", err)
+func (v *Verifier) verifyAuthZBySubjectsWithFilters(ctx context.Context, features feature.Flags, idToken *IDToken, resourceNamespace string, subjectsWithFiltersFromApplyingPolicies []SubjectsWithFilters, req *http.Request, resp http.ResponseWriter, withEvent bool) error {
+	var getEvent func() (*cloudevents.Event, error)
+	if withEvent {
+		getEvent = func() (*cloudevents.Event, error) {
+			return eventFromRequest(ctx, req)
 		}
-
-		message := cehttp.NewMessageFromHttpRequest(req)
-		defer message.Finish(nil)
-
-		event, err := binding.ToEvent(ctx, message)
-		if err != nil {
-			resp.WriteHeader(http.StatusInternalServerError)
-			return fmt.Errorf("failed to decode event from request: %w", err)
+	}
+	status, err := v.authorize(ctx, features, idToken, resourceNamespace, subjectsWithFiltersFromApplyingPolicies, getEvent)
+	if err != nil {
+		var rateLimitedErr *RateLimitedError
+		if errors.As(err, &rateLimitedErr) {
+			rateLimitedErr.SetRetryAfter(resp.Header())
 		}
+		resp.WriteHeader(status)
+	}
+	return err
+}
 
-		if !SubjectAndFiltersPass(ctx, idToken.Subject, subjectsWithFiltersFromApplyingPolicies, event, v.logger) {
-			resp.WriteHeader(http.StatusForbidden)
-			return fmt.Errorf("token is from subject %q, but only %#v are part of applying event policies", idToken.Subject, subjectsWithFiltersFromApplyingPolicies)
-		}
+// eventFromRequest decodes the event sent in req, leaving its body readable
+func eventFromRequest(ctx context.Context, req *http.Request) (*cloudevents.Event, error) {
+	req, err := utils.CopyRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy request body: %w", err)
+	}
 
-		return nil
+	message := cehttp.NewMessageFromHttpRequest(req)
+	defer message.Finish(nil)
+
+	event, err := binding.ToEvent(ctx, message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode event from request: %w", err)
 	}
+	return event, nil
+}
 
-	if features.IsAuthorizationDefaultModeDenyAll() {
-		resp.WriteHeader(http.StatusForbidden)
-		return fmt.Errorf("no event policies apply for resource and %s is set to %s", feature.AuthorizationDefaultMode, feature.AuthorizationDenyAll)
-	} else if features.IsAuthorizationDefaultModeSameNamespace() {
-		if !strings.HasPrefix(idToken.Subject, fmt.Sprintf("%s:%s:", kubernetesServiceAccountPrefix, resourceNamespace)) {
-			resp.WriteHeader(http.StatusForbidden)
-			return fmt.Errorf("no policies apply for resource. %s is set to %s, but token is from subject %q, which is not part of %q namespace", feature.AuthorizationDefaultMode, feature.AuthorizationDenyAll, idToken.Subject, resourceNamespace)
+// authorize verifies if the given idToken is allowed by the subjects with
+// filters of the applying event policies, getting the event only when
+// rules need to be evaluated. The decision is audited, if configured for
+// the resourceNamespace. Allowed events are checked against the rate limit
+// of the deciding event policy. A nil getEvent authorizes a request without
+// an event, which is not counted against the rate limit. On verification
+// errors, it returns the HTTP status describing the failure.
+func (v *Verifier) authorize(ctx context.Context, features feature.Flags, idToken *IDToken, resourceNamespace string, subjectsWithFiltersFromApplyingPolicies []SubjectsWithFilters, getEvent func() (*cloudevents.Event, error)) (int, error) {
+	var event *cloudevents.Event
+	if len(subjectsWithFiltersFromApplyingPolicies) > 0 && getEvent != nil {
+		var err error
+		event, err = getEvent()
+		if err != nil {
+			return http.StatusInternalServerError, err
 		}
+	}
 
-		return nil
+	decision := Authorize(ctx, features, idToken.Subject, resourceNamespace, subjectsWithFiltersFromApplyingPolicies, event, v.logger)
+	v.auditor.audit(decision, idToken, resourceNamespace, event)
+	if !decision.Allowed {
+		return http.StatusForbidden, errors.New(decision.Reason)
 	}
-	// else: allow all
 
-	return nil
+	if getEvent == nil {
+		return http.StatusOK, nil
+	}
+	if err := v.rateLimiters.reserve(resourceNamespace, decision.Policy, idToken.Subject, rateLimitOf(decision.Policy, subjectsWithFiltersFromApplyingPolicies)); err != nil {
+		return http.StatusTooManyRequests, err
+	}
+
+	return http.StatusOK, nil
 }
 
 // verifyJWT verifies the given JWT for the expected audience and returns the parsed ID token.
-func (v *Verifier) verifyJWT(ctx context.Context, jwt, audience string) (*IDToken, error) {
+// JWTs of trusted external issuers are verified with the keys of their issuer.
+// verifyJWT verifies the given JWT for the audience. When the audience is nil, tokens for any audience are accepted.
+func (v *Verifier) verifyJWT(ctx context.Context, jwt string, audience *string) (*IDToken, error) {
+	if external := v.externalIssuerVerifierFor(jwt); external != nil {
+		return external.verify(ctx, jwt, audience)
+	}
+
 	v.m.RLock()
 	defer v.m.RUnlock()
 
@@ -245,9 +463,11 @@ func (v *Verifier) verifyJWT(ctx context.Context, jwt, audience string) (*IDToke
 		return nil, fmt.Errorf("provider is nil. Is the OIDC provider config correct?")
 	}
 
-	verifier := v.provider.Verifier(&oidc.Config{
-		ClientID: audience,
-	})
+	config := &oidc.Config{SkipClientIDCheck: true}
+	if audience != nil {
+		config = &oidc.Config{ClientID: *audience}
+	}
+	verifier := v.provider.Verifier(config)
 
 	token, err := verifier.Verify(ctx, jwt)
 	if err != nil {
@@ -312,6 +532,11 @@ func (v *Verifier) getHTTPClient(features feature.Flags) (*http.Client, error) {
 		return v.getHTTPClientForKubeAPIServer()
 	}
 
+	return v.getTLSHTTPClient()
+}
+
+// getTLSHTTPClient returns an HTTP client trusting the trust bundles
+func (v *Verifier) getTLSHTTPClient() (*http.Client, error) {
 	var base = http.DefaultTransport.(*http.Transport).Clone()
 
 	clientConfig := eventingtls.ClientConfig{
@@ -367,22 +592,72 @@ type openIDMetadata struct {
 	SigningAlgs   []string `json:"id_token_signing_alg_values_supported"`
 }
 
-func SubjectWithFiltersFromPolicyRef(eventPolicyLister listerseventingv1alpha1.EventPolicyLister, resourceNamespace string, policyRefs []duckv1.AppliedEventPolicyRef) ([]SubjectsWithFilters, error) {
+// SubjectWithFiltersFromPolicyRef returns the allow and deny rules of the referenced event policies.
+// Event policies with deny rules only, do not contribute any allowed subjects.
+func SubjectWithFiltersFromPolicyRef(eventPolicyLister listerseventingv1alpha1.EventPolicyLister, clusterEventPolicyLister listerseventingv1alpha1.ClusterEventPolicyLister, resourceNamespace string, policyRefs []duckv1.AppliedEventPolicyRef) ([]SubjectsWithFilters, error) {
 	subjectsWithFiltersFromApplyingPolicies := make([]SubjectsWithFilters, 0, len(policyRefs))
 
 	for _, p := range policyRefs {
+		if p.Kind == eventingv1alpha1.ClusterEventPolicyKind {
+			policy, err := clusterEventPolicyLister.Get(p.Name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get clusterEventPolicy: %w", err)
+			}
+
+			// cluster policies are qualified by their kind, so they don't share rate limits or
+			// audit records with namespaced policies of the same name
+			subjectsWithFiltersFromApplyingPolicies = appendSubjectsWithFilters(subjectsWithFiltersFromApplyingPolicies, eventingv1alpha1.ClusterEventPolicyKind+"/"+policy.Name, policy.UID, policy.Generation, &policy.Spec.EventPolicySpec, &policy.Status)
+			continue
+		}
+
 		policy, err := eventPolicyLister.EventPolicies(resourceNamespace).Get(p.Name)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get eventPolicy: %w", err)
 		}
 
-		subjectsWithFiltersFromApplyingPolicies = append(subjectsWithFiltersFromApplyingPolicies, SubjectsWithFilters{Subjects: policy.Status.From, Filters: policy.Spec.Filters})
+		subjectsWithFiltersFromApplyingPolicies = appendSubjectsWithFilters(subjectsWithFiltersFromApplyingPolicies, policy.Name, policy.UID, policy.Generation, &policy.Spec, &policy.Status)
 	}
 
 	return subjectsWithFiltersFromApplyingPolicies, nil
 }
 
+// appendSubjectsWithFilters appends the allow and deny rules of the event policy. The resolved subjects of the deny rules
+// are paired with the deny rules by their index, which only holds once the status observed the generation of the spec.
+// Until then, the deny rules fail closed and apply to all subjects.
+func appendSubjectsWithFilters(subjectsWithFilters []SubjectsWithFilters, policyName string, uid types.UID, generation int64, spec *eventingv1alpha1.EventPolicySpec, status *eventingv1alpha1.EventPolicyStatus) []SubjectsWithFilters {
+	if len(spec.From) > 0 || len(spec.Deny) == 0 {
+		swf := SubjectsWithFilters{Subjects: status.From, Filters: spec.Filters, Policy: policyName}
+		if spec.Signature != nil {
+			swf.SignatureKeys = spec.Signature.JWKS
+			swf.signatureKeySet = signatureKeySetFor(uid, spec.Signature.JWKS)
+		}
+		swf.RateLimit = spec.RateLimit
+		subjectsWithFilters = append(subjectsWithFilters, swf)
+	}
+
+	denyReconciled := status.ObservedGeneration == generation && len(status.Deny) == len(spec.Deny)
+	for i, deny := range spec.Deny {
+		subjects := []string{"*"}
+		if denyReconciled {
+			subjects = status.Deny[i].From
+		}
+		subjectsWithFilters = append(subjectsWithFilters, SubjectsWithFilters{Subjects: subjects, Filters: deny.Filters, Deny: true, Policy: policyName})
+	}
+
+	return subjectsWithFilters
+}
+
 type SubjectsWithFilters struct {
 	Filters  []eventingv1.SubscriptionsAPIFilter `json:"filters,omitempty"`
 	Subjects []string                            `json:"subjects,omitempty"`
+	// Deny marks the subjects with filters as a deny rule, which takes precedence over the allowed subjects
+	Deny bool `json:"deny,omitempty"`
+	// Policy is the name of the event policy the subjects with filters are from
+	Policy string `json:"policy,omitempty"`
+	// SignatureKeys is a JSON Web Key Set of which one key must have signed the event
+	SignatureKeys string `json:"signatureKeys,omitempty"`
+	// signatureKeySet are the parsed SignatureKeys
+	signatureKeySet *signatureKeySet
+	// RateLimit is the rate limit of the events allowed by the subjects with filters
+	RateLimit *eventingv1alpha1.EventPolicySpecRateLimit `json:"rateLimit,omitempty"`
 }
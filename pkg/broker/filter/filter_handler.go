@@ -192,6 +192,7 @@ func NewHandler(
 		filtersMap:         fm,
 		tracer:             traceProvider.Tracer(ScopeName),
 	}
+	tokenVerifier.SetAuditSender(h.eventDispatcher.AuditSender())
 
 	meter := meterProvider.Meter(ScopeName)
 
@@ -64,5 +64,6 @@ func getServerTLSConfig(ctx context.Context) (*tls.Config, error) {
 
 	serverTLSConfig := eventingtls.NewDefaultServerConfig()
 	serverTLSConfig.GetCertificate = eventingtls.GetCertificateFromSecret(ctx, secretinformer.Get(ctx), kubeclient.Get(ctx), secret)
+	serverTLSConfig.RequestClientCertificates = true
 	return eventingtls.GetTLSServerConfig(serverTLSConfig)
 }
@@ -150,6 +150,7 @@ func NewHandler(
 		withContext:   withContext,
 		tracer:        traceProvider.Tracer(ScopeName),
 	}
+	tokenVerifier.SetAuditSender(h.eventDispatcher.AuditSender())
 
 	meter := meterProvider.Meter(ScopeName)
 
@@ -263,10 +264,6 @@ func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 
 	brokerNamespace := nsBrokerName[1]
 	brokerName := nsBrokerName[2]
-	brokerNamespacedName := types.NamespacedName{
-		Name:      brokerName,
-		Namespace: brokerNamespace,
-	}
 
 	broker, err := h.getBroker(brokerName, brokerNamespace)
 	if apierrors.IsNotFound(err) {
@@ -291,6 +288,23 @@ func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 		return
 	}
 
+	statusCode := h.send(ctx, utils.PassThroughHeaders(request.Header), event, broker)
+	writer.WriteHeader(statusCode)
+
+	// EventType auto-create feature handling
+	if h.EvenTypeHandler != nil {
+		h.EvenTypeHandler.AutoCreateEventType(ctx, event, toKReference(broker), broker.GetUID())
+	}
+}
+
+// send sends the event, whose sender was verified, to the broker and returns
+// the resulting HTTP status code.
+func (h *Handler) send(ctx context.Context, headers http.Header, event *cloudevents.Event, broker *eventingv1.Broker) int {
+	brokerNamespacedName := types.NamespacedName{
+		Name:      broker.Name,
+		Namespace: broker.Namespace,
+	}
+
 	ctx = observability.WithBrokerLabels(ctx, brokerNamespacedName)
 	ctx = observability.WithMinimalEventLabels(ctx, event)
 
@@ -306,18 +320,13 @@ func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 		span.End()
 	}()
 
-	statusCode, dispatchTime := h.receive(ctx, utils.PassThroughHeaders(request.Header), event, broker)
+	statusCode, dispatchTime := h.receive(ctx, headers, event, broker)
 	if dispatchTime > kncloudevents.NoDuration {
 		labeler, _ := otelhttp.LabelerFromContext(ctx)
 		h.dispatchDuration.Record(ctx, dispatchTime.Seconds(), metric.WithAttributes(labeler.Get()...))
 	}
 
-	writer.WriteHeader(statusCode)
-
-	// EventType auto-create feature handling
-	if h.EvenTypeHandler != nil {
-		h.EvenTypeHandler.AutoCreateEventType(ctx, event, toKReference(broker), broker.GetUID())
-	}
+	return statusCode
 }
 
 func toKReference(broker *eventingv1.Broker) *duckv1.KReference {
@@ -0,0 +1,463 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	mqttpaho "github.com/cloudevents/sdk-go/protocol/mqtt_paho/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/eclipse/paho.golang/packets"
+	"github.com/eclipse/paho.golang/paho"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+
+	"knative.dev/eventing/pkg/apis/feature"
+)
+
+const (
+	// mqttConnectTimeout is how long a client has to send its CONNECT packet
+	// once connected.
+	mqttConnectTimeout = 10 * time.Second
+
+	// tlsHandshakeRecordType is the first byte sent by TLS clients, MQTT
+	// clients start with a CONNECT packet instead.
+	tlsHandshakeRecordType = 0x16
+)
+
+// errMQTTPacketTooLarge is returned when a client sends a packet exceeding
+// the maximum packet size.
+var errMQTTPacketTooLarge = errors.New("packet exceeds the maximum packet size")
+
+// MQTTServer accepts CloudEvents published by MQTT v5 clients to the
+// `<namespace>/<broker>` topics, in either the binary or structured mode of
+// the CloudEvents MQTT protocol binding, and sends them to the brokers the
+// same way as events received over HTTP.
+//
+// When OIDC authentication is enabled, clients present their JWT as the
+// password of the connection. The JWT is verified when the client connects,
+// and for the broker of every event it publishes.
+//
+// Packets larger than the maximum packet size are refused before they are
+// read, and clients exceeding the maximum number of connections are
+// disconnected right away.
+//
+// Clients starting the connection with a TLS handshake are served with the
+// certificate of the broker ingress. Plaintext connections are refused when
+// transport encryption is strict, or permissive while OIDC authentication is
+// enabled, so that tokens are never sent in cleartext.
+type MQTTServer struct {
+	handler       *Handler
+	port          int
+	tlsConfig     *tls.Config
+	maxPacketSize uint32
+	connections   chan struct{}
+	logger        *zap.Logger
+}
+
+// NewMQTTServer returns a server listening for MQTT connections on port,
+// which accepts packets of up to maxPacketSize bytes from up to
+// maxConnections clients at a time.
+func NewMQTTServer(ctx context.Context, handler *Handler, port int, maxPacketSize uint32, maxConnections int) *MQTTServer {
+	tlsConfig, err := getServerTLSConfig(ctx)
+	if err != nil {
+		handler.Logger.Info("failed to get TLS server config", zap.Error(err))
+	}
+	return newMQTTServer(handler, port, tlsConfig, maxPacketSize, maxConnections)
+}
+
+func newMQTTServer(handler *Handler, port int, tlsConfig *tls.Config, maxPacketSize uint32, maxConnections int) *MQTTServer {
+	return &MQTTServer{
+		handler:       handler,
+		port:          port,
+		tlsConfig:     tlsConfig,
+		maxPacketSize: maxPacketSize,
+		connections:   make(chan struct{}, maxConnections),
+		logger:        handler.Logger.With(zap.String("protocol", "mqtt")),
+	}
+}
+
+// Start accepts and serves connections until ctx is done.
+func (s *MQTTServer) Start(ctx context.Context) error {
+	var lc net.ListenConfig
+	ln, err := lc.Listen(ctx, "tcp", fmt.Sprintf(":%d", s.port))
+	if err != nil {
+		return fmt.Errorf("failed to listen on port %d: %w", s.port, err)
+	}
+	return s.serve(ctx, ln)
+}
+
+func (s *MQTTServer) serve(ctx context.Context, ln net.Listener) error {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	go func() {
+		<-ctx.Done()
+		ln.Close()
+	}()
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		select {
+		case s.connections <- struct{}{}:
+		default:
+			s.logger.Info("Refusing connection, too many clients are connected", zap.String("remote", conn.RemoteAddr().String()))
+			conn.Close()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-s.connections }()
+			s.serveConn(ctx, conn)
+		}()
+	}
+}
+
+// serveConn handles the packets sent by the client connected with conn until
+// it disconnects.
+func (s *MQTTServer) serveConn(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	logger := s.logger.With(zap.String("remote", conn.RemoteAddr().String()))
+
+	_ = conn.SetReadDeadline(time.Now().Add(mqttConnectTimeout))
+	secured, err := s.secure(ctx, conn)
+	if err != nil {
+		logger.Info("Refusing connection", zap.Error(err))
+		return
+	}
+	s.serveMQTT(ctx, secured, logger)
+}
+
+// serveMQTT handles the MQTT session of the client connected with conn.
+func (s *MQTTServer) serveMQTT(ctx context.Context, conn net.Conn, logger *zap.Logger) {
+	cp, err := readPacket(conn, s.maxPacketSize)
+	if errors.Is(err, errMQTTPacketTooLarge) {
+		_ = writePacket(conn, packets.CONNACK, &packets.Connack{ReasonCode: packets.ConnackPacketTooLarge, Properties: &packets.Properties{}})
+		return
+	}
+	if err != nil {
+		logger.Debug("Failed to read CONNECT packet", zap.Error(err))
+		return
+	}
+	connect, ok := cp.Content.(*packets.Connect)
+	if !ok {
+		logger.Debug("Unexpected first packet", zap.String("type", cp.PacketType()))
+		return
+	}
+	if connect.ProtocolVersion != 5 {
+		_ = writePacket(conn, packets.CONNACK, &packets.Connack{ReasonCode: packets.ConnackUnsupportedProtocolVersion, Properties: &packets.Properties{}})
+		return
+	}
+
+	token := string(connect.Password)
+	features := feature.FromContext(s.handler.withContext(ctx))
+	if _, err := s.handler.tokenVerifier.AuthenticateClient(ctx, features, token); err != nil {
+		logger.Info("Refusing client", zap.Error(err))
+		_ = writePacket(conn, packets.CONNACK, &packets.Connack{ReasonCode: packets.ConnackNotAuthorized, Properties: &packets.Properties{}})
+		return
+	}
+
+	// Subscriptions and QoS 2 are not supported, the clients only publish.
+	connack := &packets.Connack{
+		ReasonCode: packets.ConnackSuccess,
+		Properties: &packets.Properties{
+			MaximumPacketSize:    ptr.To(s.maxPacketSize),
+			MaximumQOS:           ptr.To[byte](1),
+			RetainAvailable:      ptr.To[byte](0),
+			WildcardSubAvailable: ptr.To[byte](0),
+			SubIDAvailable:       ptr.To[byte](0),
+			SharedSubAvailable:   ptr.To[byte](0),
+		},
+	}
+	if connect.ClientID == "" {
+		connack.Properties.AssignedClientID = fmt.Sprintf("knative-%s", conn.RemoteAddr().String())
+	}
+	if err := writePacket(conn, packets.CONNACK, connack); err != nil {
+		logger.Debug("Failed to write CONNACK packet", zap.Error(err))
+		return
+	}
+
+	var keepAlive time.Duration
+	if connect.KeepAlive > 0 {
+		keepAlive = time.Duration(connect.KeepAlive) * time.Second * 3 / 2
+	}
+
+	for {
+		if keepAlive > 0 {
+			_ = conn.SetReadDeadline(time.Now().Add(keepAlive))
+		} else {
+			_ = conn.SetReadDeadline(time.Time{})
+		}
+
+		cp, err := readPacket(conn, s.maxPacketSize)
+		if errors.Is(err, errMQTTPacketTooLarge) {
+			_ = writePacket(conn, packets.DISCONNECT, &packets.Disconnect{ReasonCode: packets.DisconnectPacketTooLarge, Properties: &packets.Properties{}})
+			return
+		}
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				logger.Debug("Failed to read packet", zap.Error(err))
+			}
+			return
+		}
+
+		switch p := cp.Content.(type) {
+		case *packets.Publish:
+			if p.QoS > 1 {
+				_ = writePacket(conn, packets.DISCONNECT, &packets.Disconnect{ReasonCode: packets.DisconnectQoSNotSupported, Properties: &packets.Properties{}})
+				return
+			}
+
+			statusCode := s.handler.handleMQTT(ctx, token, paho.PublishFromPacketPublish(p))
+			if p.QoS == 0 {
+				// QoS 0 publishes aren't acknowledged, clients not allowed
+				// to publish learn about it from the disconnect
+				if statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden {
+					_ = writePacket(conn, packets.DISCONNECT, &packets.Disconnect{ReasonCode: packets.DisconnectNotAuthorized, Properties: &packets.Properties{}})
+					return
+				}
+				continue
+			}
+			err = writePacket(conn, packets.PUBACK, &packets.Puback{
+				PacketID:   p.PacketID,
+				ReasonCode: pubackReasonCode(statusCode),
+				Properties: &packets.Properties{},
+			})
+		case *packets.Pingreq:
+			err = writePacket(conn, packets.PINGRESP, &packets.Pingresp{})
+		case *packets.Subscribe:
+			reasons := make([]byte, len(p.Subscriptions))
+			for i := range reasons {
+				reasons[i] = packets.SubackImplementationspecificerror
+			}
+			err = writePacket(conn, packets.SUBACK, &packets.Suback{PacketID: p.PacketID, Reasons: reasons, Properties: &packets.Properties{}})
+		case *packets.Unsubscribe:
+			reasons := make([]byte, len(p.Topics))
+			for i := range reasons {
+				reasons[i] = packets.UnsubackNoSubscriptionFound
+			}
+			err = writePacket(conn, packets.UNSUBACK, &packets.Unsuback{PacketID: p.PacketID, Reasons: reasons, Properties: &packets.Properties{}})
+		case *packets.Disconnect:
+			return
+		default:
+			_ = writePacket(conn, packets.DISCONNECT, &packets.Disconnect{ReasonCode: packets.DisconnectProtocolError, Properties: &packets.Properties{}})
+			return
+		}
+		if err != nil {
+			logger.Debug("Failed to write packet", zap.Error(err))
+			return
+		}
+	}
+}
+
+// secure returns the connection to read the MQTT packets from: a TLS
+// connection when the client starts with a TLS handshake, conn otherwise,
+// unless plaintext connections aren't allowed.
+func (s *MQTTServer) secure(ctx context.Context, conn net.Conn) (net.Conn, error) {
+	r := bufio.NewReader(conn)
+	first, err := r.Peek(1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read from the connection: %w", err)
+	}
+	conn = &bufferedConn{Conn: conn, r: r}
+
+	if first[0] == tlsHandshakeRecordType {
+		if s.tlsConfig == nil {
+			return nil, errors.New("TLS is not configured")
+		}
+		tlsConn := tls.Server(conn, s.tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			return nil, fmt.Errorf("TLS handshake failed: %w", err)
+		}
+		return tlsConn, nil
+	}
+
+	features := feature.FromContext(s.handler.withContext(ctx))
+	if features.IsStrictTransportEncryption() || (features.IsPermissiveTransportEncryption() && features.IsOIDCAuthentication()) {
+		return nil, errors.New("plaintext connections are not allowed by the transport encryption configuration")
+	}
+	return conn, nil
+}
+
+// bufferedConn is a net.Conn whose first bytes were already read into r.
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+// handleMQTT sends the event published to the `<namespace>/<broker>` topic
+// by a client presenting token to the broker, and returns the HTTP status
+// code the same event sent over HTTP would have been answered with.
+func (h *Handler) handleMQTT(ctx context.Context, token string, p *paho.Publish) int {
+	nsBrokerName := strings.Split(p.Topic, "/")
+	if len(nsBrokerName) != 2 || nsBrokerName[0] == "" || nsBrokerName[1] == "" {
+		h.Logger.Info("Malformed topic", zap.String("topic", p.Topic))
+		return http.StatusNotFound
+	}
+	brokerNamespace := nsBrokerName[0]
+	brokerName := nsBrokerName[1]
+
+	ctx = h.withContext(ctx)
+
+	message := mqttpaho.NewMessage(p)
+	defer message.Finish(nil)
+
+	if message.ReadEncoding() == binding.EncodingUnknown {
+		h.Logger.Warn("message is not a CloudEvent", zap.String("topic", p.Topic))
+		return http.StatusBadRequest
+	}
+
+	event, err := binding.ToEvent(ctx, message)
+	if err != nil {
+		h.Logger.Warn("failed to extract event from message", zap.Error(err))
+		return http.StatusBadRequest
+	}
+
+	// run validation for the extracted event
+	if err := event.Validate(); err != nil {
+		h.Logger.Warn("failed to validate extracted event", zap.Error(err))
+		return http.StatusBadRequest
+	}
+
+	broker, err := h.getBroker(brokerName, brokerNamespace)
+	if apierrors.IsNotFound(err) {
+		h.Logger.Warn("Failed to retrieve broker", zap.Error(err))
+		return http.StatusNotFound
+	}
+	if err != nil {
+		h.Logger.Warn("Failed to retrieve broker", zap.Error(err))
+		return http.StatusBadRequest
+	}
+
+	features := feature.FromContext(ctx)
+	audience := ptr.To("")
+	if broker.Status.Address != nil {
+		audience = broker.Status.Address.Audience
+	}
+	if statusCode, err := h.tokenVerifier.VerifyEvent(ctx, features, audience, brokerNamespace, broker.Status.Policies, token, event); err != nil {
+		h.Logger.Warn("Failed to verify AuthN and AuthZ.", zap.Error(err))
+		return statusCode
+	}
+
+	statusCode := h.send(ctx, http.Header{}, event, broker)
+
+	// EventType auto-create feature handling
+	if h.EvenTypeHandler != nil {
+		h.EvenTypeHandler.AutoCreateEventType(ctx, event, toKReference(broker), broker.GetUID())
+	}
+
+	return statusCode
+}
+
+// pubackReasonCode maps the HTTP status code of the delivery of an event to
+// the reason code of the PUBACK packet acknowledging it.
+func pubackReasonCode(statusCode int) byte {
+	switch {
+	case statusCode >= 200 && statusCode < 300:
+		return packets.PubackSuccess
+	case statusCode == http.StatusBadRequest:
+		return packets.PubackPayloadFormatInvalid
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return packets.PubackNotAuthorized
+	case statusCode == http.StatusNotFound:
+		return packets.PubackTopicNameInvalid
+	case statusCode == http.StatusTooManyRequests:
+		return packets.PubackQuotaExceeded
+	default:
+		return packets.PubackUnspecifiedError
+	}
+}
+
+// readPacket reads the next packet from r. The size of the packet is read
+// from its fixed header and checked against maxPacketSize before the rest of
+// the packet is read, so that clients can't make the server allocate more
+// memory than the largest packet it accepts.
+func readPacket(r io.Reader, maxPacketSize uint32) (*packets.ControlPacket, error) {
+	var b [1]byte
+	fixedHeader := make([]byte, 0, 5)
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return nil, err
+	}
+	fixedHeader = append(fixedHeader, b[0])
+
+	// the remaining length is a variable byte integer of up to 4 bytes
+	var remainingLength uint32
+	for shift := 0; ; shift += 7 {
+		if shift > 21 {
+			return nil, errors.New("malformed remaining length")
+		}
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return nil, err
+		}
+		fixedHeader = append(fixedHeader, b[0])
+		remainingLength |= uint32(b[0]&0x7f) << shift
+		if b[0]&0x80 == 0 {
+			break
+		}
+	}
+
+	if uint64(len(fixedHeader))+uint64(remainingLength) > uint64(maxPacketSize) {
+		return nil, errMQTTPacketTooLarge
+	}
+
+	packet := make([]byte, len(fixedHeader)+int(remainingLength))
+	copy(packet, fixedHeader)
+	if _, err := io.ReadFull(r, packet[len(fixedHeader):]); err != nil {
+		return nil, err
+	}
+	return packets.ReadPacket(bytes.NewReader(packet))
+}
+
+func writePacket(conn net.Conn, packetType byte, content packets.Packet) error {
+	cp := packets.NewControlPacket(packetType)
+	cp.Content = content
+	_, err := cp.WriteTo(conn)
+	return err
+}
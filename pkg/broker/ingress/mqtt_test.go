@@ -0,0 +1,468 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eclipse/paho.golang/packets"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	filteredconfigmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered/fake"
+	"knative.dev/pkg/configmap"
+	reconcilertesting "knative.dev/pkg/reconciler/testing"
+	"knative.dev/pkg/system"
+
+	"knative.dev/eventing/pkg/apis/eventing"
+	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/eventing/pkg/auth"
+	"knative.dev/eventing/pkg/broker"
+	brokerinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1/broker/fake"
+	clustereventpolicyinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy/fake"
+	eventpolicyinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy/fake"
+	"knative.dev/eventing/pkg/eventingtls"
+)
+
+const (
+	testMQTTMaxPacketSize  = 64 * 1024
+	testMQTTMaxConnections = 10
+)
+
+func TestMQTTServer(t *testing.T) {
+	ceProperties := []packets.User{
+		{Key: "ce-specversion", Value: "1.0"},
+		{Key: "ce-id", Value: "1234"},
+		{Key: "ce-source", Value: "source"},
+		{Key: "ce-type", Value: "type"},
+	}
+
+	tt := []struct {
+		name       string
+		features   feature.Flags
+		topic      string
+		properties []packets.User
+		wantReason byte
+		wantSent   bool
+	}{{
+		name:       "binary mode event",
+		topic:      "ns/name",
+		properties: ceProperties,
+		wantReason: packets.PubackSuccess,
+		wantSent:   true,
+	}, {
+		name:  "structured mode event",
+		topic: "ns/name",
+		properties: []packets.User{
+			{Key: "Content-Type", Value: "application/cloudevents+json"},
+		},
+		wantReason: packets.PubackSuccess,
+		wantSent:   true,
+	}, {
+		name:       "malformed topic",
+		topic:      "ns/name/extra",
+		properties: ceProperties,
+		wantReason: packets.PubackTopicNameInvalid,
+	}, {
+		name:       "broker not found",
+		topic:      "ns/other",
+		properties: ceProperties,
+		wantReason: packets.PubackTopicNameInvalid,
+	}, {
+		name:       "not a cloudevent",
+		topic:      "ns/name",
+		wantReason: packets.PubackPayloadFormatInvalid,
+	}}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := reconcilertesting.SetupFakeContext(t, SetUpInformerSelector)
+
+			sent := make(chan struct{}, 1)
+			s := httptest.NewServer(nethttp.HandlerFunc(func(writer nethttp.ResponseWriter, request *nethttp.Request) {
+				sent <- struct{}{}
+				writer.WriteHeader(senderResponseStatusCode)
+			}))
+			defer s.Close()
+
+			b := makeBroker("name", "ns")
+			b.Status.Annotations[eventing.BrokerChannelAddressStatusAnnotationKey] = s.URL
+			brokerinformerfake.Get(ctx).Informer().GetStore().Add(b)
+
+			h := newMQTTTestHandler(t, ctx, tc.features)
+			client := connectMQTT(t, ctx, h)
+
+			payload := []byte(`{"specversion":"1.0","id":"1234","source":"source","type":"type"}`)
+			writeTestPacket(t, client, packets.PUBLISH, &packets.Publish{
+				PacketID:   1,
+				QoS:        1,
+				Topic:      tc.topic,
+				Payload:    payload,
+				Properties: &packets.Properties{User: tc.properties},
+			})
+
+			puback := readTestPacket(t, client, packets.PUBACK).Content.(*packets.Puback)
+			if puback.PacketID != 1 {
+				t.Errorf("PUBACK packet ID = %d, want 1", puback.PacketID)
+			}
+			if puback.ReasonCode != tc.wantReason {
+				t.Errorf("PUBACK reason code = %#x, want %#x", puback.ReasonCode, tc.wantReason)
+			}
+
+			select {
+			case <-sent:
+				if !tc.wantSent {
+					t.Error("Event sent to the channel, want dropped")
+				}
+			default:
+				if tc.wantSent {
+					t.Error("Event not sent to the channel")
+				}
+			}
+		})
+	}
+}
+
+func TestMQTTServerControlPackets(t *testing.T) {
+	ctx, _ := reconcilertesting.SetupFakeContext(t, SetUpInformerSelector)
+	client := connectMQTT(t, ctx, newMQTTTestHandler(t, ctx, nil))
+
+	writeTestPacket(t, client, packets.PINGREQ, &packets.Pingreq{})
+	readTestPacket(t, client, packets.PINGRESP)
+
+	writeTestPacket(t, client, packets.SUBSCRIBE, &packets.Subscribe{
+		PacketID:      2,
+		Subscriptions: []packets.SubOptions{{Topic: "ns/name"}},
+		Properties:    &packets.Properties{},
+	})
+	suback := readTestPacket(t, client, packets.SUBACK).Content.(*packets.Suback)
+	if len(suback.Reasons) != 1 || suback.Reasons[0] < 0x80 {
+		t.Errorf("SUBACK reasons = %v, want a failure", suback.Reasons)
+	}
+
+	writeTestPacket(t, client, packets.PUBLISH, &packets.Publish{
+		PacketID:   3,
+		QoS:        2,
+		Topic:      "ns/name",
+		Payload:    []byte("{}"),
+		Properties: &packets.Properties{},
+	})
+	disconnect := readTestPacket(t, client, packets.DISCONNECT).Content.(*packets.Disconnect)
+	if disconnect.ReasonCode != packets.DisconnectQoSNotSupported {
+		t.Errorf("DISCONNECT reason code = %#x, want %#x", disconnect.ReasonCode, packets.DisconnectQoSNotSupported)
+	}
+}
+
+func TestMQTTServerUnsupportedProtocolVersion(t *testing.T) {
+	ctx, _ := reconcilertesting.SetupFakeContext(t, SetUpInformerSelector)
+	h := newMQTTTestHandler(t, ctx, nil)
+
+	client, server := net.Pipe()
+	t.Cleanup(func() { client.Close() })
+	go newMQTTServer(h, 0, nil, testMQTTMaxPacketSize, testMQTTMaxConnections).serveConn(ctx, server)
+
+	connect := packets.NewControlPacket(packets.CONNECT)
+	connect.Content.(*packets.Connect).ProtocolVersion = 4
+	connect.Content.(*packets.Connect).ClientID = "device"
+	if _, err := connect.WriteTo(client); err != nil {
+		t.Fatal("Failed to write CONNECT:", err)
+	}
+
+	connack := readTestPacket(t, client, packets.CONNACK).Content.(*packets.Connack)
+	if connack.ReasonCode != packets.ConnackUnsupportedProtocolVersion {
+		t.Errorf("CONNACK reason code = %#x, want %#x", connack.ReasonCode, packets.ConnackUnsupportedProtocolVersion)
+	}
+}
+
+func TestMQTTServerRefusesPlaintext(t *testing.T) {
+	tt := map[string]feature.Flags{
+		"strict transport encryption": {
+			feature.TransportEncryption: feature.Strict,
+		},
+		"permissive transport encryption with OIDC": {
+			feature.TransportEncryption: feature.Permissive,
+			feature.OIDCAuthentication:  feature.Enabled,
+		},
+	}
+	for name, features := range tt {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := reconcilertesting.SetupFakeContext(t, SetUpInformerSelector)
+			h := newMQTTTestHandler(t, ctx, features)
+
+			client, server := net.Pipe()
+			t.Cleanup(func() { client.Close() })
+			go newMQTTServer(h, 0, nil, testMQTTMaxPacketSize, testMQTTMaxConnections).serveConn(ctx, server)
+
+			go func() {
+				_ = writePacket(client, packets.CONNECT, &packets.Connect{
+					ProtocolName:    "MQTT",
+					ProtocolVersion: 5,
+					ClientID:        "device",
+					Password:        []byte("token"),
+					Properties:      &packets.Properties{},
+				})
+			}()
+
+			_ = client.SetReadDeadline(time.Now().Add(5 * time.Second))
+			if cp, err := packets.ReadPacket(client); err == nil {
+				t.Errorf("Read %s packet, want the connection to be closed", cp.PacketType())
+			}
+		})
+	}
+}
+
+func TestMQTTServerTLS(t *testing.T) {
+	ctx, _ := reconcilertesting.SetupFakeContext(t, SetUpInformerSelector)
+	h := newMQTTTestHandler(t, ctx, feature.Flags{
+		feature.TransportEncryption: feature.Strict,
+	})
+
+	// Reuse the self-signed certificate of the httptest package.
+	tlsServer := httptest.NewTLSServer(nil)
+	tlsServer.Close()
+	serverTLSConfig := &tls.Config{Certificates: tlsServer.TLS.Certificates}
+
+	conn, server := net.Pipe()
+	t.Cleanup(func() { conn.Close() })
+	go newMQTTServer(h, 0, serverTLSConfig, testMQTTMaxPacketSize, testMQTTMaxConnections).serveConn(ctx, server)
+
+	client := tls.Client(conn, &tls.Config{InsecureSkipVerify: true}) //nolint:gosec // Self-signed test certificate.
+	writeTestPacket(t, client, packets.CONNECT, &packets.Connect{
+		ProtocolName:    "MQTT",
+		ProtocolVersion: 5,
+		ClientID:        "device",
+		Properties:      &packets.Properties{},
+	})
+	connack := readTestPacket(t, client, packets.CONNACK).Content.(*packets.Connack)
+	if connack.ReasonCode != packets.ConnackSuccess {
+		t.Errorf("CONNACK reason code = %#x, want success", connack.ReasonCode)
+	}
+}
+
+func TestMQTTServerPacketTooLarge(t *testing.T) {
+	// fixed headers announcing a remaining length of about 256 MB
+	connectHeader := []byte{packets.CONNECT << 4, 0xff, 0xff, 0xff, 0x7f}
+	publishHeader := []byte{packets.PUBLISH << 4, 0xff, 0xff, 0xff, 0x7f}
+
+	t.Run("CONNECT", func(t *testing.T) {
+		ctx, _ := reconcilertesting.SetupFakeContext(t, SetUpInformerSelector)
+		h := newMQTTTestHandler(t, ctx, nil)
+
+		client, server := net.Pipe()
+		t.Cleanup(func() { client.Close() })
+		go newMQTTServer(h, 0, nil, testMQTTMaxPacketSize, testMQTTMaxConnections).serveConn(ctx, server)
+
+		_ = client.SetWriteDeadline(time.Now().Add(5 * time.Second))
+		if _, err := client.Write(connectHeader); err != nil {
+			t.Fatal("Failed to write CONNECT header:", err)
+		}
+		connack := readTestPacket(t, client, packets.CONNACK).Content.(*packets.Connack)
+		if connack.ReasonCode != packets.ConnackPacketTooLarge {
+			t.Errorf("CONNACK reason code = %#x, want %#x", connack.ReasonCode, packets.ConnackPacketTooLarge)
+		}
+	})
+
+	t.Run("PUBLISH", func(t *testing.T) {
+		ctx, _ := reconcilertesting.SetupFakeContext(t, SetUpInformerSelector)
+		client := connectMQTT(t, ctx, newMQTTTestHandler(t, ctx, nil))
+
+		_ = client.SetWriteDeadline(time.Now().Add(5 * time.Second))
+		if _, err := client.Write(publishHeader); err != nil {
+			t.Fatal("Failed to write PUBLISH header:", err)
+		}
+		disconnect := readTestPacket(t, client, packets.DISCONNECT).Content.(*packets.Disconnect)
+		if disconnect.ReasonCode != packets.DisconnectPacketTooLarge {
+			t.Errorf("DISCONNECT reason code = %#x, want %#x", disconnect.ReasonCode, packets.DisconnectPacketTooLarge)
+		}
+	})
+}
+
+func TestMQTTServerRefusesUnauthenticatedClients(t *testing.T) {
+	tt := map[string][]byte{
+		"no token":      nil,
+		"invalid token": []byte("invalid-token"),
+	}
+	for name, token := range tt {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := reconcilertesting.SetupFakeContext(t, SetUpInformerSelector)
+			h := newMQTTTestHandler(t, ctx, feature.Flags{
+				feature.OIDCAuthentication: feature.Enabled,
+			})
+
+			client, server := net.Pipe()
+			t.Cleanup(func() { client.Close() })
+			go newMQTTServer(h, 0, nil, testMQTTMaxPacketSize, testMQTTMaxConnections).serveConn(ctx, server)
+
+			writeTestPacket(t, client, packets.CONNECT, &packets.Connect{
+				ProtocolName:    "MQTT",
+				ProtocolVersion: 5,
+				ClientID:        "device",
+				PasswordFlag:    token != nil,
+				Password:        token,
+				Properties:      &packets.Properties{},
+			})
+			connack := readTestPacket(t, client, packets.CONNACK).Content.(*packets.Connack)
+			if connack.ReasonCode != packets.ConnackNotAuthorized {
+				t.Errorf("CONNACK reason code = %#x, want %#x", connack.ReasonCode, packets.ConnackNotAuthorized)
+			}
+		})
+	}
+}
+
+func TestMQTTServerMaxConnections(t *testing.T) {
+	ctx, _ := reconcilertesting.SetupFakeContext(t, SetUpInformerSelector)
+	h := newMQTTTestHandler(t, ctx, nil)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Failed to listen:", err)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+	go func() { _ = newMQTTServer(h, 0, nil, testMQTTMaxPacketSize, 1).serve(ctx, ln) }()
+
+	dial := func() net.Conn {
+		conn, err := net.Dial("tcp", ln.Addr().String())
+		if err != nil {
+			t.Fatal("Failed to connect:", err)
+		}
+		t.Cleanup(func() { conn.Close() })
+		return conn
+	}
+	connect := &packets.Connect{
+		ProtocolName:    "MQTT",
+		ProtocolVersion: 5,
+		ClientID:        "device",
+		Properties:      &packets.Properties{},
+	}
+
+	first := dial()
+	writeTestPacket(t, first, packets.CONNECT, connect)
+	readTestPacket(t, first, packets.CONNACK)
+
+	second := dial()
+	_ = second.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, err := second.Read(make([]byte, 1)); err == nil {
+		t.Error("Read from the connection exceeding the maximum connections, want the connection to be closed")
+	}
+}
+
+func TestPubackReasonCode(t *testing.T) {
+	tt := map[int]byte{
+		nethttp.StatusAccepted:            packets.PubackSuccess,
+		nethttp.StatusBadRequest:          packets.PubackPayloadFormatInvalid,
+		nethttp.StatusUnauthorized:        packets.PubackNotAuthorized,
+		nethttp.StatusForbidden:           packets.PubackNotAuthorized,
+		nethttp.StatusNotFound:            packets.PubackTopicNameInvalid,
+		nethttp.StatusTooManyRequests:     packets.PubackQuotaExceeded,
+		nethttp.StatusInternalServerError: packets.PubackUnspecifiedError,
+	}
+	for statusCode, want := range tt {
+		if got := pubackReasonCode(statusCode); got != want {
+			t.Errorf("pubackReasonCode(%d) = %#x, want %#x", statusCode, got, want)
+		}
+	}
+}
+
+func newMQTTTestHandler(t *testing.T, ctx context.Context, features feature.Flags) *Handler {
+	t.Helper()
+
+	logger := zap.NewNop()
+	trustBundleConfigMapLister := filteredconfigmapinformer.Get(ctx, eventingtls.TrustBundleLabelSelector).Lister().ConfigMaps(system.Namespace())
+	authVerifier := auth.NewVerifier(ctx, eventpolicyinformerfake.Get(ctx).Lister(), clustereventpolicyinformerfake.Get(ctx).Lister(), trustBundleConfigMapLister, configmap.NewStaticWatcher(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "config-features",
+				Namespace: "knative-eventing",
+			},
+		},
+	))
+
+	h, err := NewHandler(logger,
+		broker.TTLDefaulter(logger, 100),
+		brokerinformerfake.Get(ctx),
+		authVerifier,
+		auth.NewOIDCTokenProvider(ctx),
+		trustBundleConfigMapLister,
+		func(ctx context.Context) context.Context {
+			if features != nil {
+				return feature.ToContext(ctx, features)
+			}
+			return ctx
+		},
+		metric.NewMeterProvider(),
+		trace.NewTracerProvider(),
+	)
+	if err != nil {
+		t.Fatal("Unable to create handler:", err)
+	}
+	return h
+}
+
+// connectMQTT connects a client to an MQTT server of h, and returns the
+// connection of the client.
+func connectMQTT(t *testing.T, ctx context.Context, h *Handler) net.Conn {
+	t.Helper()
+
+	client, server := net.Pipe()
+	t.Cleanup(func() { client.Close() })
+	go newMQTTServer(h, 0, nil, testMQTTMaxPacketSize, testMQTTMaxConnections).serveConn(ctx, server)
+
+	writeTestPacket(t, client, packets.CONNECT, &packets.Connect{
+		ProtocolName:    "MQTT",
+		ProtocolVersion: 5,
+		ClientID:        "device",
+		Properties:      &packets.Properties{},
+	})
+	connack := readTestPacket(t, client, packets.CONNACK).Content.(*packets.Connack)
+	if connack.ReasonCode != packets.ConnackSuccess {
+		t.Fatalf("CONNACK reason code = %#x, want success", connack.ReasonCode)
+	}
+	if got := connack.Properties.MaximumPacketSize; got == nil || *got != testMQTTMaxPacketSize {
+		t.Fatalf("CONNACK maximum packet size = %v, want %d", got, testMQTTMaxPacketSize)
+	}
+	return client
+}
+
+func readTestPacket(t *testing.T, conn net.Conn, want byte) *packets.ControlPacket {
+	t.Helper()
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	cp, err := packets.ReadPacket(conn)
+	if err != nil {
+		t.Fatal("Failed to read packet:", err)
+	}
+	if cp.Type != want {
+		t.Fatalf("Read %s packet, want type %d", cp.PacketType(), want)
+	}
+	return cp
+}
+
+func writeTestPacket(t *testing.T, conn net.Conn, packetType byte, content packets.Packet) {
+	t.Helper()
+	_ = conn.SetWriteDeadline(time.Now().Add(5 * time.Second))
+	if err := writePacket(conn, packetType, content); err != nil {
+		t.Fatal("Failed to write packet:", err)
+	}
+}
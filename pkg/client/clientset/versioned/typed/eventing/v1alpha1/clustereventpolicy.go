@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+	eventingv1alpha1 "knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+	scheme "knative.dev/eventing/pkg/client/clientset/versioned/scheme"
+)
+
+// ClusterEventPoliciesGetter has a method to return a ClusterEventPolicyInterface.
+// A group's client should implement this interface.
+type ClusterEventPoliciesGetter interface {
+	ClusterEventPolicies() ClusterEventPolicyInterface
+}
+
+// ClusterEventPolicyInterface has methods to work with ClusterEventPolicy resources.
+type ClusterEventPolicyInterface interface {
+	Create(ctx context.Context, clusterEventPolicy *eventingv1alpha1.ClusterEventPolicy, opts v1.CreateOptions) (*eventingv1alpha1.ClusterEventPolicy, error)
+	Update(ctx context.Context, clusterEventPolicy *eventingv1alpha1.ClusterEventPolicy, opts v1.UpdateOptions) (*eventingv1alpha1.ClusterEventPolicy, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, clusterEventPolicy *eventingv1alpha1.ClusterEventPolicy, opts v1.UpdateOptions) (*eventingv1alpha1.ClusterEventPolicy, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*eventingv1alpha1.ClusterEventPolicy, error)
+	List(ctx context.Context, opts v1.ListOptions) (*eventingv1alpha1.ClusterEventPolicyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *eventingv1alpha1.ClusterEventPolicy, err error)
+	ClusterEventPolicyExpansion
+}
+
+// clusterEventPolicies implements ClusterEventPolicyInterface
+type clusterEventPolicies struct {
+	*gentype.ClientWithList[*eventingv1alpha1.ClusterEventPolicy, *eventingv1alpha1.ClusterEventPolicyList]
+}
+
+// newClusterEventPolicies returns a ClusterEventPolicies
+func newClusterEventPolicies(c *EventingV1alpha1Client) *clusterEventPolicies {
+	return &clusterEventPolicies{
+		gentype.NewClientWithList[*eventingv1alpha1.ClusterEventPolicy, *eventingv1alpha1.ClusterEventPolicyList](
+			"clustereventpolicies",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *eventingv1alpha1.ClusterEventPolicy { return &eventingv1alpha1.ClusterEventPolicy{} },
+			func() *eventingv1alpha1.ClusterEventPolicyList { return &eventingv1alpha1.ClusterEventPolicyList{} },
+		),
+	}
+}
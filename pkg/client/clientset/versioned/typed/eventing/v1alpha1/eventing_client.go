@@ -28,6 +28,7 @@ import (
 
 type EventingV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	ClusterEventPoliciesGetter
 	EventPoliciesGetter
 	EventTransformsGetter
 	RequestRepliesGetter
@@ -38,6 +39,10 @@ type EventingV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *EventingV1alpha1Client) ClusterEventPolicies() ClusterEventPolicyInterface {
+	return newClusterEventPolicies(c)
+}
+
 func (c *EventingV1alpha1Client) EventPolicies(namespace string) EventPolicyInterface {
 	return newEventPolicies(c, namespace)
 }
@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	gentype "k8s.io/client-go/gentype"
+	v1alpha1 "knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+	eventingv1alpha1 "knative.dev/eventing/pkg/client/clientset/versioned/typed/eventing/v1alpha1"
+)
+
+// fakeClusterEventPolicies implements ClusterEventPolicyInterface
+type fakeClusterEventPolicies struct {
+	*gentype.FakeClientWithList[*v1alpha1.ClusterEventPolicy, *v1alpha1.ClusterEventPolicyList]
+	Fake *FakeEventingV1alpha1
+}
+
+func newFakeClusterEventPolicies(fake *FakeEventingV1alpha1) eventingv1alpha1.ClusterEventPolicyInterface {
+	return &fakeClusterEventPolicies{
+		gentype.NewFakeClientWithList[*v1alpha1.ClusterEventPolicy, *v1alpha1.ClusterEventPolicyList](
+			fake.Fake,
+			"",
+			v1alpha1.SchemeGroupVersion.WithResource("clustereventpolicies"),
+			v1alpha1.SchemeGroupVersion.WithKind("ClusterEventPolicy"),
+			func() *v1alpha1.ClusterEventPolicy { return &v1alpha1.ClusterEventPolicy{} },
+			func() *v1alpha1.ClusterEventPolicyList { return &v1alpha1.ClusterEventPolicyList{} },
+			func(dst, src *v1alpha1.ClusterEventPolicyList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.ClusterEventPolicyList) []*v1alpha1.ClusterEventPolicy {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.ClusterEventPolicyList, items []*v1alpha1.ClusterEventPolicy) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -28,6 +28,10 @@ type FakeEventingV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeEventingV1alpha1) ClusterEventPolicies() v1alpha1.ClusterEventPolicyInterface {
+	return newFakeClusterEventPolicies(c)
+}
+
 func (c *FakeEventingV1alpha1) EventPolicies(namespace string) v1alpha1.EventPolicyInterface {
 	return newFakeEventPolicies(c, namespace)
 }
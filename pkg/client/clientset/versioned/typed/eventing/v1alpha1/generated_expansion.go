@@ -18,6 +18,8 @@ limitations under the License.
 
 package v1alpha1
 
+type ClusterEventPolicyExpansion interface{}
+
 type EventPolicyExpansion interface{}
 
 type EventTransformExpansion interface{}
@@ -0,0 +1,50 @@
+/*
+Copyright 2021 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	gentype "k8s.io/client-go/gentype"
+	v1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	sinksv1alpha1 "knative.dev/eventing/pkg/client/clientset/versioned/typed/sinks/v1alpha1"
+)
+
+// fakePullSinks implements PullSinkInterface
+type fakePullSinks struct {
+	*gentype.FakeClientWithList[*v1alpha1.PullSink, *v1alpha1.PullSinkList]
+	Fake *FakeSinksV1alpha1
+}
+
+func newFakePullSinks(fake *FakeSinksV1alpha1, namespace string) sinksv1alpha1.PullSinkInterface {
+	return &fakePullSinks{
+		gentype.NewFakeClientWithList[*v1alpha1.PullSink, *v1alpha1.PullSinkList](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("pullsinks"),
+			v1alpha1.SchemeGroupVersion.WithKind("PullSink"),
+			func() *v1alpha1.PullSink { return &v1alpha1.PullSink{} },
+			func() *v1alpha1.PullSinkList { return &v1alpha1.PullSinkList{} },
+			func(dst, src *v1alpha1.PullSinkList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.PullSinkList) []*v1alpha1.PullSink { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1alpha1.PullSinkList, items []*v1alpha1.PullSink) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -36,6 +36,14 @@ func (c *FakeSinksV1alpha1) JobSinks(namespace string) v1alpha1.JobSinkInterface
 	return newFakeJobSinks(c, namespace)
 }
 
+func (c *FakeSinksV1alpha1) PullSinks(namespace string) v1alpha1.PullSinkInterface {
+	return newFakePullSinks(c, namespace)
+}
+
+func (c *FakeSinksV1alpha1) StreamSinks(namespace string) v1alpha1.StreamSinkInterface {
+	return newFakeStreamSinks(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeSinksV1alpha1) RESTClient() rest.Interface {
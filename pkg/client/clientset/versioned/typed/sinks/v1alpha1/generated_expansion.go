@@ -21,3 +21,7 @@ package v1alpha1
 type IntegrationSinkExpansion interface{}
 
 type JobSinkExpansion interface{}
+
+type PullSinkExpansion interface{}
+
+type StreamSinkExpansion interface{}
@@ -30,6 +30,8 @@ type SinksV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	IntegrationSinksGetter
 	JobSinksGetter
+	PullSinksGetter
+	StreamSinksGetter
 }
 
 // SinksV1alpha1Client is used to interact with features provided by the sinks.knative.dev group.
@@ -45,6 +47,14 @@ func (c *SinksV1alpha1Client) JobSinks(namespace string) JobSinkInterface {
 	return newJobSinks(c, namespace)
 }
 
+func (c *SinksV1alpha1Client) PullSinks(namespace string) PullSinkInterface {
+	return newPullSinks(c, namespace)
+}
+
+func (c *SinksV1alpha1Client) StreamSinks(namespace string) StreamSinkInterface {
+	return newStreamSinks(c, namespace)
+}
+
 // NewForConfig creates a new SinksV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	scheme "knative.dev/eventing/pkg/client/clientset/versioned/scheme"
+)
+
+// StreamSinksGetter has a method to return a StreamSinkInterface.
+// A group's client should implement this interface.
+type StreamSinksGetter interface {
+	StreamSinks(namespace string) StreamSinkInterface
+}
+
+// StreamSinkInterface has methods to work with StreamSink resources.
+type StreamSinkInterface interface {
+	Create(ctx context.Context, streamSink *sinksv1alpha1.StreamSink, opts v1.CreateOptions) (*sinksv1alpha1.StreamSink, error)
+	Update(ctx context.Context, streamSink *sinksv1alpha1.StreamSink, opts v1.UpdateOptions) (*sinksv1alpha1.StreamSink, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, streamSink *sinksv1alpha1.StreamSink, opts v1.UpdateOptions) (*sinksv1alpha1.StreamSink, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*sinksv1alpha1.StreamSink, error)
+	List(ctx context.Context, opts v1.ListOptions) (*sinksv1alpha1.StreamSinkList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sinksv1alpha1.StreamSink, err error)
+	StreamSinkExpansion
+}
+
+// streamSinks implements StreamSinkInterface
+type streamSinks struct {
+	*gentype.ClientWithList[*sinksv1alpha1.StreamSink, *sinksv1alpha1.StreamSinkList]
+}
+
+// newStreamSinks returns a StreamSinks
+func newStreamSinks(c *SinksV1alpha1Client, namespace string) *streamSinks {
+	return &streamSinks{
+		gentype.NewClientWithList[*sinksv1alpha1.StreamSink, *sinksv1alpha1.StreamSinkList](
+			"streamsinks",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *sinksv1alpha1.StreamSink { return &sinksv1alpha1.StreamSink{} },
+			func() *sinksv1alpha1.StreamSinkList { return &sinksv1alpha1.StreamSinkList{} },
+		),
+	}
+}
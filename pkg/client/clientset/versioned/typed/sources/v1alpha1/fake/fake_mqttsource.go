@@ -0,0 +1,50 @@
+/*
+Copyright 2021 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	gentype "k8s.io/client-go/gentype"
+	v1alpha1 "knative.dev/eventing/pkg/apis/sources/v1alpha1"
+	sourcesv1alpha1 "knative.dev/eventing/pkg/client/clientset/versioned/typed/sources/v1alpha1"
+)
+
+// fakeMQTTSources implements MQTTSourceInterface
+type fakeMQTTSources struct {
+	*gentype.FakeClientWithList[*v1alpha1.MQTTSource, *v1alpha1.MQTTSourceList]
+	Fake *FakeSourcesV1alpha1
+}
+
+func newFakeMQTTSources(fake *FakeSourcesV1alpha1, namespace string) sourcesv1alpha1.MQTTSourceInterface {
+	return &fakeMQTTSources{
+		gentype.NewFakeClientWithList[*v1alpha1.MQTTSource, *v1alpha1.MQTTSourceList](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("mqttsources"),
+			v1alpha1.SchemeGroupVersion.WithKind("MQTTSource"),
+			func() *v1alpha1.MQTTSource { return &v1alpha1.MQTTSource{} },
+			func() *v1alpha1.MQTTSourceList { return &v1alpha1.MQTTSourceList{} },
+			func(dst, src *v1alpha1.MQTTSourceList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.MQTTSourceList) []*v1alpha1.MQTTSource { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1alpha1.MQTTSourceList, items []*v1alpha1.MQTTSource) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -32,6 +32,14 @@ func (c *FakeSourcesV1alpha1) IntegrationSources(namespace string) v1alpha1.Inte
 	return newFakeIntegrationSources(c, namespace)
 }
 
+func (c *FakeSourcesV1alpha1) MQTTSources(namespace string) v1alpha1.MQTTSourceInterface {
+	return newFakeMQTTSources(c, namespace)
+}
+
+func (c *FakeSourcesV1alpha1) WebSocketSources(namespace string) v1alpha1.WebSocketSourceInterface {
+	return newFakeWebSocketSources(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeSourcesV1alpha1) RESTClient() rest.Interface {
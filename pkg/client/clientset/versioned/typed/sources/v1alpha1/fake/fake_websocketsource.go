@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	gentype "k8s.io/client-go/gentype"
+	v1alpha1 "knative.dev/eventing/pkg/apis/sources/v1alpha1"
+	sourcesv1alpha1 "knative.dev/eventing/pkg/client/clientset/versioned/typed/sources/v1alpha1"
+)
+
+// fakeWebSocketSources implements WebSocketSourceInterface
+type fakeWebSocketSources struct {
+	*gentype.FakeClientWithList[*v1alpha1.WebSocketSource, *v1alpha1.WebSocketSourceList]
+	Fake *FakeSourcesV1alpha1
+}
+
+func newFakeWebSocketSources(fake *FakeSourcesV1alpha1, namespace string) sourcesv1alpha1.WebSocketSourceInterface {
+	return &fakeWebSocketSources{
+		gentype.NewFakeClientWithList[*v1alpha1.WebSocketSource, *v1alpha1.WebSocketSourceList](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("websocketsources"),
+			v1alpha1.SchemeGroupVersion.WithKind("WebSocketSource"),
+			func() *v1alpha1.WebSocketSource { return &v1alpha1.WebSocketSource{} },
+			func() *v1alpha1.WebSocketSourceList { return &v1alpha1.WebSocketSourceList{} },
+			func(dst, src *v1alpha1.WebSocketSourceList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.WebSocketSourceList) []*v1alpha1.WebSocketSource {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.WebSocketSourceList, items []*v1alpha1.WebSocketSource) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
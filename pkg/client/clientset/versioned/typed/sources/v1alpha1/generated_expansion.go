@@ -19,3 +19,7 @@ limitations under the License.
 package v1alpha1
 
 type IntegrationSourceExpansion interface{}
+
+type MQTTSourceExpansion interface{}
+
+type WebSocketSourceExpansion interface{}
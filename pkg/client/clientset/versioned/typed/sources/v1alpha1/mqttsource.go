@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+	sourcesv1alpha1 "knative.dev/eventing/pkg/apis/sources/v1alpha1"
+	scheme "knative.dev/eventing/pkg/client/clientset/versioned/scheme"
+)
+
+// MQTTSourcesGetter has a method to return a MQTTSourceInterface.
+// A group's client should implement this interface.
+type MQTTSourcesGetter interface {
+	MQTTSources(namespace string) MQTTSourceInterface
+}
+
+// MQTTSourceInterface has methods to work with MQTTSource resources.
+type MQTTSourceInterface interface {
+	Create(ctx context.Context, mQTTSource *sourcesv1alpha1.MQTTSource, opts v1.CreateOptions) (*sourcesv1alpha1.MQTTSource, error)
+	Update(ctx context.Context, mQTTSource *sourcesv1alpha1.MQTTSource, opts v1.UpdateOptions) (*sourcesv1alpha1.MQTTSource, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, mQTTSource *sourcesv1alpha1.MQTTSource, opts v1.UpdateOptions) (*sourcesv1alpha1.MQTTSource, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.MQTTSource, error)
+	List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.MQTTSourceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.MQTTSource, err error)
+	MQTTSourceExpansion
+}
+
+// mQTTSources implements MQTTSourceInterface
+type mQTTSources struct {
+	*gentype.ClientWithList[*sourcesv1alpha1.MQTTSource, *sourcesv1alpha1.MQTTSourceList]
+}
+
+// newMQTTSources returns a MQTTSources
+func newMQTTSources(c *SourcesV1alpha1Client, namespace string) *mQTTSources {
+	return &mQTTSources{
+		gentype.NewClientWithList[*sourcesv1alpha1.MQTTSource, *sourcesv1alpha1.MQTTSourceList](
+			"mqttsources",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *sourcesv1alpha1.MQTTSource { return &sourcesv1alpha1.MQTTSource{} },
+			func() *sourcesv1alpha1.MQTTSourceList { return &sourcesv1alpha1.MQTTSourceList{} },
+		),
+	}
+}
@@ -29,6 +29,8 @@ import (
 type SourcesV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	IntegrationSourcesGetter
+	MQTTSourcesGetter
+	WebSocketSourcesGetter
 }
 
 // SourcesV1alpha1Client is used to interact with features provided by the sources.knative.dev group.
@@ -40,6 +42,14 @@ func (c *SourcesV1alpha1Client) IntegrationSources(namespace string) Integration
 	return newIntegrationSources(c, namespace)
 }
 
+func (c *SourcesV1alpha1Client) MQTTSources(namespace string) MQTTSourceInterface {
+	return newMQTTSources(c, namespace)
+}
+
+func (c *SourcesV1alpha1Client) WebSocketSources(namespace string) WebSocketSourceInterface {
+	return newWebSocketSources(c, namespace)
+}
+
 // NewForConfig creates a new SourcesV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+	sourcesv1alpha1 "knative.dev/eventing/pkg/apis/sources/v1alpha1"
+	scheme "knative.dev/eventing/pkg/client/clientset/versioned/scheme"
+)
+
+// WebSocketSourcesGetter has a method to return a WebSocketSourceInterface.
+// A group's client should implement this interface.
+type WebSocketSourcesGetter interface {
+	WebSocketSources(namespace string) WebSocketSourceInterface
+}
+
+// WebSocketSourceInterface has methods to work with WebSocketSource resources.
+type WebSocketSourceInterface interface {
+	Create(ctx context.Context, webSocketSource *sourcesv1alpha1.WebSocketSource, opts v1.CreateOptions) (*sourcesv1alpha1.WebSocketSource, error)
+	Update(ctx context.Context, webSocketSource *sourcesv1alpha1.WebSocketSource, opts v1.UpdateOptions) (*sourcesv1alpha1.WebSocketSource, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, webSocketSource *sourcesv1alpha1.WebSocketSource, opts v1.UpdateOptions) (*sourcesv1alpha1.WebSocketSource, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.WebSocketSource, error)
+	List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.WebSocketSourceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.WebSocketSource, err error)
+	WebSocketSourceExpansion
+}
+
+// webSocketSources implements WebSocketSourceInterface
+type webSocketSources struct {
+	*gentype.ClientWithList[*sourcesv1alpha1.WebSocketSource, *sourcesv1alpha1.WebSocketSourceList]
+}
+
+// newWebSocketSources returns a WebSocketSources
+func newWebSocketSources(c *SourcesV1alpha1Client, namespace string) *webSocketSources {
+	return &webSocketSources{
+		gentype.NewClientWithList[*sourcesv1alpha1.WebSocketSource, *sourcesv1alpha1.WebSocketSourceList](
+			"websocketsources",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *sourcesv1alpha1.WebSocketSource { return &sourcesv1alpha1.WebSocketSource{} },
+			func() *sourcesv1alpha1.WebSocketSourceList { return &sourcesv1alpha1.WebSocketSourceList{} },
+		),
+	}
+}
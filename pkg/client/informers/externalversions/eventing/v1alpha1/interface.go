@@ -24,6 +24,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// ClusterEventPolicies returns a ClusterEventPolicyInformer.
+	ClusterEventPolicies() ClusterEventPolicyInformer
 	// EventPolicies returns a EventPolicyInformer.
 	EventPolicies() EventPolicyInformer
 	// EventTransforms returns a EventTransformInformer.
@@ -43,6 +45,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// ClusterEventPolicies returns a ClusterEventPolicyInformer.
+func (v *version) ClusterEventPolicies() ClusterEventPolicyInformer {
+	return &clusterEventPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // EventPolicies returns a EventPolicyInformer.
 func (v *version) EventPolicies() EventPolicyInformer {
 	return &eventPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -69,6 +69,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Eventing().V1().Triggers().Informer()}, nil
 
 		// Group=eventing.knative.dev, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithResource("clustereventpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Eventing().V1alpha1().ClusterEventPolicies().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("eventpolicies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Eventing().V1alpha1().EventPolicies().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("eventtransforms"):
@@ -107,6 +109,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Sinks().V1alpha1().IntegrationSinks().Informer()}, nil
 	case sinksv1alpha1.SchemeGroupVersion.WithResource("jobsinks"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Sinks().V1alpha1().JobSinks().Informer()}, nil
+	case sinksv1alpha1.SchemeGroupVersion.WithResource("pullsinks"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sinks().V1alpha1().PullSinks().Informer()}, nil
+	case sinksv1alpha1.SchemeGroupVersion.WithResource("streamsinks"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sinks().V1alpha1().StreamSinks().Informer()}, nil
 
 		// Group=sources.knative.dev, Version=v1
 	case sourcesv1.SchemeGroupVersion.WithResource("apiserversources"):
@@ -121,6 +127,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		// Group=sources.knative.dev, Version=v1alpha1
 	case sourcesv1alpha1.SchemeGroupVersion.WithResource("integrationsources"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1alpha1().IntegrationSources().Informer()}, nil
+	case sourcesv1alpha1.SchemeGroupVersion.WithResource("mqttsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1alpha1().MQTTSources().Informer()}, nil
+	case sourcesv1alpha1.SchemeGroupVersion.WithResource("websocketsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1alpha1().WebSocketSources().Informer()}, nil
 
 		// Group=sources.knative.dev, Version=v1beta2
 	case sourcesv1beta2.SchemeGroupVersion.WithResource("pingsources"):
@@ -28,6 +28,10 @@ type Interface interface {
 	IntegrationSinks() IntegrationSinkInformer
 	// JobSinks returns a JobSinkInformer.
 	JobSinks() JobSinkInformer
+	// PullSinks returns a PullSinkInformer.
+	PullSinks() PullSinkInformer
+	// StreamSinks returns a StreamSinkInformer.
+	StreamSinks() StreamSinkInformer
 }
 
 type version struct {
@@ -50,3 +54,13 @@ func (v *version) IntegrationSinks() IntegrationSinkInformer {
 func (v *version) JobSinks() JobSinkInformer {
 	return &jobSinkInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// PullSinks returns a PullSinkInformer.
+func (v *version) PullSinks() PullSinkInformer {
+	return &pullSinkInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// StreamSinks returns a StreamSinkInformer.
+func (v *version) StreamSinks() StreamSinkInformer {
+	return &streamSinkInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -26,6 +26,10 @@ import (
 type Interface interface {
 	// IntegrationSources returns a IntegrationSourceInformer.
 	IntegrationSources() IntegrationSourceInformer
+	// MQTTSources returns a MQTTSourceInformer.
+	MQTTSources() MQTTSourceInformer
+	// WebSocketSources returns a WebSocketSourceInformer.
+	WebSocketSources() WebSocketSourceInformer
 }
 
 type version struct {
@@ -43,3 +47,13 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 func (v *version) IntegrationSources() IntegrationSourceInformer {
 	return &integrationSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// MQTTSources returns a MQTTSourceInformer.
+func (v *version) MQTTSources() MQTTSourceInformer {
+	return &mQTTSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// WebSocketSources returns a WebSocketSourceInformer.
+func (v *version) WebSocketSources() WebSocketSourceInformer {
+	return &webSocketSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2021 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+	eventingv1alpha1 "knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+)
+
+// ClusterEventPolicyLister helps list ClusterEventPolicies.
+// All objects returned here must be treated as read-only.
+type ClusterEventPolicyLister interface {
+	// List lists all ClusterEventPolicies in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*eventingv1alpha1.ClusterEventPolicy, err error)
+	// Get retrieves the ClusterEventPolicy from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*eventingv1alpha1.ClusterEventPolicy, error)
+	ClusterEventPolicyListerExpansion
+}
+
+// clusterEventPolicyLister implements the ClusterEventPolicyLister interface.
+type clusterEventPolicyLister struct {
+	listers.ResourceIndexer[*eventingv1alpha1.ClusterEventPolicy]
+}
+
+// NewClusterEventPolicyLister returns a new ClusterEventPolicyLister.
+func NewClusterEventPolicyLister(indexer cache.Indexer) ClusterEventPolicyLister {
+	return &clusterEventPolicyLister{listers.New[*eventingv1alpha1.ClusterEventPolicy](indexer, eventingv1alpha1.Resource("clustereventpolicy"))}
+}
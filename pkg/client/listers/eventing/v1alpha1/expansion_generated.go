@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1alpha1
 
+// ClusterEventPolicyListerExpansion allows custom methods to be added to
+// ClusterEventPolicyLister.
+type ClusterEventPolicyListerExpansion interface{}
+
 // EventPolicyListerExpansion allows custom methods to be added to
 // EventPolicyLister.
 type EventPolicyListerExpansion interface{}
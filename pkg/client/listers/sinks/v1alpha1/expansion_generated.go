@@ -33,3 +33,19 @@ type JobSinkListerExpansion interface{}
 // JobSinkNamespaceListerExpansion allows custom methods to be added to
 // JobSinkNamespaceLister.
 type JobSinkNamespaceListerExpansion interface{}
+
+// PullSinkListerExpansion allows custom methods to be added to
+// PullSinkLister.
+type PullSinkListerExpansion interface{}
+
+// PullSinkNamespaceListerExpansion allows custom methods to be added to
+// PullSinkNamespaceLister.
+type PullSinkNamespaceListerExpansion interface{}
+
+// StreamSinkListerExpansion allows custom methods to be added to
+// StreamSinkLister.
+type StreamSinkListerExpansion interface{}
+
+// StreamSinkNamespaceListerExpansion allows custom methods to be added to
+// StreamSinkNamespaceLister.
+type StreamSinkNamespaceListerExpansion interface{}
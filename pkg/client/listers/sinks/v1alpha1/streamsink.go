@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+)
+
+// StreamSinkLister helps list StreamSinks.
+// All objects returned here must be treated as read-only.
+type StreamSinkLister interface {
+	// List lists all StreamSinks in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*sinksv1alpha1.StreamSink, err error)
+	// StreamSinks returns an object that can list and get StreamSinks.
+	StreamSinks(namespace string) StreamSinkNamespaceLister
+	StreamSinkListerExpansion
+}
+
+// streamSinkLister implements the StreamSinkLister interface.
+type streamSinkLister struct {
+	listers.ResourceIndexer[*sinksv1alpha1.StreamSink]
+}
+
+// NewStreamSinkLister returns a new StreamSinkLister.
+func NewStreamSinkLister(indexer cache.Indexer) StreamSinkLister {
+	return &streamSinkLister{listers.New[*sinksv1alpha1.StreamSink](indexer, sinksv1alpha1.Resource("streamsink"))}
+}
+
+// StreamSinks returns an object that can list and get StreamSinks.
+func (s *streamSinkLister) StreamSinks(namespace string) StreamSinkNamespaceLister {
+	return streamSinkNamespaceLister{listers.NewNamespaced[*sinksv1alpha1.StreamSink](s.ResourceIndexer, namespace)}
+}
+
+// StreamSinkNamespaceLister helps list and get StreamSinks.
+// All objects returned here must be treated as read-only.
+type StreamSinkNamespaceLister interface {
+	// List lists all StreamSinks in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*sinksv1alpha1.StreamSink, err error)
+	// Get retrieves the StreamSink from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*sinksv1alpha1.StreamSink, error)
+	StreamSinkNamespaceListerExpansion
+}
+
+// streamSinkNamespaceLister implements the StreamSinkNamespaceLister
+// interface.
+type streamSinkNamespaceLister struct {
+	listers.ResourceIndexer[*sinksv1alpha1.StreamSink]
+}
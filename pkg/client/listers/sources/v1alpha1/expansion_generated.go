@@ -25,3 +25,19 @@ type IntegrationSourceListerExpansion interface{}
 // IntegrationSourceNamespaceListerExpansion allows custom methods to be added to
 // IntegrationSourceNamespaceLister.
 type IntegrationSourceNamespaceListerExpansion interface{}
+
+// MQTTSourceListerExpansion allows custom methods to be added to
+// MQTTSourceLister.
+type MQTTSourceListerExpansion interface{}
+
+// MQTTSourceNamespaceListerExpansion allows custom methods to be added to
+// MQTTSourceNamespaceLister.
+type MQTTSourceNamespaceListerExpansion interface{}
+
+// WebSocketSourceListerExpansion allows custom methods to be added to
+// WebSocketSourceLister.
+type WebSocketSourceListerExpansion interface{}
+
+// WebSocketSourceNamespaceListerExpansion allows custom methods to be added to
+// WebSocketSourceNamespaceLister.
+type WebSocketSourceNamespaceListerExpansion interface{}
@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+	sourcesv1alpha1 "knative.dev/eventing/pkg/apis/sources/v1alpha1"
+)
+
+// MQTTSourceLister helps list MQTTSources.
+// All objects returned here must be treated as read-only.
+type MQTTSourceLister interface {
+	// List lists all MQTTSources in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*sourcesv1alpha1.MQTTSource, err error)
+	// MQTTSources returns an object that can list and get MQTTSources.
+	MQTTSources(namespace string) MQTTSourceNamespaceLister
+	MQTTSourceListerExpansion
+}
+
+// mQTTSourceLister implements the MQTTSourceLister interface.
+type mQTTSourceLister struct {
+	listers.ResourceIndexer[*sourcesv1alpha1.MQTTSource]
+}
+
+// NewMQTTSourceLister returns a new MQTTSourceLister.
+func NewMQTTSourceLister(indexer cache.Indexer) MQTTSourceLister {
+	return &mQTTSourceLister{listers.New[*sourcesv1alpha1.MQTTSource](indexer, sourcesv1alpha1.Resource("mqttsource"))}
+}
+
+// MQTTSources returns an object that can list and get MQTTSources.
+func (s *mQTTSourceLister) MQTTSources(namespace string) MQTTSourceNamespaceLister {
+	return mQTTSourceNamespaceLister{listers.NewNamespaced[*sourcesv1alpha1.MQTTSource](s.ResourceIndexer, namespace)}
+}
+
+// MQTTSourceNamespaceLister helps list and get MQTTSources.
+// All objects returned here must be treated as read-only.
+type MQTTSourceNamespaceLister interface {
+	// List lists all MQTTSources in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*sourcesv1alpha1.MQTTSource, err error)
+	// Get retrieves the MQTTSource from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*sourcesv1alpha1.MQTTSource, error)
+	MQTTSourceNamespaceListerExpansion
+}
+
+// mQTTSourceNamespaceLister implements the MQTTSourceNamespaceLister
+// interface.
+type mQTTSourceNamespaceLister struct {
+	listers.ResourceIndexer[*sourcesv1alpha1.MQTTSource]
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+	sourcesv1alpha1 "knative.dev/eventing/pkg/apis/sources/v1alpha1"
+)
+
+// WebSocketSourceLister helps list WebSocketSources.
+// All objects returned here must be treated as read-only.
+type WebSocketSourceLister interface {
+	// List lists all WebSocketSources in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*sourcesv1alpha1.WebSocketSource, err error)
+	// WebSocketSources returns an object that can list and get WebSocketSources.
+	WebSocketSources(namespace string) WebSocketSourceNamespaceLister
+	WebSocketSourceListerExpansion
+}
+
+// webSocketSourceLister implements the WebSocketSourceLister interface.
+type webSocketSourceLister struct {
+	listers.ResourceIndexer[*sourcesv1alpha1.WebSocketSource]
+}
+
+// NewWebSocketSourceLister returns a new WebSocketSourceLister.
+func NewWebSocketSourceLister(indexer cache.Indexer) WebSocketSourceLister {
+	return &webSocketSourceLister{listers.New[*sourcesv1alpha1.WebSocketSource](indexer, sourcesv1alpha1.Resource("websocketsource"))}
+}
+
+// WebSocketSources returns an object that can list and get WebSocketSources.
+func (s *webSocketSourceLister) WebSocketSources(namespace string) WebSocketSourceNamespaceLister {
+	return webSocketSourceNamespaceLister{listers.NewNamespaced[*sourcesv1alpha1.WebSocketSource](s.ResourceIndexer, namespace)}
+}
+
+// WebSocketSourceNamespaceLister helps list and get WebSocketSources.
+// All objects returned here must be treated as read-only.
+type WebSocketSourceNamespaceLister interface {
+	// List lists all WebSocketSources in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*sourcesv1alpha1.WebSocketSource, err error)
+	// Get retrieves the WebSocketSource from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*sourcesv1alpha1.WebSocketSource, error)
+	WebSocketSourceNamespaceListerExpansion
+}
+
+// webSocketSourceNamespaceLister implements the WebSocketSourceNamespaceLister
+// interface.
+type webSocketSourceNamespaceLister struct {
+	listers.ResourceIndexer[*sourcesv1alpha1.WebSocketSource]
+}
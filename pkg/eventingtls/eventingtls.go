@@ -54,6 +54,10 @@ const (
 	IMCDispatcherServerTLSSecretName = "imc-dispatcher-server-tls" //nolint:gosec // This is not a hardcoded credential
 	// JobSinkDispatcherServerTLSSecretName is the name of the tls secret for the job sink dispatcher server
 	JobSinkDispatcherServerTLSSecretName = "job-sink-server-tls" //nolint:gosec // This is not a hardcoded credential
+	// StreamSinkDispatcherServerTLSSecretName is the name of the tls secret for the stream sink dispatcher server
+	StreamSinkDispatcherServerTLSSecretName = "stream-sink-server-tls" //nolint:gosec // This is not a hardcoded credential
+	// PullSinkDispatcherServerTLSSecretName is the name of the tls secret for the pull sink dispatcher server
+	PullSinkDispatcherServerTLSSecretName = "pull-sink-server-tls" //nolint:gosec // This is not a hardcoded credential
 	// BrokerFilterServerTLSSecretName is the name of the tls secret for the broker filter server
 	BrokerFilterServerTLSSecretName = "mt-broker-filter-server-tls" //nolint:gosec // This is not a hardcoded credential
 	// BrokerIngressServerTLSSecretName is the name of the tls secret for the broker ingress server
@@ -78,6 +82,11 @@ type ServerConfig struct {
 	// retrieved from NameToCertificate. If NameToCertificate is nil, the
 	// best element of Certificates will be used.
 	GetCertificate func(*tls.ClientHelloInfo) (*tls.Certificate, error)
+
+	// RequestClientCertificates makes the server request a certificate from its clients.
+	// The certificate is optional and not verified during the handshake, but it is
+	// verified by the auth.Verifier when it is used as the identity of the client.
+	RequestClientCertificates bool
 }
 
 // GetCertificate returns a Certificate based on the given
@@ -181,10 +190,14 @@ func NewDefaultServerConfig() ServerConfig {
 }
 
 func GetTLSServerConfig(config ServerConfig) (*tls.Config, error) {
-	return &tls.Config{
+	tlsConfig := &tls.Config{
 		MinVersion:     DefaultMinTLSVersion,
 		GetCertificate: config.GetCertificate,
-	}, nil
+	}
+	if config.RequestClientCertificates {
+		tlsConfig.ClientAuth = tls.RequestClientCert
+	}
+	return tlsConfig, nil
 }
 
 // IsHttpsSink returns true if the sink has scheme equal to https.
@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package eventsigning signs CloudEvents end-to-end, so that receivers can verify
+// the producer of an event, independent of the hops it passed.
+//
+// The signature is a JWS with detached payload, carried in the SignatureExtension.
+// The payload is the canonical JSON of the context attributes and the data of the
+// event. Extensions are not signed, as they are changed on the way, e.g. by tracing.
+package eventsigning
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/go-jose/go-jose/v3"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// SignatureExtension is the CloudEvents extension holding the signature of an event
+const SignatureExtension = "knativesignature"
+
+// ErrNotSigned is returned when verifying an event without signature
+var ErrNotSigned = errors.New("event is not signed")
+
+const (
+	// SigningKeySecretKey is the key of the PEM encoded PKCS #8 private key in the Secrets
+	// holding the signing key of a producer
+	SigningKeySecretKey = "key.pem"
+	// SigningKeyIDSecretKey is the key of the optional key id in the Secrets holding the
+	// signing key of a producer. It defaults to the name of the Secret.
+	SigningKeyIDSecretKey = "kid"
+)
+
+// Signer signs CloudEvents with a private key
+type Signer struct {
+	signer jose.Signer
+}
+
+// NewSigner creates a Signer for the given RSA, ECDSA or Ed25519 private key. The keyID
+// is set in the signatures, so that receivers can select the key to verify them with.
+func NewSigner(key crypto.PrivateKey, keyID string) (*Signer, error) {
+	alg, err := algorithmFor(key)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: key}, (&jose.SignerOptions{}).WithHeader("kid", keyID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signer: %w", err)
+	}
+	return &Signer{signer: signer}, nil
+}
+
+// NewSignerFromSecret creates a Signer for the private key in the SigningKeySecretKey of the
+// Secret, identified by the SigningKeyIDSecretKey of the Secret or its name.
+func NewSignerFromSecret(secret *corev1.Secret) (*Signer, error) {
+	block, _ := pem.Decode(secret.Data[SigningKeySecretKey])
+	if block == nil {
+		return nil, fmt.Errorf("secret %s/%s has no PEM encoded private key in %q", secret.Namespace, secret.Name, SigningKeySecretKey)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key of secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	}
+
+	keyID := secret.Name
+	if kid, ok := secret.Data[SigningKeyIDSecretKey]; ok && len(kid) > 0 {
+		keyID = string(kid)
+	}
+	return NewSigner(key, keyID)
+}
+
+func algorithmFor(key crypto.PrivateKey) (jose.SignatureAlgorithm, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return jose.RS256, nil
+	case *ecdsa.PrivateKey:
+		switch k.Curve {
+		case elliptic.P256():
+			return jose.ES256, nil
+		case elliptic.P384():
+			return jose.ES384, nil
+		case elliptic.P521():
+			return jose.ES512, nil
+		}
+		return "", fmt.Errorf("unsupported ECDSA curve %s", k.Curve.Params().Name)
+	case ed25519.PrivateKey:
+		return jose.EdDSA, nil
+	default:
+		return "", fmt.Errorf("unsupported private key type %T", key)
+	}
+}
+
+// Sign sets the signature of the event in the SignatureExtension
+func (s *Signer) Sign(event *cloudevents.Event) error {
+	payload, err := signedPayload(event)
+	if err != nil {
+		return err
+	}
+
+	jws, err := s.signer.Sign(payload)
+	if err != nil {
+		return fmt.Errorf("failed to sign event: %w", err)
+	}
+
+	signature, err := jws.DetachedCompactSerialize()
+	if err != nil {
+		return fmt.Errorf("failed to serialize signature: %w", err)
+	}
+
+	event.SetExtension(SignatureExtension, signature)
+	return nil
+}
+
+// Verify verifies the signature of the event with the keys of the key set and returns
+// the id of the key which signed the event. It returns ErrNotSigned, if the event has
+// no signature.
+func Verify(event *cloudevents.Event, keys *jose.JSONWebKeySet) (string, error) {
+	signature, ok := event.Extensions()[SignatureExtension].(string)
+	if !ok || signature == "" {
+		return "", ErrNotSigned
+	}
+
+	payload, err := signedPayload(event)
+	if err != nil {
+		return "", err
+	}
+
+	jws, err := jose.ParseDetached(signature, payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse signature: %w", err)
+	}
+	if len(jws.Signatures) != 1 {
+		return "", fmt.Errorf("expected one signature, got %d", len(jws.Signatures))
+	}
+
+	keyID := jws.Signatures[0].Header.KeyID
+	for _, key := range keys.Key(keyID) {
+		if !key.IsPublic() {
+			continue
+		}
+		if _, err := jws.Verify(key); err == nil {
+			return keyID, nil
+		}
+	}
+	return "", fmt.Errorf("signature is not valid for any key with id %q", keyID)
+}
+
+// ParseKeySet parses a JSON Web Key Set of public keys to verify signatures with
+func ParseKeySet(jwks string) (*jose.JSONWebKeySet, error) {
+	keys := &jose.JSONWebKeySet{}
+	if err := json.Unmarshal([]byte(jwks), keys); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON Web Key Set: %w", err)
+	}
+	if len(keys.Keys) == 0 {
+		return nil, errors.New("JSON Web Key Set has no keys")
+	}
+	for i := range keys.Keys {
+		if !keys.Keys[i].IsPublic() {
+			return nil, fmt.Errorf("key %q is not a public key", keys.Keys[i].KeyID)
+		}
+	}
+	return keys, nil
+}
+
+// ValidateKeySet checks that jwks is a JSON Web Key Set of public keys
+func ValidateKeySet(jwks string) error {
+	_, err := ParseKeySet(jwks)
+	return err
+}
+
+// signedAttributes are the attributes of an event covered by the signature
+type signedAttributes struct {
+	SpecVersion     string `json:"specversion"`
+	ID              string `json:"id"`
+	Source          string `json:"source"`
+	Type            string `json:"type"`
+	Subject         string `json:"subject,omitempty"`
+	DataContentType string `json:"datacontenttype,omitempty"`
+	DataSchema      string `json:"dataschema,omitempty"`
+	Time            string `json:"time,omitempty"`
+	Data            []byte `json:"data,omitempty"`
+}
+
+// signedPayload returns the canonical JSON of the signed attributes and data of the event
+func signedPayload(event *cloudevents.Event) ([]byte, error) {
+	attributes := signedAttributes{
+		SpecVersion:     event.SpecVersion(),
+		ID:              event.ID(),
+		Source:          event.Source(),
+		Type:            event.Type(),
+		Subject:         event.Subject(),
+		DataContentType: event.DataContentType(),
+		DataSchema:      event.DataSchema(),
+		Data:            event.Data(),
+	}
+	if !event.Time().IsZero() {
+		attributes.Time = event.Time().UTC().Format(time.RFC3339Nano)
+	}
+
+	payload, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal signed attributes: %w", err)
+	}
+	return payload, nil
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventsigning
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cetest "github.com/cloudevents/sdk-go/v2/test"
+	"github.com/go-jose/go-jose/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := &jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{
+			{Key: &rsaKey.PublicKey, KeyID: "rsa"},
+			{Key: &ecKey.PublicKey, KeyID: "ec"},
+			{Key: edKey.Public(), KeyID: "ed"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		key       interface{}
+		keyID     string
+		modify    func(event *cloudevents.Event)
+		wantKeyID string
+		wantErr   bool
+	}{
+		{
+			name:      "rsa",
+			key:       rsaKey,
+			keyID:     "rsa",
+			wantKeyID: "rsa",
+		}, {
+			name:      "ecdsa",
+			key:       ecKey,
+			keyID:     "ec",
+			wantKeyID: "ec",
+		}, {
+			name:      "ed25519",
+			key:       edKey,
+			keyID:     "ed",
+			wantKeyID: "ed",
+		}, {
+			name:  "extensions are not signed",
+			key:   ecKey,
+			keyID: "ec",
+			modify: func(event *cloudevents.Event) {
+				event.SetExtension("knativearrivaltime", "2026-01-01T00:00:00Z")
+			},
+			wantKeyID: "ec",
+		}, {
+			name:  "modified type",
+			key:   ecKey,
+			keyID: "ec",
+			modify: func(event *cloudevents.Event) {
+				event.SetType("other.type")
+			},
+			wantErr: true,
+		}, {
+			name:  "modified data",
+			key:   ecKey,
+			keyID: "ec",
+			modify: func(event *cloudevents.Event) {
+				_ = event.SetData(cloudevents.ApplicationJSON, map[string]string{"hello": "other"})
+			},
+			wantErr: true,
+		}, {
+			name:    "signed with key of other id",
+			key:     rsaKey,
+			keyID:   "ec",
+			wantErr: true,
+		}, {
+			name:    "unknown key id",
+			key:     ecKey,
+			keyID:   "unknown",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := cetest.FullEvent()
+
+			signer, err := NewSigner(tt.key, tt.keyID)
+			if err != nil {
+				t.Fatalf("NewSigner() error = %v", err)
+			}
+			if err := signer.Sign(&event); err != nil {
+				t.Fatalf("Sign() error = %v", err)
+			}
+
+			if tt.modify != nil {
+				tt.modify(&event)
+			}
+
+			gotKeyID, err := Verify(&event, keys)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if gotKeyID != tt.wantKeyID {
+				t.Errorf("Verify() key id = %q, want %q", gotKeyID, tt.wantKeyID)
+			}
+		})
+	}
+}
+
+func TestNewSignerFromSecret(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	keys := &jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: "my-secret"},
+			{Key: &key.PublicKey, KeyID: "my-key"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		data      map[string][]byte
+		wantKeyID string
+		wantErr   bool
+	}{
+		{
+			name:      "key id defaults to the secret name",
+			data:      map[string][]byte{SigningKeySecretKey: keyPEM},
+			wantKeyID: "my-secret",
+		}, {
+			name:      "key id of the secret",
+			data:      map[string][]byte{SigningKeySecretKey: keyPEM, SigningKeyIDSecretKey: []byte("my-key")},
+			wantKeyID: "my-key",
+		}, {
+			name:    "no private key",
+			data:    map[string][]byte{},
+			wantErr: true,
+		}, {
+			name:    "invalid private key",
+			data:    map[string][]byte{SigningKeySecretKey: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("invalid")})},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer, err := NewSignerFromSecret(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "knative-eventing"},
+				Data:       tt.data,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewSignerFromSecret() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			event := cetest.FullEvent()
+			if err := signer.Sign(&event); err != nil {
+				t.Fatal("Sign() unexpected error:", err)
+			}
+			keyID, err := Verify(&event, keys)
+			if err != nil {
+				t.Fatal("Verify() unexpected error:", err)
+			}
+			if keyID != tt.wantKeyID {
+				t.Errorf("Verify() key id = %q, want %q", keyID, tt.wantKeyID)
+			}
+		})
+	}
+}
+
+func TestVerifyNotSigned(t *testing.T) {
+	event := cetest.MinEvent()
+	if _, err := Verify(&event, &jose.JSONWebKeySet{}); !errors.Is(err, ErrNotSigned) {
+		t.Errorf("Verify() error = %v, want %v", err, ErrNotSigned)
+	}
+}
+
+func TestParseKeySet(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	marshal := func(keys ...jose.JSONWebKey) string {
+		b, err := json.Marshal(jose.JSONWebKeySet{Keys: keys})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(b)
+	}
+
+	tests := []struct {
+		name    string
+		jwks    string
+		wantErr bool
+	}{
+		{
+			name: "public key",
+			jwks: marshal(jose.JSONWebKey{Key: &ecKey.PublicKey, KeyID: "ec"}),
+		}, {
+			name:    "private key",
+			jwks:    marshal(jose.JSONWebKey{Key: ecKey, KeyID: "ec"}),
+			wantErr: true,
+		}, {
+			name:    "no keys",
+			jwks:    `{"keys": []}`,
+			wantErr: true,
+		}, {
+			name:    "invalid json",
+			jwks:    `{"keys":`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseKeySet(tt.jwks); (err != nil) != tt.wantErr {
+				t.Errorf("ParseKeySet() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -47,6 +47,7 @@ import (
 	v1 "knative.dev/eventing/pkg/apis/duck/v1"
 	"knative.dev/eventing/pkg/auth"
 	"knative.dev/eventing/pkg/eventingtls"
+	"knative.dev/eventing/pkg/eventsigning"
 	"knative.dev/eventing/pkg/eventtype"
 	"knative.dev/eventing/pkg/observability"
 	"knative.dev/eventing/pkg/utils"
@@ -142,6 +143,19 @@ func WithEventTypeAutoHandler(handler *eventtype.EventTypeAutoHandler, ref *duck
 	}
 }
 
+// WithEventSigner signs the events with the given signer before sending them,
+// so that receivers can verify their producer
+func WithEventSigner(signer *eventsigning.Signer) SendOption {
+	return func(sc *senderConfig) error {
+		if signer == nil {
+			return fmt.Errorf("event signer must not be nil")
+		}
+		sc.signer = signer
+
+		return nil
+	}
+}
+
 type senderConfig struct {
 	reply                *duckv1.Addressable
 	deadLetterSink       *duckv1.Addressable
@@ -153,6 +167,7 @@ type senderConfig struct {
 	eventTypeRef         *duckv1.KReference
 	eventTypeOnwerUID    types.UID
 	eventFormat          *v1.FormatType
+	signer               *eventsigning.Signer
 }
 
 type Dispatcher struct {
@@ -197,6 +212,26 @@ func NewDispatcher(clientConfig eventingtls.ClientConfig, oidcTokenProvider *aut
 	return d
 }
 
+// Client returns the HTTP client used to send requests to the given
+// destination, trusting its CA certs and the configured trust bundles.
+func (d *Dispatcher) Client(destination duckv1.Addressable) (*http.Client, error) {
+	return getClientForAddressable(d.clientConfig, destination, d.meterProvider, d.traceProvider)
+}
+
+// AuditSender returns an auth.AuditSender sending the audit events of the
+// authorization decisions with the dispatcher.
+func (d *Dispatcher) AuditSender() auth.AuditSender {
+	return func(ctx context.Context, event event.Event, sink duckv1.Addressable, oidcServiceAccount *types.NamespacedName) error {
+		var options []SendOption
+		if oidcServiceAccount != nil {
+			options = append(options, WithOIDCAuthentication(oidcServiceAccount))
+		}
+
+		_, err := d.SendEvent(ctx, event, sink, options...)
+		return err
+	}
+}
+
 // SendEvent sends the given event to the given destination.
 func (d *Dispatcher) SendEvent(ctx context.Context, event event.Event, destination duckv1.Addressable, options ...SendOption) (*DispatchInfo, error) {
 	// clone the event since:
@@ -227,9 +262,31 @@ func (d *Dispatcher) SendMessage(ctx context.Context, message binding.Message, d
 		}
 	}
 
+	if config.signer != nil {
+		signed, err := signMessage(ctx, message, config.signer)
+		if err != nil {
+			return nil, fmt.Errorf("could not sign event: %w", err)
+		}
+		message = signed
+	}
+
 	return d.send(ctx, message, destination, config)
 }
 
+// signMessage returns a message of the signed event of the given message, which is finished
+func signMessage(ctx context.Context, message binding.Message, signer *eventsigning.Signer) (binding.Message, error) {
+	event, err := binding.ToEvent(ctx, message)
+	_ = message.Finish(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode event: %w", err)
+	}
+
+	if err := signer.Sign(event); err != nil {
+		return nil, err
+	}
+	return binding.ToMessage(event), nil
+}
+
 func (d *Dispatcher) send(ctx context.Context, message binding.Message, destination duckv1.Addressable, config *senderConfig) (*DispatchInfo, error) {
 	dispatchExecutionInfo := &DispatchInfo{}
 
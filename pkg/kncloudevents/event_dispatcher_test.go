@@ -19,6 +19,9 @@ package kncloudevents_test
 import (
 	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"encoding/base64"
 	"fmt"
 	"io"
@@ -36,6 +39,7 @@ import (
 	"github.com/cloudevents/sdk-go/v2/binding/transformer"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
 	"github.com/cloudevents/sdk-go/v2/test"
+	"github.com/go-jose/go-jose/v3"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -58,6 +62,7 @@ import (
 	"knative.dev/eventing/pkg/auth"
 	"knative.dev/eventing/pkg/eventingtls"
 	"knative.dev/eventing/pkg/eventingtls/eventingtlstesting"
+	"knative.dev/eventing/pkg/eventsigning"
 	"knative.dev/eventing/pkg/kncloudevents"
 	"knative.dev/eventing/pkg/utils"
 )
@@ -1014,6 +1019,44 @@ func testEventFormat(t *testing.T, format v1.FormatType, expectedEncoding bindin
 	require.Equal(t, eventToSend.Data(), destinationReceivedEvents[0].Data())
 }
 
+func TestSendEventWithEventSigner(t *testing.T) {
+	ctx, _ := rectesting.SetupFakeContext(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := eventsigning.NewSigner(key, "my-key")
+	require.NoError(t, err)
+
+	receivedEvents := make(chan *cloudevents.Event, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event, err := binding.ToEvent(r.Context(), cehttp.NewMessageFromHttpRequest(r))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		receivedEvents <- event
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	destination := duckv1.Addressable{URL: apis.HTTP(strings.TrimPrefix(server.URL, "http://"))}
+
+	dispatcher := kncloudevents.NewDispatcher(eventingtls.NewDefaultClientConfig(), auth.NewOIDCTokenProvider(ctx))
+	event := test.FullEvent()
+	_, err = dispatcher.SendEvent(ctx, event, destination, kncloudevents.WithEventSigner(signer))
+	require.NoError(t, err)
+
+	received := <-receivedEvents
+	keyID, err := eventsigning.Verify(received, &jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{{Key: &key.PublicKey, KeyID: "my-key"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "my-key", keyID)
+
+	_, signed := event.Extensions()[eventsigning.SignatureExtension]
+	require.False(t, signed, "the event of the caller must not be modified")
+}
+
 func TestEventFormats(t *testing.T) {
 	t.Run("BinaryFormat", func(t *testing.T) {
 		testEventFormat(t, v1.DeliveryFormatBinary, binding.EncodingBinary, 8335)
@@ -0,0 +1,383 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pullsink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/rickb777/date/period"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/logging"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/apis/feature"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/auth"
+	sinkslister "knative.dev/eventing/pkg/client/listers/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/eventing/pkg/observability"
+	eventingtracing "knative.dev/eventing/pkg/tracing"
+)
+
+const (
+	// MaxQueryParameter is the query parameter holding the maximum number of
+	// events returned by a pull. Defaults to 1.
+	MaxQueryParameter = "max"
+
+	// MaxPullSize is the maximum number of events returned by a pull.
+	MaxPullSize = 100
+
+	// sweepInterval is the interval between two checks for expired leases.
+	sweepInterval = time.Second
+)
+
+// Handler handles the requests to the PullSinks. Events are sent to a
+// PullSink with a POST to /<namespace>/<name>, and consumers pull them with
+// a POST to /<namespace>/<name>/pull, which returns a JSON array of Leases.
+// Pulled events are acknowledged with a POST to
+// /<namespace>/<name>/ack/<ackId> and rejected with a POST to
+// /<namespace>/<name>/nack/<ackId>.
+//
+// Events are buffered in memory, so they are lost when the dispatcher
+// restarts. Senders are therefore answered with 200 rather than 202, which
+// would suggest the events were durably accepted.
+type Handler struct {
+	lister       sinkslister.PullSinkLister
+	authVerifier verifier
+	dispatcher   *kncloudevents.Dispatcher
+	withContext  func(ctx context.Context) context.Context
+
+	mu     sync.Mutex
+	queues map[types.NamespacedName]*Queue
+}
+
+// verifier authenticates and authorizes the senders and the consumers of the
+// PullSinks. It is implemented by auth.Verifier.
+type verifier interface {
+	VerifyRequest(ctx context.Context, features feature.Flags, requiredOIDCAudience *string, resourceNamespace string, policyRefs []eventingduckv1.AppliedEventPolicyRef, req *http.Request, resp http.ResponseWriter) error
+	VerifySubscriber(ctx context.Context, features feature.Flags, requiredOIDCAudience *string, resourceNamespace string, allowedSubjects []string, token string) (int, error)
+}
+
+// NewHandler creates a Handler for the PullSinks listed by lister, sending
+// the events which can't be delivered to the dead letter sinks with
+// dispatcher.
+func NewHandler(lister sinkslister.PullSinkLister, authVerifier *auth.Verifier, dispatcher *kncloudevents.Dispatcher, withContext func(ctx context.Context) context.Context) *Handler {
+	return &Handler{
+		lister:       lister,
+		authVerifier: authVerifier,
+		dispatcher:   dispatcher,
+		withContext:  withContext,
+		queues:       make(map[types.NamespacedName]*Queue),
+	}
+}
+
+// Start periodically returns the events whose lease expired to their queue,
+// and drops the queues of the deleted PullSinks, until ctx is done.
+func (h *Handler) Start(ctx context.Context) {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.sweep(h.withContext(ctx))
+		}
+	}
+}
+
+func (h *Handler) sweep(ctx context.Context) {
+	h.mu.Lock()
+	queues := make(map[types.NamespacedName]*Queue, len(h.queues))
+	for ref, q := range h.queues {
+		queues[ref] = q
+	}
+	h.mu.Unlock()
+
+	for ref, q := range queues {
+		ps, err := h.lister.PullSinks(ref.Namespace).Get(ref.Name)
+		if apierrors.IsNotFound(err) {
+			h.mu.Lock()
+			delete(h.queues, ref)
+			h.mu.Unlock()
+			continue
+		} else if err != nil {
+			continue
+		}
+		if dead := q.Expire(retry(ps)); len(dead) > 0 {
+			h.deadLetter(ctx, ps, dead)
+		}
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := h.withContext(r.Context())
+	logger := logging.FromContext(ctx).Desugar()
+
+	ctx = observability.WithRequestLabels(ctx, r)
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) < 2 || len(parts) > 4 {
+		logger.Info("Malformed uri", zap.String("path", r.URL.Path))
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	ref := types.NamespacedName{
+		Namespace: parts[0],
+		Name:      parts[1],
+	}
+
+	ctx = observability.WithSinkLabels(ctx, ref, "PullSink")
+
+	ps, err := h.lister.PullSinks(ref.Namespace).Get(ref.Name)
+	if err != nil {
+		logger.Info("Failed to retrieve pullsink", zap.String("ref", ref.String()), zap.Error(err))
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		logger.Info("Unexpected HTTP method", zap.String("method", r.Method))
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch {
+	case len(parts) == 2:
+		h.handleEvent(ctx, w, r, ps)
+	case len(parts) == 3 && parts[2] == "pull":
+		h.handlePull(ctx, w, r, ps)
+	case len(parts) == 4 && parts[2] == "ack":
+		h.handleAck(ctx, w, r, ps, parts[3], false)
+	case len(parts) == 4 && parts[2] == "nack":
+		h.handleAck(ctx, w, r, ps, parts[3], true)
+	default:
+		logger.Info("Malformed uri", zap.String("path", r.URL.Path))
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// handleEvent adds the event sent in r to the queue of ps.
+func (h *Handler) handleEvent(ctx context.Context, w http.ResponseWriter, r *http.Request, ps *sinksv1alpha1.PullSink) {
+	logger := logging.FromContext(ctx).Desugar()
+
+	err := h.authVerifier.VerifyRequest(ctx, feature.FromContext(ctx), audience(ps), ps.Namespace, ps.Status.Policies, r, w)
+	if err != nil {
+		logger.Warn("Failed to verify AuthN and AuthZ.", zap.Error(err))
+		return
+	}
+
+	message := cehttp.NewMessageFromHttpRequest(r)
+	defer message.Finish(nil)
+
+	event, err := binding.ToEvent(ctx, message, eventingtracing.PopulateCEDistributedTracing(ctx))
+	if err != nil {
+		logger.Warn("failed to extract event from request", zap.Error(err))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err := event.Validate(); err != nil {
+		logger.Info("failed to validate event from request", zap.Error(err))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	capacity := sinksv1alpha1.DefaultPullSinkCapacity
+	if ps.Spec.Capacity != nil {
+		capacity = *ps.Spec.Capacity
+	}
+
+	if err := h.queue(ps).Push(event, int(capacity)); err != nil {
+		logger.Info("Rejecting event", zap.String("id", event.ID()), zap.Error(err))
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// handlePull leases the events of ps to the consumer sending r.
+func (h *Handler) handlePull(ctx context.Context, w http.ResponseWriter, r *http.Request, ps *sinksv1alpha1.PullSink) {
+	logger := logging.FromContext(ctx).Desugar()
+
+	if !h.verifyConsumer(ctx, w, r, ps) {
+		return
+	}
+
+	size := 1
+	if v := r.URL.Query().Get(MaxQueryParameter); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > MaxPullSize {
+			http.Error(w, "max must be an integer between 1 and "+strconv.Itoa(MaxPullSize), http.StatusBadRequest)
+			return
+		}
+		size = n
+	}
+
+	leases := h.queue(ps).Lease(size, leaseDuration(ps))
+
+	w.Header().Set("Content-Type", cloudevents.ApplicationJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(leases); err != nil {
+		logger.Info("Failed to write leases", zap.Error(err))
+	}
+}
+
+// handleAck acknowledges, or rejects when nack is true, the event of ps
+// leased with ackID.
+func (h *Handler) handleAck(ctx context.Context, w http.ResponseWriter, r *http.Request, ps *sinksv1alpha1.PullSink, ackID string, nack bool) {
+	logger := logging.FromContext(ctx).Desugar()
+
+	if !h.verifyConsumer(ctx, w, r, ps) {
+		return
+	}
+
+	q := h.queue(ps)
+	var err error
+	if nack {
+		var dead *cloudevents.Event
+		dead, err = q.Nack(ackID, retry(ps))
+		if dead != nil {
+			h.deadLetter(ctx, ps, []*cloudevents.Event{dead})
+		}
+	} else {
+		err = q.Ack(ackID)
+	}
+
+	if errors.Is(err, ErrNotFound) {
+		logger.Info("Unknown lease", zap.String("ackId", ackID))
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// verifyConsumer authenticates and authorizes the consumer sending r,
+// replying to it and returning false when it isn't allowed to consume the
+// events of ps.
+func (h *Handler) verifyConsumer(ctx context.Context, w http.ResponseWriter, r *http.Request, ps *sinksv1alpha1.PullSink) bool {
+	var subjects []string
+	if ps.Spec.Consumers != nil {
+		subjects = ps.Spec.Consumers.Subjects
+	}
+
+	status, err := h.authVerifier.VerifySubscriber(ctx, feature.FromContext(ctx), audience(ps), ps.Namespace, subjects, auth.GetJWTFromHeader(r.Header))
+	if err != nil {
+		logging.FromContext(ctx).Desugar().Warn("Failed to verify AuthN and AuthZ.", zap.Error(err))
+		w.WriteHeader(status)
+		return false
+	}
+	return true
+}
+
+// deadLetter sends the events of ps which can't be redelivered to its dead
+// letter sink, or drops them when it has none.
+func (h *Handler) deadLetter(ctx context.Context, ps *sinksv1alpha1.PullSink, events []*cloudevents.Event) {
+	logger := logging.FromContext(ctx).Desugar()
+
+	if !ps.Status.DeliveryStatus.IsSet() {
+		for _, event := range events {
+			logger.Info("Dropping event, no dead letter sink is configured", zap.String("id", event.ID()))
+		}
+		return
+	}
+
+	dls := duckv1.Addressable{
+		URL:      ps.Status.DeadLetterSinkURI,
+		CACerts:  ps.Status.DeadLetterSinkCACerts,
+		Audience: ps.Status.DeadLetterSinkAudience,
+	}
+
+	var opts []kncloudevents.SendOption
+	if feature.FromContext(ctx).IsOIDCAuthentication() && ps.Status.Auth != nil && ps.Status.Auth.ServiceAccountName != nil {
+		opts = append(opts, kncloudevents.WithOIDCAuthentication(&types.NamespacedName{
+			Namespace: ps.Namespace,
+			Name:      *ps.Status.Auth.ServiceAccountName,
+		}))
+	}
+
+	// The request which made the events expire must not wait for them to be
+	// delivered, nor cancel their delivery.
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		for _, event := range events {
+			if _, err := h.dispatcher.SendEvent(ctx, *event, dls, opts...); err != nil {
+				logger.Error("Failed to send event to the dead letter sink", zap.String("id", event.ID()), zap.Error(err))
+			}
+		}
+	}()
+}
+
+// queue returns the queue of ps, creating it if needed.
+func (h *Handler) queue(ps *sinksv1alpha1.PullSink) *Queue {
+	ref := types.NamespacedName{Namespace: ps.Namespace, Name: ps.Name}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	q, ok := h.queues[ref]
+	if !ok {
+		q = NewQueue()
+		h.queues[ref] = q
+	}
+	return q
+}
+
+func leaseDuration(ps *sinksv1alpha1.PullSink) time.Duration {
+	p := sinksv1alpha1.DefaultPullSinkLeaseDuration
+	if ps.Spec.LeaseDuration != nil {
+		p = *ps.Spec.LeaseDuration
+	}
+	parsed, err := period.Parse(p)
+	if err != nil {
+		// Periods are validated by the webhook, fall back to the default.
+		parsed, _ = period.Parse(sinksv1alpha1.DefaultPullSinkLeaseDuration)
+	}
+	d, _ := parsed.Duration()
+	return d
+}
+
+func retry(ps *sinksv1alpha1.PullSink) *int32 {
+	if ps.Spec.Delivery == nil {
+		return nil
+	}
+	return ps.Spec.Delivery.Retry
+}
+
+func audience(ps *sinksv1alpha1.PullSink) *string {
+	if ps.Status.Address == nil {
+		return nil
+	}
+	return ps.Status.Address.Audience
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pullsink
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/apis/feature"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/auth"
+	sinkslister "knative.dev/eventing/pkg/client/listers/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/eventingtls"
+	"knative.dev/eventing/pkg/kncloudevents"
+)
+
+// testVerifier accepts all the events and consumers.
+type testVerifier struct{}
+
+func (testVerifier) VerifyRequest(context.Context, feature.Flags, *string, string, []eventingduckv1.AppliedEventPolicyRef, *http.Request, http.ResponseWriter) error {
+	return nil
+}
+
+func (testVerifier) VerifySubscriber(context.Context, feature.Flags, *string, string, []string, string) (int, error) {
+	return http.StatusOK, nil
+}
+
+func TestHandlerPullAck(t *testing.T) {
+	server := newTestServer(t, newTestPullSink())
+
+	sendEvent(t, server.URL+"/ns/sink", "1")
+	sendEvent(t, server.URL+"/ns/sink", "2")
+
+	leases := pull(t, server.URL+"/ns/sink/pull?max=10")
+	if len(leases) != 2 || leases[0].Event.ID() != "1" || leases[1].Event.ID() != "2" {
+		t.Fatalf("pulled %v, want events 1 and 2", leases)
+	}
+
+	post(t, server.URL+"/ns/sink/ack/"+leases[0].AckID, http.StatusNoContent)
+	post(t, server.URL+"/ns/sink/ack/"+leases[0].AckID, http.StatusNotFound)
+	post(t, server.URL+"/ns/sink/nack/"+leases[1].AckID, http.StatusNoContent)
+
+	leases = pull(t, server.URL+"/ns/sink/pull")
+	if len(leases) != 1 || leases[0].Event.ID() != "2" || leases[0].DeliveryAttempt != 2 {
+		t.Errorf("pulled %v, want event 2 redelivered", leases)
+	}
+}
+
+func TestHandlerDeadLetter(t *testing.T) {
+	received := make(chan string, 1)
+	dls := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header.Get("Ce-Id")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(dls.Close)
+
+	ps := newTestPullSink()
+	ps.Spec.Delivery = &eventingduckv1.DeliverySpec{Retry: ptr.To[int32](0)}
+	ps.Status.DeadLetterSinkURI, _ = apis.ParseURL(dls.URL)
+	server := newTestServer(t, ps)
+
+	sendEvent(t, server.URL+"/ns/sink", "1")
+	leases := pull(t, server.URL+"/ns/sink/pull")
+	if len(leases) != 1 {
+		t.Fatalf("pulled %v, want event 1", leases)
+	}
+	post(t, server.URL+"/ns/sink/nack/"+leases[0].AckID, http.StatusNoContent)
+
+	select {
+	case id := <-received:
+		if id != "1" {
+			t.Errorf("dead letter sink received event %q, want 1", id)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the dead letter sink")
+	}
+
+	if leases := pull(t, server.URL+"/ns/sink/pull"); len(leases) != 0 {
+		t.Errorf("pulled %v, want no event", leases)
+	}
+}
+
+func TestHandlerErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		want   int
+	}{{
+		name:   "malformed path",
+		method: http.MethodPost,
+		path:   "/ns",
+		want:   http.StatusNotFound,
+	}, {
+		name:   "unknown sink",
+		method: http.MethodPost,
+		path:   "/ns/unknown/pull",
+		want:   http.StatusNotFound,
+	}, {
+		name:   "unknown operation",
+		method: http.MethodPost,
+		path:   "/ns/sink/unknown",
+		want:   http.StatusNotFound,
+	}, {
+		name:   "unsupported method",
+		method: http.MethodGet,
+		path:   "/ns/sink/pull",
+		want:   http.StatusMethodNotAllowed,
+	}, {
+		name:   "not an event",
+		method: http.MethodPost,
+		path:   "/ns/sink",
+		want:   http.StatusBadRequest,
+	}, {
+		name:   "invalid max",
+		method: http.MethodPost,
+		path:   "/ns/sink/pull?max=1000",
+		want:   http.StatusBadRequest,
+	}, {
+		name:   "unknown lease",
+		method: http.MethodPost,
+		path:   "/ns/sink/ack/unknown",
+		want:   http.StatusNotFound,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHandler(t, newTestPullSink())
+
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, nil))
+
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandlerRefusesConsumersWithoutOIDC(t *testing.T) {
+	h := newTestHandler(t, newTestPullSink())
+	// OIDC authentication is disabled by default.
+	h.authVerifier = &auth.Verifier{}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/ns/sink/pull", nil))
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+}
+
+func newTestPullSink() *sinksv1alpha1.PullSink {
+	return &sinksv1alpha1.PullSink{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "sink",
+		},
+	}
+}
+
+func newTestHandler(t *testing.T, ps *sinksv1alpha1.PullSink) *Handler {
+	t.Helper()
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	if err := indexer.Add(ps); err != nil {
+		t.Fatal(err)
+	}
+
+	h := NewHandler(
+		sinkslister.NewPullSinkLister(indexer),
+		nil,
+		kncloudevents.NewDispatcher(eventingtls.ClientConfig{}, nil),
+		func(ctx context.Context) context.Context { return ctx },
+	)
+	h.authVerifier = testVerifier{}
+	return h
+}
+
+func newTestServer(t *testing.T, ps *sinksv1alpha1.PullSink) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(newTestHandler(t, ps))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func sendEvent(t *testing.T, target, id string) {
+	t.Helper()
+
+	client, err := cloudevents.NewClientHTTP()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := cloudevents.ContextWithTarget(context.Background(), target)
+	result := client.Send(ctx, *newEvent(id))
+	if !cloudevents.IsACK(result) {
+		t.Fatalf("failed to send event: %v", result)
+	}
+	// Events are only buffered in memory, so they aren't durably accepted.
+	var httpResult *cehttp.Result
+	if !cloudevents.ResultAs(result, &httpResult) || httpResult.StatusCode != http.StatusOK {
+		t.Errorf("send result = %v, want status %d", result, http.StatusOK)
+	}
+}
+
+func pull(t *testing.T, url string) []Lease {
+	t.Helper()
+
+	resp, err := http.Post(url, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("pull status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	var leases []Lease
+	if err := json.NewDecoder(resp.Body).Decode(&leases); err != nil {
+		t.Fatal(err)
+	}
+	return leases
+}
+
+func post(t *testing.T, url string, want int) {
+	t.Helper()
+
+	resp, err := http.Post(url, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != want {
+		t.Errorf("POST %s status = %d, want %d", url, resp.StatusCode, want)
+	}
+}
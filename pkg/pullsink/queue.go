@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pullsink
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrFull is returned when an event is pushed to a full Queue.
+	ErrFull = errors.New("queue is full")
+
+	// ErrNotFound is returned when acknowledging or rejecting an event whose
+	// lease is unknown, most likely because it expired.
+	ErrNotFound = errors.New("lease not found")
+)
+
+// Lease is an event leased to a consumer, which must acknowledge or reject it
+// with its AckID before it expires.
+type Lease struct {
+	AckID string `json:"ackId"`
+	// DeliveryAttempt is the number of times the event was leased, starting
+	// at 1.
+	DeliveryAttempt int32              `json:"deliveryAttempt"`
+	ExpiresAt       time.Time          `json:"expiresAt"`
+	Event           *cloudevents.Event `json:"event"`
+}
+
+type entry struct {
+	event    *cloudevents.Event
+	attempts int32
+}
+
+type leasedEntry struct {
+	*entry
+	expiresAt time.Time
+}
+
+// Queue buffers the events of a PullSink. Events are leased to the consumers
+// in the order they were pushed, and are pushed back to the end of the queue
+// when they are rejected or their lease expires, until they have been
+// redelivered as many times as allowed.
+type Queue struct {
+	mu     sync.Mutex
+	ready  []*entry
+	leased map[string]*leasedEntry
+
+	// now returns the current time, it is overridden in tests.
+	now func() time.Time
+}
+
+// NewQueue creates an empty Queue.
+func NewQueue() *Queue {
+	return &Queue{
+		leased: make(map[string]*leasedEntry),
+		now:    time.Now,
+	}
+}
+
+// Len returns the number of events in the queue, leased or not.
+func (q *Queue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.ready) + len(q.leased)
+}
+
+// Push adds event to the end of the queue, unless the queue already holds
+// capacity events.
+func (q *Queue) Push(event *cloudevents.Event, capacity int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.ready)+len(q.leased) >= capacity {
+		return ErrFull
+	}
+	q.ready = append(q.ready, &entry{event: event})
+	return nil
+}
+
+// Lease leases up to max events for duration.
+func (q *Queue) Lease(max int, duration time.Duration) []Lease {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	n := min(max, len(q.ready))
+	leases := make([]Lease, 0, n)
+	expiresAt := q.now().Add(duration)
+	for _, e := range q.ready[:n] {
+		e.attempts++
+		ackID := uuid.NewString()
+		q.leased[ackID] = &leasedEntry{entry: e, expiresAt: expiresAt}
+		leases = append(leases, Lease{
+			AckID:           ackID,
+			DeliveryAttempt: e.attempts,
+			ExpiresAt:       expiresAt,
+			Event:           e.event,
+		})
+	}
+	q.ready = q.ready[n:]
+	return leases
+}
+
+// Ack removes the event leased with ackID from the queue.
+func (q *Queue) Ack(ackID string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, ok := q.leased[ackID]; !ok {
+		return ErrNotFound
+	}
+	delete(q.leased, ackID)
+	return nil
+}
+
+// Nack returns the event leased with ackID to the queue. When retry is set
+// and the event has already been redelivered retry times, the event is
+// removed from the queue and returned instead, to be dead lettered.
+func (q *Queue) Nack(ackID string, retry *int32) (*cloudevents.Event, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	l, ok := q.leased[ackID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	delete(q.leased, ackID)
+	return q.release(l.entry, retry), nil
+}
+
+// Expire returns the events whose lease expired to the queue, and returns
+// those which have already been redelivered retry times, as Nack.
+func (q *Queue) Expire(retry *int32) []*cloudevents.Event {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var dead []*cloudevents.Event
+	now := q.now()
+	for ackID, l := range q.leased {
+		if now.Before(l.expiresAt) {
+			continue
+		}
+		delete(q.leased, ackID)
+		if event := q.release(l.entry, retry); event != nil {
+			dead = append(dead, event)
+		}
+	}
+	return dead
+}
+
+// release pushes e back to the queue, or returns its event when it can't be
+// redelivered anymore. q.mu must be held.
+func (q *Queue) release(e *entry, retry *int32) *cloudevents.Event {
+	if retry != nil && e.attempts > *retry {
+		return e.event
+	}
+	q.ready = append(q.ready, e)
+	return nil
+}
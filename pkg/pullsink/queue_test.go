@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pullsink
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"k8s.io/utils/ptr"
+)
+
+func TestQueueLeaseAck(t *testing.T) {
+	q := NewQueue()
+
+	for _, id := range []string{"1", "2", "3"} {
+		if err := q.Push(newEvent(id), 3); err != nil {
+			t.Fatalf("Push(%s) = %v", id, err)
+		}
+	}
+	if err := q.Push(newEvent("4"), 3); !errors.Is(err, ErrFull) {
+		t.Errorf("Push() on a full queue = %v, want %v", err, ErrFull)
+	}
+
+	leases := q.Lease(2, time.Minute)
+	if len(leases) != 2 || leases[0].Event.ID() != "1" || leases[1].Event.ID() != "2" {
+		t.Fatalf("Lease() = %v, want events 1 and 2", leases)
+	}
+	if leases[0].DeliveryAttempt != 1 {
+		t.Errorf("DeliveryAttempt = %d, want 1", leases[0].DeliveryAttempt)
+	}
+
+	if err := q.Ack(leases[0].AckID); err != nil {
+		t.Errorf("Ack() = %v", err)
+	}
+	if err := q.Ack(leases[0].AckID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Ack() of an acknowledged event = %v, want %v", err, ErrNotFound)
+	}
+	if got := q.Len(); got != 2 {
+		t.Errorf("Len() = %d, want 2", got)
+	}
+
+	leases = q.Lease(10, time.Minute)
+	if len(leases) != 1 || leases[0].Event.ID() != "3" {
+		t.Errorf("Lease() = %v, want event 3", leases)
+	}
+}
+
+func TestQueueNack(t *testing.T) {
+	q := NewQueue()
+	_ = q.Push(newEvent("1"), 10)
+	_ = q.Push(newEvent("2"), 10)
+
+	lease := q.Lease(1, time.Minute)[0]
+	dead, err := q.Nack(lease.AckID, ptr.To[int32](1))
+	if err != nil || dead != nil {
+		t.Fatalf("Nack() = %v, %v, want the event to be redelivered", dead, err)
+	}
+
+	// Rejected events go back to the end of the queue.
+	leases := q.Lease(2, time.Minute)
+	if len(leases) != 2 || leases[0].Event.ID() != "2" || leases[1].Event.ID() != "1" {
+		t.Fatalf("Lease() = %v, want events 2 and 1", leases)
+	}
+	if leases[1].DeliveryAttempt != 2 {
+		t.Errorf("DeliveryAttempt = %d, want 2", leases[1].DeliveryAttempt)
+	}
+
+	dead, err = q.Nack(leases[1].AckID, ptr.To[int32](1))
+	if err != nil || dead == nil || dead.ID() != "1" {
+		t.Errorf("Nack() = %v, %v, want event 1 to be dead lettered", dead, err)
+	}
+
+	// Events are redelivered until they are acknowledged without retry.
+	dead, err = q.Nack(leases[0].AckID, nil)
+	if err != nil || dead != nil {
+		t.Errorf("Nack() = %v, %v, want the event to be redelivered", dead, err)
+	}
+	if got := q.Len(); got != 1 {
+		t.Errorf("Len() = %d, want 1", got)
+	}
+
+	if _, err := q.Nack("unknown", nil); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Nack() of an unknown lease = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestQueueExpire(t *testing.T) {
+	now := time.Now()
+	q := NewQueue()
+	q.now = func() time.Time { return now }
+
+	_ = q.Push(newEvent("1"), 10)
+	_ = q.Push(newEvent("2"), 10)
+	q.Lease(1, time.Second)
+	q.Lease(1, time.Minute)
+
+	now = now.Add(2 * time.Second)
+	if dead := q.Expire(ptr.To[int32](1)); len(dead) != 0 {
+		t.Errorf("Expire() = %v, want no dead event", dead)
+	}
+
+	leases := q.Lease(10, time.Second)
+	if len(leases) != 1 || leases[0].Event.ID() != "1" || leases[0].DeliveryAttempt != 2 {
+		t.Fatalf("Lease() = %v, want event 1 redelivered", leases)
+	}
+
+	now = now.Add(2 * time.Second)
+	if dead := q.Expire(ptr.To[int32](1)); len(dead) != 1 || dead[0].ID() != "1" {
+		t.Errorf("Expire() = %v, want event 1", dead)
+	}
+	if got := q.Len(); got != 1 {
+		t.Errorf("Len() = %d, want 1", got)
+	}
+}
+
+func newEvent(id string) *cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID(id)
+	event.SetType("type")
+	event.SetSource("source")
+	return &event
+}
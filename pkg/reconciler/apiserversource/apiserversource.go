@@ -381,6 +381,8 @@ func (r *Reconciler) createCloudEventAttributes(src *v1.ApiServerSource) ([]duck
 		eventTypes = apisources.ApiServerSourceEventReferenceModeTypes
 	} else if src.Spec.EventMode == v1.ResourceMode {
 		eventTypes = apisources.ApiServerSourceEventResourceModeTypes
+	} else if src.Spec.EventMode == v1.DiffMode {
+		eventTypes = apisources.ApiServerSourceEventDiffModeTypes
 	} else {
 		return []duckv1.CloudEventAttributes{}, fmt.Errorf("no EventType available for EventMode: %s", src.Spec.EventMode)
 	}
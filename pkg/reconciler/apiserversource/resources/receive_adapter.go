@@ -137,6 +137,8 @@ func makeEnv(args *ReceiveAdapterArgs) ([]corev1.EnvVar, error) {
 		Resources:     make([]apiserver.ResourceWatch, 0, len(args.Source.Spec.Resources)),
 		ResourceOwner: args.Source.Spec.ResourceOwner,
 		EventMode:     args.Source.Spec.EventMode,
+		Diff:          args.Source.Spec.Diff,
+		Checkpoint:    args.Source.Spec.Checkpoint,
 		AllNamespaces: args.AllNamespaces,
 		Filters:       args.Source.Spec.Filters,
 		FailFast:      args.FailFast,
@@ -149,7 +151,11 @@ func makeEnv(args *ReceiveAdapterArgs) ([]corev1.EnvVar, error) {
 		}
 		gvr, _ := meta.UnsafeGuessKindToResource(gv.WithKind(r.Kind))
 
-		rw := apiserver.ResourceWatch{GVR: gvr}
+		rw := apiserver.ResourceWatch{
+			GVR:           gvr,
+			FieldSelector: r.FieldSelector,
+			Predicate:     r.Predicate,
+		}
 
 		if r.LabelSelector != nil {
 			selector, _ := metav1.LabelSelectorAsSelector(r.LabelSelector)
@@ -84,7 +84,9 @@ type Reconciler struct {
 	// If specified, only reconcile brokers with these labels
 	brokerClass string
 
-	eventPolicyLister eventingv1alpha1listers.EventPolicyLister
+	eventPolicyLister        eventingv1alpha1listers.EventPolicyLister
+	clusterEventPolicyLister eventingv1alpha1listers.ClusterEventPolicyLister
+	namespaceLister          corev1listers.NamespaceLister
 }
 
 // Check that our Reconciler implements Interface
@@ -272,7 +274,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, b *eventingv1.Broker) pk
 
 	b.GetConditionSet().Manage(b.GetStatus()).MarkTrue(eventingv1.BrokerConditionAddressable)
 
-	err = auth.UpdateStatusWithEventPolicies(featureFlags, &b.Status.AppliedEventPoliciesStatus, &b.Status, r.eventPolicyLister, eventingv1.SchemeGroupVersion.WithKind("Broker"), b.ObjectMeta)
+	err = auth.UpdateStatusWithEventPolicies(featureFlags, &b.Status.AppliedEventPoliciesStatus, &b.Status, r.eventPolicyLister, r.clusterEventPolicyLister, r.namespaceLister, eventingv1.SchemeGroupVersion.WithKind("Broker"), b.ObjectMeta)
 	if err != nil {
 		return fmt.Errorf("could not update broker status with EventPolicies: %v", err)
 	}
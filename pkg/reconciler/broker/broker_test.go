@@ -1102,15 +1102,17 @@ func TestReconcile(t *testing.T) {
 		}
 
 		r := &Reconciler{
-			eventingClientSet:  fakeeventingclient.Get(ctx),
-			dynamicClientSet:   fakedynamicclient.Get(ctx),
-			subscriptionLister: listers.GetSubscriptionLister(),
-			endpointsLister:    listers.GetEndpointsLister(),
-			configmapLister:    listers.GetConfigMapLister(),
-			secretLister:       listers.GetSecretLister(),
-			channelableTracker: duck.NewListableTrackerFromTracker(ctx, channelable.Get, tracker.New(func(types.NamespacedName) {}, 0)),
-			uriResolver:        resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0)),
-			eventPolicyLister:  listers.GetEventPolicyLister(),
+			eventingClientSet:        fakeeventingclient.Get(ctx),
+			dynamicClientSet:         fakedynamicclient.Get(ctx),
+			subscriptionLister:       listers.GetSubscriptionLister(),
+			endpointsLister:          listers.GetEndpointsLister(),
+			configmapLister:          listers.GetConfigMapLister(),
+			secretLister:             listers.GetSecretLister(),
+			channelableTracker:       duck.NewListableTrackerFromTracker(ctx, channelable.Get, tracker.New(func(types.NamespacedName) {}, 0)),
+			uriResolver:              resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0)),
+			eventPolicyLister:        listers.GetEventPolicyLister(),
+			clusterEventPolicyLister: listers.GetClusterEventPolicyLister(),
+			namespaceLister:          listers.GetNamespaceLister(),
 		}
 		return broker.NewReconciler(ctx, logger,
 			fakeeventingclient.Get(ctx), listers.GetBrokerLister(),
@@ -25,6 +25,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"knative.dev/pkg/apis"
 	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap"
+	namespaceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection/clients/dynamicclient"
@@ -41,6 +42,7 @@ import (
 	eventingclient "knative.dev/eventing/pkg/client/injection/client"
 	"knative.dev/eventing/pkg/client/injection/ducks/duck/v1/channelable"
 	brokerinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1/broker"
+	clustereventpolicyinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy"
 	eventpolicyinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
 	subscriptioninformer "knative.dev/eventing/pkg/client/injection/informers/messaging/v1/subscription"
 	brokerreconciler "knative.dev/eventing/pkg/client/injection/reconciler/eventing/v1/broker"
@@ -74,6 +76,8 @@ func NewController(
 	configmapInformer := configmapinformer.Get(ctx)
 	secretInformer := secretinformer.Get(ctx)
 	eventPolicyInformer := eventpolicyinformer.Get(ctx)
+	clusterEventPolicyInformer := clustereventpolicyinformer.Get(ctx)
+	namespaceInformer := namespaceinformer.Get(ctx)
 
 	var globalResync func(obj interface{})
 
@@ -95,14 +99,16 @@ func NewController(
 	brokerFilter := pkgreconciler.AnnotationFilterFunc(brokerreconciler.ClassAnnotationKey, eventing.MTChannelBrokerClassValue, false /*allowUnset*/)
 
 	r := &Reconciler{
-		eventingClientSet:  eventingclient.Get(ctx),
-		dynamicClientSet:   dynamicclient.Get(ctx),
-		endpointsLister:    endpointsInformer.Lister(),
-		subscriptionLister: subscriptionInformer.Lister(),
-		brokerClass:        eventing.MTChannelBrokerClassValue,
-		configmapLister:    configmapInformer.Lister(),
-		secretLister:       secretInformer.Lister(),
-		eventPolicyLister:  eventPolicyInformer.Lister(),
+		eventingClientSet:        eventingclient.Get(ctx),
+		dynamicClientSet:         dynamicclient.Get(ctx),
+		endpointsLister:          endpointsInformer.Lister(),
+		subscriptionLister:       subscriptionInformer.Lister(),
+		brokerClass:              eventing.MTChannelBrokerClassValue,
+		configmapLister:          configmapInformer.Lister(),
+		secretLister:             secretInformer.Lister(),
+		eventPolicyLister:        eventPolicyInformer.Lister(),
+		clusterEventPolicyLister: clusterEventPolicyInformer.Lister(),
+		namespaceLister:          namespaceInformer.Lister(),
 	}
 	impl := brokerreconciler.NewImpl(ctx, r, eventing.MTChannelBrokerClassValue, func(impl *controller.Impl) controller.Options {
 		return controller.Options{
@@ -153,5 +159,23 @@ func NewController(
 	// or got updated and now is referencing the Broker
 	eventPolicyInformer.Informer().AddEventHandler(auth.EventPolicyEventHandler(brokerInformer.Informer().GetIndexer(), brokerGK, impl.EnqueueKey))
 
+	// Enqueue the Broker, if we have a ClusterEventPolicy which was applying
+	// or got updated and now is applying to the Broker
+	clusterEventPolicyInformer.Informer().AddEventHandler(auth.ClusterEventPolicyEventHandler(
+		brokerInformer.Informer().GetIndexer(),
+		brokerGK,
+		namespaceInformer.Lister(),
+		impl.EnqueueKey,
+	))
+
+	// Enqueue the Broker, if the labels of its namespace got updated and a ClusterEventPolicy
+	// started or stopped applying to the Broker
+	namespaceInformer.Informer().AddEventHandler(auth.ClusterEventPolicyNamespaceEventHandler(
+		clusterEventPolicyInformer.Lister(),
+		brokerInformer.Informer().GetIndexer(),
+		brokerGK,
+		impl.EnqueueKey,
+	))
+
 	return impl
 }
@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/dynamic"
+	corev1listers "k8s.io/client-go/listers/core/v1"
 	"knative.dev/eventing/pkg/apis/feature"
 	"knative.dev/eventing/pkg/auth"
 	"knative.dev/pkg/kmeta"
@@ -58,7 +59,9 @@ type Reconciler struct {
 	// dynamicClientSet allows us to configure pluggable Build objects
 	dynamicClientSet dynamic.Interface
 
-	eventPolicyLister eventingv1alpha1listers.EventPolicyLister
+	eventPolicyLister        eventingv1alpha1listers.EventPolicyLister
+	clusterEventPolicyLister eventingv1alpha1listers.ClusterEventPolicyLister
+	namespaceLister          corev1listers.NamespaceLister
 
 	eventingClientSet eventingclientset.Interface
 }
@@ -112,7 +115,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, c *v1.Channel) pkgreconc
 		c.Status.MarkDeadLetterSinkNotConfigured()
 	}
 
-	err = auth.UpdateStatusWithEventPolicies(featureFlags, &c.Status.AppliedEventPoliciesStatus, &c.Status, r.eventPolicyLister, v1.SchemeGroupVersion.WithKind("Channel"), c.ObjectMeta)
+	err = auth.UpdateStatusWithEventPolicies(featureFlags, &c.Status.AppliedEventPoliciesStatus, &c.Status, r.eventPolicyLister, r.clusterEventPolicyLister, r.namespaceLister, v1.SchemeGroupVersion.WithKind("Channel"), c.ObjectMeta)
 	if err != nil {
 		return fmt.Errorf("could not update channel status with EventPolicies: %v", err)
 	}
@@ -132,12 +135,24 @@ func (r *Reconciler) reconcileBackingChannelEventPolicies(ctx context.Context, c
 	}
 
 	for _, policy := range applyingEventPoliciesForChannel {
-		err := r.reconcileBackingChannelEventPolicy(ctx, backingChannel, policy)
+		err := r.reconcileBackingChannelEventPolicy(ctx, resources.MakeEventPolicyForBackingChannel(backingChannel, policy))
 		if err != nil {
 			return fmt.Errorf("could not reconcile EventPolicy %s/%s for backing channel %s/%s: %w", policy.Namespace, policy.Name, backingChannel.Namespace, backingChannel.Name, err)
 		}
 	}
 
+	applyingClusterEventPoliciesForChannel, err := auth.GetClusterEventPoliciesForResource(r.clusterEventPolicyLister, r.namespaceLister, v1.SchemeGroupVersion.WithKind("Channel"), channel.ObjectMeta)
+	if err != nil {
+		return fmt.Errorf("could not get applying ClusterEventPolicies for channel %s/%s: %w", channel.Namespace, channel.Name, err)
+	}
+
+	for _, policy := range applyingClusterEventPoliciesForChannel {
+		err := r.reconcileBackingChannelEventPolicy(ctx, resources.MakeEventPolicyForBackingChannelFromClusterEventPolicy(backingChannel, policy))
+		if err != nil {
+			return fmt.Errorf("could not reconcile ClusterEventPolicy %s for backing channel %s/%s: %w", policy.Name, backingChannel.Namespace, backingChannel.Name, err)
+		}
+	}
+
 	// Check, if we have old EP for the backing channel, which are not relevant anymore
 	applyingEventPoliciesForBackingChannel, err := auth.GetEventPoliciesForResource(r.eventPolicyLister, backingChannel.GroupVersionKind(), backingChannel.ObjectMeta)
 	if err != nil {
@@ -168,9 +183,7 @@ func (r *Reconciler) reconcileBackingChannelEventPolicies(ctx context.Context, c
 	return nil
 }
 
-func (r *Reconciler) reconcileBackingChannelEventPolicy(ctx context.Context, backingChannel *eventingduckv1.Channelable, eventpolicy *eventingv1alpha1.EventPolicy) error {
-	expected := resources.MakeEventPolicyForBackingChannel(backingChannel, eventpolicy)
-
+func (r *Reconciler) reconcileBackingChannelEventPolicy(ctx context.Context, expected *eventingv1alpha1.EventPolicy) error {
 	foundEP, err := r.eventPolicyLister.EventPolicies(expected.Namespace).Get(expected.Name)
 	if apierrs.IsNotFound(err) {
 		_, err := r.eventingClientSet.EventingV1alpha1().EventPolicies(expected.Namespace).Create(ctx, expected, metav1.CreateOptions{})
@@ -56,8 +56,9 @@ const (
 	testNS      = "test-namespace"
 	channelName = "test-channel"
 
-	readyEventPolicyName   = "test-event-policy-ready"
-	unreadyEventPolicyName = "test-event-policy-unready"
+	readyEventPolicyName        = "test-event-policy-ready"
+	unreadyEventPolicyName      = "test-event-policy-unready"
+	readyClusterEventPolicyName = "test-cluster-event-policy-ready"
 )
 
 var (
@@ -596,6 +597,64 @@ func TestReconcile(t *testing.T) {
 				}),
 			),
 		},
+	}, {
+		Name: "should create EventPolicies for backing channel from ClusterEventPolicies",
+		Key:  testKey,
+		Objects: []runtime.Object{
+			NewNamespace(testNS, WithNamespaceLabeled(map[string]string{"team": "a"})),
+			NewChannel(channelName, testNS,
+				WithChannelTemplate(channelCRD()),
+				WithInitChannelConditions,
+				WithChannelEventPoliciesReady(),
+				WithChannelClusterEventPoliciesListed(readyClusterEventPolicyName)),
+			NewInMemoryChannel(channelName, testNS,
+				WithInitInMemoryChannelConditions,
+				WithInMemoryChannelDeploymentReady(),
+				WithInMemoryChannelServiceReady(),
+				WithInMemoryChannelEndpointsReady(),
+				WithInMemoryChannelChannelServiceReady(),
+				WithInMemoryChannelAddress(backingChannelAddressable),
+				WithInMemoryChannelDLSUnknown(),
+				WithInMemoryChannelEventPoliciesReady()),
+			NewClusterEventPolicy(readyClusterEventPolicyName,
+				WithReadyClusterEventPolicyCondition,
+				WithClusterEventPolicyNamespaceSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}),
+				WithClusterEventPolicyToSelector(channelV1GVK, &metav1.LabelSelector{}),
+			),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: NewChannel(channelName, testNS,
+				WithChannelTemplate(channelCRD()),
+				WithInitChannelConditions,
+				WithBackingChannelObjRef(backingChannelObjRef()),
+				WithBackingChannelReady,
+				WithChannelDLSUnknown(),
+				WithChannelAddress(&backingChannelAddressable),
+				WithChannelEventPoliciesReady(),
+				WithChannelClusterEventPoliciesListed(readyClusterEventPolicyName)),
+		}},
+		WantCreates: []runtime.Object{
+			NewEventPolicy(fmt.Sprintf("cluster-%s-%s", readyClusterEventPolicyName, channelName), testNS,
+				WithEventPolicyToRef(imcV1GVK, channelName),
+				WithEventPolicyOwnerReferences([]metav1.OwnerReference{
+					{
+						APIVersion: v1.SchemeGroupVersion.String(),
+						Kind:       "InMemoryChannel",
+						Name:       channelName,
+					}, {
+						APIVersion: eventingv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "ClusterEventPolicy",
+						Name:       readyClusterEventPolicyName,
+					},
+				}...),
+				WithEventPolicyLabels(map[string]string{
+					"messaging.knative.dev/channel-group":   v1.SchemeGroupVersion.Group,
+					"messaging.knative.dev/channel-version": v1.SchemeGroupVersion.Version,
+					"messaging.knative.dev/channel-kind":    "InMemoryChannel",
+					"messaging.knative.dev/channel-name":    channelName,
+				}),
+			),
+		},
 	}}
 
 	logger := logtesting.TestLogger(t)
@@ -603,11 +662,13 @@ func TestReconcile(t *testing.T) {
 		ctx = channelable.WithDuck(ctx)
 		ctx = v1addr.WithDuck(ctx)
 		r := &Reconciler{
-			dynamicClientSet:   fakedynamicclient.Get(ctx),
-			channelLister:      listers.GetMessagingChannelLister(),
-			channelableTracker: &fakeListableTracker{duck.NewListableTrackerFromTracker(ctx, channelable.Get, tracker.New(func(types.NamespacedName) {}, 0))},
-			eventPolicyLister:  listers.GetEventPolicyLister(),
-			eventingClientSet:  fakeeventingclient.Get(ctx),
+			dynamicClientSet:         fakedynamicclient.Get(ctx),
+			channelLister:            listers.GetMessagingChannelLister(),
+			channelableTracker:       &fakeListableTracker{duck.NewListableTrackerFromTracker(ctx, channelable.Get, tracker.New(func(types.NamespacedName) {}, 0))},
+			eventPolicyLister:        listers.GetEventPolicyLister(),
+			clusterEventPolicyLister: listers.GetClusterEventPolicyLister(),
+			namespaceLister:          listers.GetNamespaceLister(),
+			eventingClientSet:        fakeeventingclient.Get(ctx),
 		}
 		return channelreconciler.NewReconciler(ctx, logger,
 			fakeeventingclient.Get(ctx), listers.GetMessagingChannelLister(),
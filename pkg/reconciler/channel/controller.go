@@ -25,12 +25,14 @@ import (
 	"knative.dev/eventing/pkg/apis/feature"
 	"knative.dev/pkg/logging"
 
+	namespaceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection/clients/dynamicclient"
 
 	eventingclient "knative.dev/eventing/pkg/client/injection/client"
 	"knative.dev/eventing/pkg/client/injection/ducks/duck/v1/channelable"
+	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy"
 	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
 	channelinformer "knative.dev/eventing/pkg/client/injection/informers/messaging/v1/channel"
 	channelreconciler "knative.dev/eventing/pkg/client/injection/reconciler/messaging/v1/channel"
@@ -45,12 +47,16 @@ func NewController(
 ) *controller.Impl {
 	channelInformer := channelinformer.Get(ctx)
 	eventPolicyInformer := eventpolicy.Get(ctx)
+	clusterEventPolicyInformer := clustereventpolicy.Get(ctx)
+	namespaceInformer := namespaceinformer.Get(ctx)
 
 	r := &Reconciler{
-		dynamicClientSet:  dynamicclient.Get(ctx),
-		channelLister:     channelInformer.Lister(),
-		eventPolicyLister: eventPolicyInformer.Lister(),
-		eventingClientSet: eventingclient.Get(ctx),
+		dynamicClientSet:         dynamicclient.Get(ctx),
+		channelLister:            channelInformer.Lister(),
+		eventPolicyLister:        eventPolicyInformer.Lister(),
+		clusterEventPolicyLister: clusterEventPolicyInformer.Lister(),
+		namespaceLister:          namespaceInformer.Lister(),
+		eventingClientSet:        eventingclient.Get(ctx),
 	}
 
 	var globalResync func()
@@ -81,5 +87,23 @@ func NewController(
 	// or got updated and now is referencing the Channel
 	eventPolicyInformer.Informer().AddEventHandler(auth.EventPolicyEventHandler(channelInformer.Informer().GetIndexer(), channelGK, impl.EnqueueKey))
 
+	// Enqueue the Channel, if we have a ClusterEventPolicy which was applying
+	// or got updated and now is applying to the Channel
+	clusterEventPolicyInformer.Informer().AddEventHandler(auth.ClusterEventPolicyEventHandler(
+		channelInformer.Informer().GetIndexer(),
+		channelGK,
+		namespaceInformer.Lister(),
+		impl.EnqueueKey,
+	))
+
+	// Enqueue the Channel, if the labels of its namespace got updated and a ClusterEventPolicy
+	// started or stopped applying to the Channel
+	namespaceInformer.Informer().AddEventHandler(auth.ClusterEventPolicyNamespaceEventHandler(
+		clusterEventPolicyInformer.Lister(),
+		channelInformer.Informer().GetIndexer(),
+		channelGK,
+		impl.EnqueueKey,
+	))
+
 	return impl
 }
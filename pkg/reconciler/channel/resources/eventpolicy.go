@@ -67,6 +67,46 @@ func MakeEventPolicyForBackingChannel(backingChannel *eventingduckv1.Channelable
 	}
 }
 
+// MakeEventPolicyForBackingChannelFromClusterEventPolicy creates an EventPolicy for the backing channel
+// of a Channel, to which the given ClusterEventPolicy applies.
+func MakeEventPolicyForBackingChannelFromClusterEventPolicy(backingChannel *eventingduckv1.Channelable, parentPolicy *eventingv1alpha1.ClusterEventPolicy) *eventingv1alpha1.EventPolicy {
+	parentPolicy = parentPolicy.DeepCopy()
+
+	return &eventingv1alpha1.EventPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: backingChannel.Namespace,
+			Name:      kmeta.ChildName(fmt.Sprintf("cluster-%s-", parentPolicy.Name), backingChannel.Name),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: backingChannel.APIVersion,
+					Kind:       backingChannel.Kind,
+					Name:       backingChannel.Name,
+					UID:        backingChannel.UID,
+				}, {
+					APIVersion: parentPolicy.GetGroupVersionKind().GroupVersion().String(),
+					Kind:       parentPolicy.GetGroupVersionKind().Kind,
+					Name:       parentPolicy.Name,
+					UID:        parentPolicy.UID,
+				},
+			},
+			Labels: LabelsForBackingChannelsEventPolicy(backingChannel),
+		},
+		Spec: eventingv1alpha1.EventPolicySpec{
+			To: []eventingv1alpha1.EventPolicySpecTo{
+				{
+					Ref: &eventingv1alpha1.EventPolicyToReference{
+						APIVersion: backingChannel.APIVersion,
+						Kind:       backingChannel.Kind,
+						Name:       backingChannel.Name,
+					},
+				},
+			},
+			From:    parentPolicy.Spec.From,
+			Filters: parentPolicy.Spec.Filters,
+		},
+	}
+}
+
 func LabelsForBackingChannelsEventPolicy(backingChannel *eventingduckv1.Channelable) map[string]string {
 	return map[string]string{
 		BackingChannelEventPolicyLabelPrefix + "channel-group":   backingChannel.GroupVersionKind().Group,
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustereventpolicy
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/eventing/pkg/auth"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/resolver"
+)
+
+type Reconciler struct {
+	authResolver *resolver.AuthenticatableResolver
+}
+
+// ReconcileKind implements Interface.ReconcileKind.
+// 1. Verify the Reference exists.
+func (r *Reconciler) ReconcileKind(ctx context.Context, cep *v1alpha1.ClusterEventPolicy) pkgreconciler.Event {
+	featureFlags := feature.FromContext(ctx)
+	if featureFlags.IsOIDCAuthentication() {
+		cep.Status.MarkOIDCAuthenticationEnabled()
+	} else {
+		cep.Status.MarkOIDCAuthenticationDisabled("OIDCAuthenticationDisabled", "")
+		return nil
+	}
+	// We reconcile the status of the ClusterEventPolicy the same way as of an EventPolicy
+	// by looking at all .spec.from[].refs and .spec.deny[].from[].refs have subjects
+	subjects, err := auth.ResolveClusterSubjects(r.authResolver, cep)
+	if err != nil {
+		cep.Status.MarkSubjectsResolvedFailed("SubjectsNotResolved", err.Error())
+		return fmt.Errorf("failed to resolve .spec.from[].ref: %w", err)
+	}
+	denied, err := auth.ResolveClusterDenySubjects(r.authResolver, cep)
+	if err != nil {
+		cep.Status.MarkSubjectsResolvedFailed("SubjectsNotResolved", err.Error())
+		return fmt.Errorf("failed to resolve .spec.deny[].from[].ref: %w", err)
+	}
+	cep.Status.MarkSubjectsResolvedSucceeded()
+	cep.Status.From = subjects
+	cep.Status.Deny = denied
+	return nil
+}
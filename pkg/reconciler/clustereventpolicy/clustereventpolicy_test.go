@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustereventpolicy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgotesting "k8s.io/client-go/testing"
+	"knative.dev/eventing/pkg/apis/feature"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	fakeeventingclient "knative.dev/eventing/pkg/client/injection/client/fake"
+	"knative.dev/eventing/pkg/client/injection/reconciler/eventing/v1alpha1/clustereventpolicy"
+	. "knative.dev/eventing/pkg/reconciler/testing/v1"
+	duckv1authstatus "knative.dev/pkg/client/injection/ducks/duck/v1/authstatus"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	logtesting "knative.dev/pkg/logging/testing"
+	. "knative.dev/pkg/reconciler/testing"
+	"knative.dev/pkg/resolver"
+	"knative.dev/pkg/tracker"
+)
+
+const (
+	testNS                 = "test-namespace"
+	clusterEventPolicyName = "test-clustereventpolicy"
+	pingSourceName         = "test-pingsource"
+	serviceAccountname     = "test-sa"
+)
+
+var (
+	pingSourceWithServiceAccount    = NewPingSource(pingSourceName, testNS, WithPingSourceOIDCServiceAccountName(serviceAccountname))
+	pingSourceGVK                   = v1.GroupVersionKind(sourcesv1.SchemeGroupVersion.WithKind("PingSource"))
+	SubjectsNotResolvedErrorMessage = fmt.Sprintf("could not resolve subjects from reference: could not resolve auth status: failed to get authenticatable %s/%s: failed to get object %s/%s: pingsources.sources.knative.dev \"%s\" not found", testNS, pingSourceName, testNS, pingSourceName, pingSourceName)
+	SubjectsNotResolvedEventMessage = fmt.Sprintf("Warning InternalError failed to resolve .spec.from[].ref: %s", SubjectsNotResolvedErrorMessage)
+)
+
+func TestReconcile(t *testing.T) {
+	table := TableTest{
+		{
+			Name: "bad workqueue key",
+			// Make sure Reconcile handles bad keys.
+			Key: "too/many/parts",
+		}, {
+			Name: "with oidc disabled, status set to NotReady",
+			Ctx: feature.ToContext(context.TODO(), feature.Flags{
+				feature.OIDCAuthentication: feature.Disabled,
+			}),
+			Key: clusterEventPolicyName,
+			Objects: []runtime.Object{
+				NewClusterEventPolicy(clusterEventPolicyName,
+					WithInitClusterEventPolicyConditions,
+					WithClusterEventPolicyFrom(pingSourceGVK, pingSourceName, testNS),
+				),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewClusterEventPolicy(clusterEventPolicyName,
+						WithInitClusterEventPolicyConditions,
+						WithClusterEventPolicyFrom(pingSourceGVK, pingSourceName, testNS),
+						WithClusterEventPolicyAuthenticationDisabled,
+					),
+				},
+			},
+		}, {
+			Name: "subject not found, status set to NotReady",
+			Ctx: feature.ToContext(context.TODO(), feature.Flags{
+				feature.OIDCAuthentication: feature.Enabled,
+			}),
+			Key: clusterEventPolicyName,
+			Objects: []runtime.Object{
+				NewClusterEventPolicy(clusterEventPolicyName,
+					WithInitClusterEventPolicyConditions,
+					WithClusterEventPolicyFrom(pingSourceGVK, pingSourceName, testNS),
+				),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewClusterEventPolicy(clusterEventPolicyName,
+						WithInitClusterEventPolicyConditions,
+						WithClusterEventPolicyFrom(pingSourceGVK, pingSourceName, testNS),
+						WithClusterEventPolicySubjectsResolvedFailed("SubjectsNotResolved", SubjectsNotResolvedErrorMessage),
+					),
+				},
+			},
+			WantEvents: []string{SubjectsNotResolvedEventMessage},
+			WantErr:    true,
+		}, {
+			Name: "subject found for pingsource, status set to Ready",
+			Ctx: feature.ToContext(context.TODO(), feature.Flags{
+				feature.OIDCAuthentication: feature.Enabled,
+			}),
+			Key: clusterEventPolicyName,
+			Objects: []runtime.Object{
+				pingSourceWithServiceAccount,
+				NewClusterEventPolicy(clusterEventPolicyName,
+					WithInitClusterEventPolicyConditions,
+					WithClusterEventPolicyFrom(pingSourceGVK, pingSourceName, testNS),
+					WithClusterEventPolicyFromSub("system:serviceaccount:other-ns:*"),
+				),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewClusterEventPolicy(clusterEventPolicyName,
+						WithInitClusterEventPolicyConditions,
+						WithClusterEventPolicyFrom(pingSourceGVK, pingSourceName, testNS),
+						WithClusterEventPolicyFromSub("system:serviceaccount:other-ns:*"),
+						WithClusterEventPolicyStatusFromSub([]string{
+							fmt.Sprintf("system:serviceaccount:%s:%s", testNS, serviceAccountname),
+							"system:serviceaccount:other-ns:*",
+						}),
+						WithReadyClusterEventPolicyCondition,
+					),
+				},
+			},
+		},
+	}
+
+	logger := logtesting.TestLogger(t)
+	table.Test(t, MakeFactory(func(ctx context.Context, listers *Listers, cmw configmap.Watcher) controller.Reconciler {
+		ctx = duckv1authstatus.WithDuck(ctx)
+		r := &Reconciler{
+			authResolver: resolver.NewAuthenticatableResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0))}
+		return clustereventpolicy.NewReconciler(ctx, logger,
+			fakeeventingclient.Get(ctx), listers.GetClusterEventPolicyLister(),
+			controller.GetEventRecorder(ctx), r)
+	},
+		false,
+		logger,
+	))
+}
@@ -18,10 +18,14 @@ package eventpolicy
 
 import (
 	"context"
+	"os"
 
 	"knative.dev/eventing/pkg/apis/feature"
+	namespaceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
+	"knative.dev/pkg/injection/clients/dynamicclient"
 	"knative.dev/pkg/logging"
 
+	clustereventpolicyinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy"
 	eventpolicyinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
 	eventpolicyreconciler "knative.dev/eventing/pkg/client/injection/reconciler/eventing/v1alpha1/eventpolicy"
 	"knative.dev/pkg/configmap"
@@ -63,5 +67,16 @@ func NewController(
 	// Set up event handlers
 	eventPolicyInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
 
+	if port := os.Getenv(SimulatorPortEnvVar); port != "" {
+		go startSimulator(ctx, logging.FromContext(ctx), port, &simulatorHandler{
+			logger:                   logging.FromContext(ctx).Named("event-policy-simulator"),
+			eventPolicyLister:        eventPolicyInformer.Lister(),
+			clusterEventPolicyLister: clustereventpolicyinformer.Get(ctx).Lister(),
+			namespaceLister:          namespaceinformer.Get(ctx).Lister(),
+			dynamicClient:            dynamicclient.Get(ctx),
+			features:                 featureStore.Load,
+		})
+	}
+
 	return impl
 }
@@ -42,14 +42,20 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, ep *v1alpha1.EventPolicy
 		return nil
 	}
 	// We reconcile the status of the EventPolicy
-	// by looking at all .spec.from[].refs have subjects
+	// by looking at all .spec.from[].refs and .spec.deny[].from[].refs have subjects
 	// and accordingly set the eventpolicy status
 	subjects, err := auth.ResolveSubjects(r.authResolver, ep)
 	if err != nil {
 		ep.Status.MarkSubjectsResolvedFailed("SubjectsNotResolved", err.Error())
 		return fmt.Errorf("failed to resolve .spec.from[].ref: %w", err)
 	}
+	denied, err := auth.ResolveDenySubjects(r.authResolver, ep)
+	if err != nil {
+		ep.Status.MarkSubjectsResolvedFailed("SubjectsNotResolved", err.Error())
+		return fmt.Errorf("failed to resolve .spec.deny[].from[].ref: %w", err)
+	}
 	ep.Status.MarkSubjectsResolvedSucceeded()
 	ep.Status.From = subjects
+	ep.Status.Deny = denied
 	return nil
 }
@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgotesting "k8s.io/client-go/testing"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/eventing/pkg/apis/feature"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 	fakeeventingclient "knative.dev/eventing/pkg/client/injection/client/fake"
@@ -224,6 +225,41 @@ func TestReconcile(t *testing.T) {
 			},
 			WantErr: false,
 		},
+		{
+			Name: "Deny subjects resolved, status set to Ready",
+			Ctx: feature.ToContext(context.TODO(), feature.Flags{
+				feature.OIDCAuthentication: feature.Enabled,
+			}),
+			Key: testNS + "/" + eventPolicyName,
+			Objects: []runtime.Object{
+				NewEventPolicy(eventPolicyName, testNS,
+					WithInitEventPolicyConditions,
+					WithEventPolicyFromSub(fmt.Sprintf("system:serviceaccount:%s*", testNS)),
+					WithEventPolicyDenySub(fmt.Sprintf("system:serviceaccount:%s:%s", testNS, serviceAccountname)),
+					WithEventPolicyDenyFilter(eventingv1.SubscriptionsAPIFilter{CESQL: "type = 'unwanted'"}),
+				),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewEventPolicy(eventPolicyName, testNS,
+						WithEventPolicyFromSub(fmt.Sprintf("system:serviceaccount:%s*", testNS)),
+						WithEventPolicyDenySub(fmt.Sprintf("system:serviceaccount:%s:%s", testNS, serviceAccountname)),
+						WithEventPolicyDenyFilter(eventingv1.SubscriptionsAPIFilter{CESQL: "type = 'unwanted'"}),
+						WithEventPolicyStatusFromSub([]string{
+							fmt.Sprintf("system:serviceaccount:%s*", testNS),
+						}),
+						WithEventPolicyStatusDenySub([]string{
+							fmt.Sprintf("system:serviceaccount:%s:%s", testNS, serviceAccountname),
+						}),
+						WithEventPolicyStatusDenySub([]string{"*"}),
+						WithEventPolicyAuthenticationEnabledCondition,
+						WithReadyEventPolicyCondition,
+						WithEventPolicySubjectsResolvedSucceeded,
+					),
+				},
+			},
+			WantErr: false,
+		},
 
 		// test cases for authentication-oidc feature disabled afterwards
 		{
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"knative.dev/pkg/apis"
+
+	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/eventing/pkg/auth"
+	listerseventingv1alpha1 "knative.dev/eventing/pkg/client/listers/eventing/v1alpha1"
+)
+
+// SimulatorPortEnvVar is the env var holding the port of the event policy simulator endpoint.
+// The endpoint is disabled if it is not set. As it is unauthenticated, it only listens on localhost
+// and is reached through kubectl port-forward.
+const SimulatorPortEnvVar = "EVENT_POLICY_SIMULATOR_PORT"
+
+// simulatorHandler evaluates SimulationRequests against the event policies applying to their target
+type simulatorHandler struct {
+	logger                   *zap.SugaredLogger
+	eventPolicyLister        listerseventingv1alpha1.EventPolicyLister
+	clusterEventPolicyLister listerseventingv1alpha1.ClusterEventPolicyLister
+	namespaceLister          corev1listers.NamespaceLister
+	dynamicClient            dynamic.Interface
+	features                 func() feature.Flags
+}
+
+func (h *simulatorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	request := &auth.SimulationRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		http.Error(w, fmt.Sprintf("failed to decode simulation request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if err := validateSimulationRequest(request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	gvr := apis.KindToResource(request.Target.GroupVersionKind())
+	target, err := h.dynamicClient.Resource(gvr).Namespace(request.Target.Namespace).Get(r.Context(), request.Target.Name, metav1.GetOptions{})
+	if apierrs.IsNotFound(err) {
+		http.Error(w, fmt.Sprintf("target %s %s/%s not found", request.Target.Kind, request.Target.Namespace, request.Target.Name), http.StatusNotFound)
+		return
+	} else if err != nil {
+		h.logger.Errorw("Failed to get simulation target", zap.Error(err))
+		http.Error(w, fmt.Sprintf("failed to get target: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	targetObjectMeta := metav1.ObjectMeta{
+		Name:      target.GetName(),
+		Namespace: target.GetNamespace(),
+		Labels:    target.GetLabels(),
+	}
+
+	result, err := auth.Simulate(r.Context(), h.features(), h.eventPolicyLister, h.clusterEventPolicyLister, h.namespaceLister, request, targetObjectMeta, h.logger)
+	if err != nil {
+		h.logger.Errorw("Failed to simulate event policies", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		h.logger.Errorw("Failed to write simulation result", zap.Error(err))
+	}
+}
+
+func validateSimulationRequest(request *auth.SimulationRequest) error {
+	if request.Target.APIVersion == "" || request.Target.Kind == "" || request.Target.Name == "" || request.Target.Namespace == "" {
+		return errors.New("target apiVersion, kind, name and namespace are required")
+	}
+	if request.Sub == "" {
+		return errors.New("sub is required")
+	}
+	return nil
+}
+
+// startSimulator serves the simulator endpoint on the given port of localhost until the context is done
+func startSimulator(ctx context.Context, logger *zap.SugaredLogger, port string, handler http.Handler) {
+	mux := http.NewServeMux()
+	mux.Handle(auth.SimulationPath, handler)
+
+	server := &http.Server{
+		Addr:              net.JoinHostPort("localhost", port),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	logger.Infow("Starting event policy simulator", zap.String("address", server.Addr))
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Errorw("Event policy simulator failed", zap.Error(err))
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventpolicy
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/eventing/pkg/auth"
+	"knative.dev/eventing/pkg/client/clientset/versioned/scheme"
+	clustereventpolicyinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy/fake"
+	eventpolicyinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy/fake"
+	. "knative.dev/eventing/pkg/reconciler/testing/v1"
+	namespaceinformerfake "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace/fake"
+	fakedynamicclient "knative.dev/pkg/injection/clients/dynamicclient/fake"
+	reconcilertesting "knative.dev/pkg/reconciler/testing"
+)
+
+func TestSimulatorHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		body       string
+		features   feature.Flags
+		wantStatus int
+		wantResult *auth.SimulationResult
+	}{
+		{
+			name:       "method not allowed",
+			method:     http.MethodGet,
+			wantStatus: http.StatusMethodNotAllowed,
+		}, {
+			name:       "invalid request",
+			method:     http.MethodPost,
+			body:       `{"target": {"kind": "Broker"}}`,
+			wantStatus: http.StatusBadRequest,
+		}, {
+			name:       "target not found",
+			method:     http.MethodPost,
+			body:       `{"target": {"apiVersion": "eventing.knative.dev/v1", "kind": "Broker", "name": "other-broker", "namespace": "test-namespace"}, "sub": "system:serviceaccount:test-namespace:my-sa"}`,
+			wantStatus: http.StatusNotFound,
+		}, {
+			name:       "denied by default authorization mode",
+			method:     http.MethodPost,
+			body:       `{"target": {"apiVersion": "eventing.knative.dev/v1", "kind": "Broker", "name": "my-broker", "namespace": "test-namespace"}, "sub": "system:serviceaccount:test-namespace:my-sa"}`,
+			features:   feature.Flags{feature.OIDCAuthentication: feature.Enabled, feature.AuthorizationDefaultMode: feature.AuthorizationDenyAll},
+			wantStatus: http.StatusOK,
+			wantResult: &auth.SimulationResult{
+				Decision: auth.Decision{Allowed: false},
+			},
+		}, {
+			name:       "allowed with oidc disabled",
+			method:     http.MethodPost,
+			body:       `{"target": {"apiVersion": "eventing.knative.dev/v1", "kind": "Broker", "name": "my-broker", "namespace": "test-namespace"}, "sub": "system:serviceaccount:test-namespace:my-sa"}`,
+			features:   feature.Flags{feature.OIDCAuthentication: feature.Disabled},
+			wantStatus: http.StatusOK,
+			wantResult: &auth.SimulationResult{
+				Decision: auth.Decision{Allowed: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := reconcilertesting.SetupFakeContext(t)
+			ctx, dynamicClient := fakedynamicclient.With(ctx, scheme.Scheme, NewBroker("my-broker", "test-namespace"))
+
+			handler := &simulatorHandler{
+				logger:                   zap.NewNop().Sugar(),
+				eventPolicyLister:        eventpolicyinformerfake.Get(ctx).Lister(),
+				clusterEventPolicyLister: clustereventpolicyinformerfake.Get(ctx).Lister(),
+				namespaceLister:          namespaceinformerfake.Get(ctx).Lister(),
+				dynamicClient:            dynamicClient,
+				features:                 func() feature.Flags { return tt.features },
+			}
+
+			req := httptest.NewRequest(tt.method, auth.SimulationPath, strings.NewReader(tt.body))
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			if recorder.Code != tt.wantStatus {
+				t.Fatalf("unexpected status %d, want %d: %s", recorder.Code, tt.wantStatus, recorder.Body.String())
+			}
+			if tt.wantResult == nil {
+				return
+			}
+
+			got := &auth.SimulationResult{}
+			if err := json.Unmarshal(recorder.Body.Bytes(), got); err != nil {
+				t.Fatalf("failed to decode result: %v", err)
+			}
+			if got.Allowed != tt.wantResult.Allowed {
+				t.Errorf("unexpected allowed %v, want %v (reason: %s)", got.Allowed, tt.wantResult.Allowed, got.Reason)
+			}
+		})
+	}
+}
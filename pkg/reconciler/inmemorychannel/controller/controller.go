@@ -33,6 +33,7 @@ import (
 	"knative.dev/pkg/resolver"
 
 	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy"
 	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
 	"knative.dev/eventing/pkg/client/injection/informers/messaging/v1/inmemorychannel"
 	inmemorychannelreconciler "knative.dev/eventing/pkg/client/injection/reconciler/messaging/v1/inmemorychannel"
@@ -41,6 +42,7 @@ import (
 
 	"knative.dev/pkg/client/injection/kube/informers/apps/v1/deployment"
 	"knative.dev/pkg/client/injection/kube/informers/core/v1/endpoints"
+	namespaceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
 	"knative.dev/pkg/client/injection/kube/informers/core/v1/service"
 	"knative.dev/pkg/client/injection/kube/informers/core/v1/serviceaccount"
 	"knative.dev/pkg/client/injection/kube/informers/rbac/v1/rolebinding"
@@ -69,17 +71,21 @@ func NewController(
 	roleBindingInformer := rolebinding.Get(ctx)
 	secretInformer := secretinformer.Get(ctx)
 	eventPolicyInformer := eventpolicy.Get(ctx)
+	clusterEventPolicyInformer := clustereventpolicy.Get(ctx)
+	namespaceInformer := namespaceinformer.Get(ctx)
 
 	r := &Reconciler{
-		kubeClientSet:        kubeclient.Get(ctx),
-		systemNamespace:      system.Namespace(),
-		deploymentLister:     deploymentInformer.Lister(),
-		serviceLister:        serviceInformer.Lister(),
-		endpointsLister:      endpointsInformer.Lister(),
-		serviceAccountLister: serviceAccountInformer.Lister(),
-		roleBindingLister:    roleBindingInformer.Lister(),
-		secretLister:         secretInformer.Lister(),
-		eventPolicyLister:    eventPolicyInformer.Lister(),
+		kubeClientSet:            kubeclient.Get(ctx),
+		systemNamespace:          system.Namespace(),
+		deploymentLister:         deploymentInformer.Lister(),
+		serviceLister:            serviceInformer.Lister(),
+		endpointsLister:          endpointsInformer.Lister(),
+		serviceAccountLister:     serviceAccountInformer.Lister(),
+		roleBindingLister:        roleBindingInformer.Lister(),
+		secretLister:             secretInformer.Lister(),
+		eventPolicyLister:        eventPolicyInformer.Lister(),
+		clusterEventPolicyLister: clusterEventPolicyInformer.Lister(),
+		namespaceLister:          namespaceInformer.Lister(),
 	}
 
 	env := &envConfig{}
@@ -151,6 +157,24 @@ func NewController(
 	// or got updated and now is referencing the InMemoryChannel
 	eventPolicyInformer.Informer().AddEventHandler(auth.EventPolicyEventHandler(inmemorychannelInformer.Informer().GetIndexer(), imcGK, impl.EnqueueKey))
 
+	// Enqueue the InMemoryChannel, if we have a ClusterEventPolicy which was applying
+	// or got updated and now is applying to the InMemoryChannel
+	clusterEventPolicyInformer.Informer().AddEventHandler(auth.ClusterEventPolicyEventHandler(
+		inmemorychannelInformer.Informer().GetIndexer(),
+		imcGK,
+		namespaceInformer.Lister(),
+		impl.EnqueueKey,
+	))
+
+	// Enqueue the InMemoryChannel, if the labels of its namespace got updated and a ClusterEventPolicy
+	// started or stopped applying to the InMemoryChannel
+	namespaceInformer.Informer().AddEventHandler(auth.ClusterEventPolicyNamespaceEventHandler(
+		clusterEventPolicyInformer.Lister(),
+		inmemorychannelInformer.Informer().GetIndexer(),
+		imcGK,
+		impl.EnqueueKey,
+	))
+
 	// Setup the watch on the config map of dispatcher config
 	configStore := config.NewEventDispatcherConfigStore(logging.FromContext(ctx))
 	configStore.WatchConfigs(cmw)
@@ -96,7 +96,9 @@ type Reconciler struct {
 
 	uriResolver *resolver.URIResolver
 
-	eventPolicyLister v1alpha1.EventPolicyLister
+	eventPolicyLister        v1alpha1.EventPolicyLister
+	clusterEventPolicyLister v1alpha1.ClusterEventPolicyLister
+	namespaceLister          corev1listers.NamespaceLister
 }
 
 // Check that our Reconciler implements Interface
@@ -235,7 +237,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, imc *v1.InMemoryChannel)
 
 	imc.GetConditionSet().Manage(imc.GetStatus()).MarkTrue(v1.InMemoryChannelConditionAddressable)
 
-	err = auth.UpdateStatusWithEventPolicies(featureFlags, &imc.Status.AppliedEventPoliciesStatus, &imc.Status, r.eventPolicyLister, v1.SchemeGroupVersion.WithKind("InMemoryChannel"), imc.ObjectMeta)
+	err = auth.UpdateStatusWithEventPolicies(featureFlags, &imc.Status.AppliedEventPoliciesStatus, &imc.Status, r.eventPolicyLister, r.clusterEventPolicyLister, r.namespaceLister, v1.SchemeGroupVersion.WithKind("InMemoryChannel"), imc.ObjectMeta)
 	if err != nil {
 		return fmt.Errorf("could not update InMemoryChannels status with EventPolicies: %v", err)
 	}
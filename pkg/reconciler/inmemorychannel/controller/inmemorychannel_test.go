@@ -774,14 +774,16 @@ func TestAllCases(t *testing.T) {
 		}
 
 		r := &Reconciler{
-			kubeClientSet:     fakekubeclient.Get(ctx),
-			systemNamespace:   testNS,
-			deploymentLister:  listers.GetDeploymentLister(),
-			serviceLister:     listers.GetServiceLister(),
-			endpointsLister:   listers.GetEndpointsLister(),
-			secretLister:      listers.GetSecretLister(),
-			eventPolicyLister: listers.GetEventPolicyLister(),
-			uriResolver:       resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0)),
+			kubeClientSet:            fakekubeclient.Get(ctx),
+			systemNamespace:          testNS,
+			deploymentLister:         listers.GetDeploymentLister(),
+			serviceLister:            listers.GetServiceLister(),
+			endpointsLister:          listers.GetEndpointsLister(),
+			secretLister:             listers.GetSecretLister(),
+			eventPolicyLister:        listers.GetEventPolicyLister(),
+			clusterEventPolicyLister: listers.GetClusterEventPolicyLister(),
+			namespaceLister:          listers.GetNamespaceLister(),
+			uriResolver:              resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0)),
 		}
 		return inmemorychannel.NewReconciler(ctx, logger,
 			fakeeventingclient.Get(ctx), listers.GetInMemoryChannelLister(),
@@ -896,6 +898,8 @@ func TestInNamespace(t *testing.T) {
 			roleBindingLister:          listers.GetRoleBindingLister(),
 			secretLister:               listers.GetSecretLister(),
 			eventPolicyLister:          listers.GetEventPolicyLister(),
+			clusterEventPolicyLister:   listers.GetClusterEventPolicyLister(),
+			namespaceLister:            listers.GetNamespaceLister(),
 			eventDispatcherConfigStore: eventDispatcherConfigStore,
 			uriResolver:                resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0)),
 		}
@@ -50,6 +50,7 @@ import (
 	"knative.dev/eventing/pkg/apis/eventing"
 	"knative.dev/eventing/pkg/apis/feature"
 	eventingclient "knative.dev/eventing/pkg/client/injection/client"
+	clustereventpolicyinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy"
 	eventpolicyinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
 	eventtypeinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1beta3/eventtype"
 	inmemorychannelinformer "knative.dev/eventing/pkg/client/injection/informers/messaging/v1/inmemorychannel"
@@ -135,22 +136,27 @@ func NewController(
 	})
 
 	featureStore.WatchConfigs(cmw)
+
+	eventDispatcher := kncloudevents.NewDispatcher(
+		clientConfig,
+		oidcTokenProvider,
+		kncloudevents.WithMeterProvider(mp),
+		kncloudevents.WithTraceProvider(tp),
+	)
+	authVerifier := auth.NewVerifier(ctx, eventpolicyinformer.Get(ctx).Lister(), clustereventpolicyinformer.Get(ctx).Lister(), trustBundleConfigMapLister, cmw)
+	authVerifier.SetAuditSender(eventDispatcher.AuditSender())
+
 	r := &Reconciler{
 		multiChannelEventHandler: sh,
 		messagingClientSet:       eventingclient.Get(ctx).MessagingV1(),
 		eventingClient:           eventingclient.Get(ctx).EventingV1beta3(),
 		eventTypeLister:          eventtypeinformer.Get(ctx).Lister(),
-		eventDispatcher: kncloudevents.NewDispatcher(
-			clientConfig,
-			oidcTokenProvider,
-			kncloudevents.WithMeterProvider(mp),
-			kncloudevents.WithTraceProvider(tp),
-		),
-		authVerifier:          auth.NewVerifier(ctx, eventpolicyinformer.Get(ctx).Lister(), trustBundleConfigMapLister, cmw),
-		clientConfig:          clientConfig,
-		inMemoryChannelLister: inmemorychannelInformer.Lister(),
-		meterProvider:         mp,
-		traceProvider:         tp,
+		eventDispatcher:          eventDispatcher,
+		authVerifier:             authVerifier,
+		clientConfig:             clientConfig,
+		inMemoryChannelLister:    inmemorychannelInformer.Lister(),
+		meterProvider:            mp,
+		traceProvider:            tp,
 	}
 
 	impl := inmemorychannelreconciler.NewImpl(ctx, r, func(impl *controller.Impl) controller.Options {
@@ -193,6 +199,7 @@ func NewController(
 	}
 	serverTLSConfig := eventingtls.NewDefaultServerConfig()
 	serverTLSConfig.GetCertificate = eventingtls.GetCertificateFromSecret(ctx, secretinformer.Get(ctx), kubeclient.Get(ctx), secret)
+	serverTLSConfig.RequestClientCertificates = true
 	tlsConfig, err := eventingtls.GetTLSServerConfig(serverTLSConfig)
 	if err != nil {
 		logger.Panicf("unable to get tls config: %s", err)
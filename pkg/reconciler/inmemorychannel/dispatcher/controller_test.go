@@ -28,6 +28,7 @@ import (
 	"knative.dev/eventing/pkg/apis/eventing"
 	"knative.dev/eventing/pkg/eventingtls"
 
+	kubeclient "knative.dev/pkg/client/injection/kube/client/fake"
 	filteredFactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
 
 	configmap "knative.dev/pkg/configmap/informer"
@@ -40,6 +41,7 @@ import (
 	_ "knative.dev/pkg/client/injection/kube/informers/factory/filtered/fake"
 	_ "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret/fake"
 
+	_ "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy/fake"
 	_ "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy/fake"
 	_ "knative.dev/eventing/pkg/client/injection/informers/eventing/v1beta3/eventtype/fake"
 	_ "knative.dev/eventing/pkg/client/injection/informers/messaging/v1/inmemorychannel/fake"
@@ -58,7 +60,7 @@ func TestNew(t *testing.T) {
 	os.Setenv("CONTAINER_NAME", "testcontainer")
 	os.Setenv("MAX_IDLE_CONNS", "2000")
 	os.Setenv("MAX_IDLE_CONNS_PER_HOST", "200")
-	c := NewController(ctx, &configmap.InformedWatcher{})
+	c := NewController(ctx, newConfigMapWatcher(ctx))
 
 	if c == nil {
 		t.Fatal("Expected NewController to return a non-nil value")
@@ -77,7 +79,7 @@ func TestNewInNamespace(t *testing.T) {
 	os.Setenv("CONTAINER_NAME", "testcontainer")
 	os.Setenv("MAX_IDLE_CONNS", "2000")
 	os.Setenv("MAX_IDLE_CONNS_PER_HOST", "200")
-	c := NewController(ctx, &configmap.InformedWatcher{})
+	c := NewController(ctx, newConfigMapWatcher(ctx))
 
 	if c == nil {
 		t.Fatal("Expected NewController to return a non-nil value")
@@ -98,7 +100,7 @@ func TestMaxIdleConnsEqualToZero(t *testing.T) {
 	os.Setenv("MAX_IDLE_CONNS_PER_HOST", "200")
 
 	require.Panics(t, func() {
-		NewController(ctx, &configmap.InformedWatcher{})
+		NewController(ctx, newConfigMapWatcher(ctx))
 	})
 }
 
@@ -116,10 +118,14 @@ func TestMaxIdleConnsPerHostEqualToZero(t *testing.T) {
 	os.Setenv("MAX_IDLE_CONNS_PER_HOST", "0")
 
 	require.Panics(t, func() {
-		NewController(ctx, &configmap.InformedWatcher{})
+		NewController(ctx, newConfigMapWatcher(ctx))
 	})
 }
 
+func newConfigMapWatcher(ctx context.Context) *configmap.InformedWatcher {
+	return configmap.NewInformedWatcher(kubeclient.Get(ctx), "knative-eventing")
+}
+
 func SetUpInformerSelector(ctx context.Context) context.Context {
 	ctx = filteredFactory.WithSelectors(ctx, eventingtls.TrustBundleLabelSelector)
 	return ctx
@@ -35,6 +35,7 @@ import (
 	"knative.dev/eventing/pkg/apis/sinks/v1alpha1"
 	"knative.dev/eventing/pkg/auth"
 	"knative.dev/eventing/pkg/certificates"
+	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy"
 	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
 	"knative.dev/eventing/pkg/client/injection/informers/sinks/v1alpha1/integrationsink"
 	deploymentinformer "knative.dev/pkg/client/injection/kube/informers/apps/v1/deployment"
@@ -46,6 +47,7 @@ import (
 
 	integrationsinkreconciler "knative.dev/eventing/pkg/client/injection/reconciler/sinks/v1alpha1/integrationsink"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	namespaceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
 	secretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/filtered"
 	rolebindinginformer "knative.dev/pkg/client/injection/kube/informers/rbac/v1/rolebinding"
 	"knative.dev/pkg/configmap"
@@ -66,6 +68,8 @@ func NewController(
 	integrationSinkInformer := integrationsink.Get(ctx)
 	secretInformer := secretinformer.Get(ctx, certificates.SecretLabelSelectorPair)
 	eventPolicyInformer := eventpolicy.Get(ctx)
+	clusterEventPolicyInformer := clustereventpolicy.Get(ctx)
+	namespaceInformer := namespaceinformer.Get(ctx)
 	deploymentInformer := deploymentinformer.Get(ctx)
 	serviceInformer := service.Get(ctx)
 	trustBundleConfigMapInformer := configmapinformer.Get(ctx, eventingtls.TrustBundleLabelSelector)
@@ -80,6 +84,8 @@ func NewController(
 	r := &Reconciler{
 		secretLister:               secretInformer.Lister(),
 		eventPolicyLister:          eventPolicyInformer.Lister(),
+		clusterEventPolicyLister:   clusterEventPolicyInformer.Lister(),
+		namespaceLister:            namespaceInformer.Lister(),
 		kubeClientSet:              kubeclient.Get(ctx),
 		deploymentLister:           deploymentInformer.Lister(),
 		serviceLister:              serviceInformer.Lister(),
@@ -145,6 +151,24 @@ func NewController(
 		impl.EnqueueKey,
 	))
 
+	// Enqueue the IntegrationSink, if we have a ClusterEventPolicy which was applying
+	// or got updated and now is applying to the IntegrationSink
+	clusterEventPolicyInformer.Informer().AddEventHandler(auth.ClusterEventPolicyEventHandler(
+		integrationSinkInformer.Informer().GetIndexer(),
+		integrationSinkGK,
+		namespaceInformer.Lister(),
+		impl.EnqueueKey,
+	))
+
+	// Enqueue the IntegrationSink, if the labels of its namespace got updated and a ClusterEventPolicy
+	// started or stopped applying to the IntegrationSink
+	namespaceInformer.Informer().AddEventHandler(auth.ClusterEventPolicyNamespaceEventHandler(
+		clusterEventPolicyInformer.Lister(),
+		integrationSinkInformer.Informer().GetIndexer(),
+		integrationSinkGK,
+		impl.EnqueueKey,
+	))
+
 	trustBundleConfigMapInformer.Informer().AddEventHandler(controller.HandleAll(func(i interface{}) {
 		obj, err := kmeta.DeletionHandlingAccessor(i)
 		if err != nil {
@@ -73,8 +73,10 @@ const (
 )
 
 type Reconciler struct {
-	secretLister      corev1listers.SecretLister
-	eventPolicyLister eventingv1alpha1listers.EventPolicyLister
+	secretLister             corev1listers.SecretLister
+	eventPolicyLister        eventingv1alpha1listers.EventPolicyLister
+	clusterEventPolicyLister eventingv1alpha1listers.ClusterEventPolicyLister
+	namespaceLister          corev1listers.NamespaceLister
 
 	kubeClientSet kubernetes.Interface
 
@@ -140,7 +142,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, sink *sinks.IntegrationS
 	}
 
 	logger.Debugw("Updating IntegrationSink status with EventPolicies")
-	err = auth.UpdateStatusWithEventPolicies(featureFlags, &sink.Status.AppliedEventPoliciesStatus, &sink.Status, r.eventPolicyLister, sinks.SchemeGroupVersion.WithKind("IntegrationSink"), sink.ObjectMeta)
+	err = auth.UpdateStatusWithEventPolicies(featureFlags, &sink.Status.AppliedEventPoliciesStatus, &sink.Status, r.eventPolicyLister, r.clusterEventPolicyLister, r.namespaceLister, sinks.SchemeGroupVersion.WithKind("IntegrationSink"), sink.ObjectMeta)
 	if err != nil {
 		return fmt.Errorf("could not update IntegrationSink status with EventPolicies: %v", err)
 	}
@@ -149,7 +151,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, sink *sinks.IntegrationS
 }
 
 func (r *Reconciler) reconcileDeployment(ctx context.Context, sink *sinks.IntegrationSink, authProxyImage string, featureFlags feature.Flags) (*v1.Deployment, error) {
-	expected, err := resources.MakeDeploymentSpec(sink, authProxyImage, featureFlags, r.trustBundleConfigMapLister, r.eventPolicyLister)
+	expected, err := resources.MakeDeploymentSpec(sink, authProxyImage, featureFlags, r.trustBundleConfigMapLister, r.eventPolicyLister, r.clusterEventPolicyLister)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create deployment template: %w", err)
 	}
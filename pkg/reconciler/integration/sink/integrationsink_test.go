@@ -168,6 +168,8 @@ func TestReconcile(t *testing.T) {
 			secretLister:               listers.GetSecretLister(),
 			cmCertificateLister:        cmCertificatesListerAtomic,
 			eventPolicyLister:          listers.GetEventPolicyLister(),
+			clusterEventPolicyLister:   listers.GetClusterEventPolicyLister(),
+			namespaceLister:            listers.GetNamespaceLister(),
 			trustBundleConfigMapLister: listers.GetConfigMapLister(),
 			rolebindingLister:          listers.GetRoleBindingLister(),
 			integrationSinkLister:      listers.GetIntegrationSinkLister(),
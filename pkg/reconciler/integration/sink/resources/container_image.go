@@ -47,7 +47,7 @@ const (
 	AuthProxyRolebindingName = "eventing-auth-proxy"
 )
 
-func MakeDeploymentSpec(sink *v1alpha1.IntegrationSink, authProxyImage string, featureFlags feature.Flags, trustBundleConfigmapLister corev1listers.ConfigMapLister, eventPolicyLister alpha1.EventPolicyLister) (*appsv1.Deployment, error) {
+func MakeDeploymentSpec(sink *v1alpha1.IntegrationSink, authProxyImage string, featureFlags feature.Flags, trustBundleConfigmapLister corev1listers.ConfigMapLister, eventPolicyLister alpha1.EventPolicyLister, clusterEventPolicyLister alpha1.ClusterEventPolicyLister) (*appsv1.Deployment, error) {
 	deploy := &appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "apps/v1",
@@ -117,7 +117,7 @@ func MakeDeploymentSpec(sink *v1alpha1.IntegrationSink, authProxyImage string, f
 	if featureFlags.IsOIDCAuthentication() {
 		// add auth-proxy
 
-		proxyVars, err := makeAuthProxyEnv(sink, featureFlags, eventPolicyLister)
+		proxyVars, err := makeAuthProxyEnv(sink, featureFlags, eventPolicyLister, clusterEventPolicyLister)
 		if err != nil {
 			return nil, fmt.Errorf("failed to make auth proxy env vars: %w", err)
 		}
@@ -246,13 +246,13 @@ func MakeAuthProxyRoleBindings(sink *v1alpha1.IntegrationSink, sinkLister v1alph
 	return &rb, nil
 }
 
-func makeAuthProxyEnv(sink *v1alpha1.IntegrationSink, featureFlags feature.Flags, eventPolicyLister alpha1.EventPolicyLister) ([]corev1.EnvVar, error) {
+func makeAuthProxyEnv(sink *v1alpha1.IntegrationSink, featureFlags feature.Flags, eventPolicyLister alpha1.EventPolicyLister, clusterEventPolicyLister alpha1.ClusterEventPolicyLister) ([]corev1.EnvVar, error) {
 	sinkAddress := eventingtls.GetHttpsAddress(sink.Status.Addresses)
 	if sinkAddress == nil {
 		sinkAddress = sink.Status.Address
 	}
 
-	policies, err := auth.SubjectWithFiltersFromPolicyRef(eventPolicyLister, sink.Namespace, sink.Status.Policies)
+	policies, err := auth.SubjectWithFiltersFromPolicyRef(eventPolicyLister, clusterEventPolicyLister, sink.Namespace, sink.Status.Policies)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build auth proxy policies env vars: %w", err)
 	}
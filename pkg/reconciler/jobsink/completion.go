@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobsink
+
+import (
+	"sort"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sinksapi "knative.dev/eventing/pkg/apis/sinks"
+	sinks "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+)
+
+// completionSendTimeout bounds the time sending a completion event takes.
+const completionSendTimeout = 10 * time.Second
+
+// JobCompletion is the data of the completion event of a Job.
+type JobCompletion struct {
+	// Job is the name of the Job.
+	Job string `json:"job"`
+	// EventID and EventSource identify the event the Job was created for.
+	EventID     string `json:"eventId,omitempty"`
+	EventSource string `json:"eventSource,omitempty"`
+	// Succeeded is true when the Job completed successfully.
+	Succeeded      bool         `json:"succeeded"`
+	StartTime      *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Duration is the time the Job took to finish, like "1m30s".
+	Duration string `json:"duration,omitempty"`
+	// ExitCode is the exit code of the failed container of a failed Job, or
+	// of the first container of a succeeded Job.
+	ExitCode *int32 `json:"exitCode,omitempty"`
+	// Result is the termination message of the container ExitCode is read
+	// from.
+	Result string `json:"result,omitempty"`
+}
+
+// finishedCondition returns the condition telling that job finished, or nil
+// when it is still running.
+func finishedCondition(job *batchv1.Job) *batchv1.JobCondition {
+	for i, c := range job.Status.Conditions {
+		if (c.Type == batchv1.JobComplete || c.Type == batchv1.JobFailed) && c.Status == corev1.ConditionTrue {
+			return &job.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// completionSinkConfigured returns whether the completion events of js are
+// sent to a resolved completion sink.
+func completionSinkConfigured(js *sinks.JobSink) bool {
+	return js.Spec.Completion != nil && js.Spec.Completion.Sink != nil &&
+		js.Status.Completion != nil && js.Status.Completion.SinkURI != nil
+}
+
+// completionPending returns whether the completion event of job, which
+// finished, still has to be recorded or sent.
+func completionPending(js *sinks.JobSink, job *batchv1.Job) bool {
+	if js.Spec.Completion == nil {
+		return false
+	}
+	if _, ok := job.Annotations[sinksapi.JobSinkCompletionEventAnnotation]; !ok {
+		return true
+	}
+	_, sent := job.Annotations[sinksapi.JobSinkCompletionSentAnnotation]
+	return completionSinkConfigured(js) && !sent
+}
+
+// finishedTime returns the time job finished as told by finished.
+func finishedTime(job *batchv1.Job, finished *batchv1.JobCondition) *metav1.Time {
+	if job.Status.CompletionTime != nil {
+		return job.Status.CompletionTime
+	}
+	// Failed Jobs have no completion time.
+	return &finished.LastTransitionTime
+}
+
+// completionEvent returns the completion event of job, which finished as
+// told by finished, with the result read from its pods.
+func completionEvent(js *sinks.JobSink, job *batchv1.Job, finished *batchv1.JobCondition, pods []*corev1.Pod) (cloudevents.Event, error) {
+	data := JobCompletion{
+		Job:         job.Name,
+		EventID:     job.Annotations[sinksapi.JobSinkEventIDAnnotation],
+		EventSource: job.Annotations[sinksapi.JobSinkEventSourceAnnotation],
+		Succeeded:   finished.Type == batchv1.JobComplete,
+		StartTime:   job.Status.StartTime,
+	}
+
+	data.CompletionTime = finishedTime(job, finished)
+	if data.StartTime != nil {
+		data.Duration = data.CompletionTime.Sub(data.StartTime.Time).Round(time.Millisecond).String()
+	}
+
+	if state := terminatedState(pods, !data.Succeeded); state != nil {
+		data.ExitCode = &state.ExitCode
+		data.Result = state.Message
+	}
+
+	event := cloudevents.NewEvent()
+	event.SetID(string(job.UID))
+	event.SetSource(sinks.JobSinkSource(js.Namespace, js.Name))
+	event.SetSubject(job.Name)
+	event.SetTime(data.CompletionTime.Time)
+	if data.Succeeded {
+		event.SetType(sinks.JobSinkJobSucceededEventType)
+	} else {
+		event.SetType(sinks.JobSinkJobFailedEventType)
+	}
+	if err := event.SetData(cloudevents.ApplicationJSON, data); err != nil {
+		return event, err
+	}
+	return event, nil
+}
+
+// terminatedState returns the state of the first terminated container of the
+// last pod of a Job, or of its first failed container when failed is true.
+func terminatedState(pods []*corev1.Pod, failed bool) *corev1.ContainerStateTerminated {
+	if len(pods) == 0 {
+		return nil
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].CreationTimestamp.Before(&pods[j].CreationTimestamp)
+	})
+
+	var first *corev1.ContainerStateTerminated
+	for _, cs := range pods[len(pods)-1].Status.ContainerStatuses {
+		state := cs.State.Terminated
+		if state == nil {
+			continue
+		}
+		if !failed || state.ExitCode != 0 {
+			return state
+		}
+		if first == nil {
+			first = state
+		}
+	}
+	return first
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobsink
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/system"
+
+	"knative.dev/eventing/pkg/apis/sinks"
+	"knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/eventsigning"
+	. "knative.dev/eventing/pkg/reconciler/testing/v1alpha1"
+)
+
+func TestFinishedCondition(t *testing.T) {
+	job := finishedJob()
+	if got := finishedCondition(job); got == nil || got.Type != batchv1.JobComplete {
+		t.Errorf("finishedCondition() = %v, want %s condition", got, batchv1.JobComplete)
+	}
+
+	job.Status.Conditions = []batchv1.JobCondition{
+		{Type: batchv1.JobSuspended, Status: corev1.ConditionTrue},
+		{Type: batchv1.JobFailed, Status: corev1.ConditionFalse},
+	}
+	if got := finishedCondition(job); got != nil {
+		t.Errorf("finishedCondition() = %v, want nil", got)
+	}
+}
+
+func TestCompletionEvent(t *testing.T) {
+	js := NewJobSink(jobSinkName, testNamespace)
+
+	tests := []struct {
+		name     string
+		job      func(job *batchv1.Job)
+		pods     []*corev1.Pod
+		wantType string
+		want     JobCompletion
+	}{{
+		name:     "succeeded",
+		pods:     []*corev1.Pod{finishedJobPod()},
+		wantType: v1alpha1.JobSinkJobSucceededEventType,
+		want: JobCompletion{
+			Job:            "finished-job",
+			EventID:        "event-id",
+			EventSource:    "event-source",
+			Succeeded:      true,
+			StartTime:      &metav1.Time{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+			CompletionTime: &metav1.Time{Time: time.Date(2026, 1, 1, 0, 1, 30, 0, time.UTC)},
+			Duration:       "1m30s",
+			ExitCode:       ptr.To[int32](0),
+			Result:         "done",
+		},
+	}, {
+		name: "failed",
+		job: func(job *batchv1.Job) {
+			job.Status.CompletionTime = nil
+			job.Status.Conditions = []batchv1.JobCondition{{
+				Type:               batchv1.JobFailed,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.Time{Time: time.Date(2026, 1, 1, 0, 0, 10, 0, time.UTC)},
+			}}
+		},
+		pods: []*corev1.Pod{{
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}},
+				}, {
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 2, Message: "boom"}},
+				}},
+			},
+		}},
+		wantType: v1alpha1.JobSinkJobFailedEventType,
+		want: JobCompletion{
+			Job:            "finished-job",
+			EventID:        "event-id",
+			EventSource:    "event-source",
+			StartTime:      &metav1.Time{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+			CompletionTime: &metav1.Time{Time: time.Date(2026, 1, 1, 0, 0, 10, 0, time.UTC)},
+			Duration:       "10s",
+			ExitCode:       ptr.To[int32](2),
+			Result:         "boom",
+		},
+	}, {
+		name:     "no pods",
+		wantType: v1alpha1.JobSinkJobSucceededEventType,
+		want: JobCompletion{
+			Job:            "finished-job",
+			EventID:        "event-id",
+			EventSource:    "event-source",
+			Succeeded:      true,
+			StartTime:      &metav1.Time{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+			CompletionTime: &metav1.Time{Time: time.Date(2026, 1, 1, 0, 1, 30, 0, time.UTC)},
+			Duration:       "1m30s",
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := finishedJob()
+			if tt.job != nil {
+				tt.job(job)
+			}
+
+			event, err := completionEvent(js, job, finishedCondition(job), tt.pods)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := event.Validate(); err != nil {
+				t.Fatal("invalid event:", err)
+			}
+			if got, want := event.Type(), tt.wantType; got != want {
+				t.Errorf("event type = %q, want %q", got, want)
+			}
+			if got, want := event.Source(), v1alpha1.JobSinkSource(testNamespace, jobSinkName); got != want {
+				t.Errorf("event source = %q, want %q", got, want)
+			}
+			if got, want := event.ID(), string(job.UID); got != want {
+				t.Errorf("event id = %q, want %q", got, want)
+			}
+
+			var got JobCompletion
+			if err := event.DataAs(&got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Error("unexpected completion (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestCompletionPending(t *testing.T) {
+	sinkURI := apis.HTTP("completion-sink.test-namespace.svc.cluster.local")
+	withSink := func(js *v1alpha1.JobSink) {
+		js.Spec.Completion = &v1alpha1.JobSinkCompletion{Sink: &duckv1.Destination{URI: sinkURI}}
+		js.Status.Completion = &v1alpha1.JobSinkCompletionStatus{SinkURI: sinkURI}
+	}
+	withoutSink := func(js *v1alpha1.JobSink) {
+		js.Spec.Completion = &v1alpha1.JobSinkCompletion{}
+	}
+
+	tests := []struct {
+		name        string
+		js          func(js *v1alpha1.JobSink)
+		annotations map[string]string
+		want        bool
+	}{{
+		name: "completion not configured",
+		js:   func(*v1alpha1.JobSink) {},
+	}, {
+		name: "not recorded",
+		js:   withoutSink,
+		want: true,
+	}, {
+		name:        "recorded without sink",
+		js:          withoutSink,
+		annotations: map[string]string{sinks.JobSinkCompletionEventAnnotation: "{}"},
+	}, {
+		name:        "recorded but not sent",
+		js:          withSink,
+		annotations: map[string]string{sinks.JobSinkCompletionEventAnnotation: "{}"},
+		want:        true,
+	}, {
+		name: "sent",
+		js:   withSink,
+		annotations: map[string]string{
+			sinks.JobSinkCompletionEventAnnotation: "{}",
+			sinks.JobSinkCompletionSentAnnotation:  "2026-01-01T00:00:00Z",
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			js := NewJobSink(jobSinkName, testNamespace)
+			tt.js(js)
+			job := finishedJob()
+			for k, v := range tt.annotations {
+				job.Annotations[k] = v
+			}
+
+			if got := completionPending(js, job); got != tt.want {
+				t.Errorf("completionPending() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompletionSigner(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	tests := []struct {
+		name       string
+		secret     *corev1.Secret
+		wantSigner bool
+		wantErr    bool
+	}{{
+		name: "no signing key",
+	}, {
+		name:       "signing key",
+		secret:     signingKeySecret(map[string][]byte{eventsigning.SigningKeySecretKey: keyPEM}),
+		wantSigner: true,
+	}, {
+		name:    "invalid signing key",
+		secret:  signingKeySecret(map[string][]byte{eventsigning.SigningKeySecretKey: []byte("invalid")}),
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			if tt.secret != nil {
+				if err := indexer.Add(tt.secret); err != nil {
+					t.Fatal(err)
+				}
+			}
+			r := &Reconciler{
+				secretLister:    corev1listers.NewSecretLister(indexer),
+				systemNamespace: system.Namespace(),
+			}
+
+			signer, err := r.completionSigner()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("completionSigner() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (signer != nil) != tt.wantSigner {
+				t.Errorf("completionSigner() = %v, wantSigner %v", signer, tt.wantSigner)
+			}
+		})
+	}
+}
+
+func signingKeySecret(data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      sinks.JobSinkCompletionSigningKeySecretName,
+			Namespace: system.Namespace(),
+		},
+		Data: data,
+	}
+}
@@ -28,17 +28,26 @@ import (
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/system"
 
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	jobinformer "knative.dev/pkg/client/injection/kube/informers/batch/v1/job/filtered"
+	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered"
+	namespaceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
+	podinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/pod/filtered"
+	filteredsecretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/filtered"
+	serviceaccountinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/serviceaccount/filtered"
 	secretinformer "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret"
 
 	"knative.dev/eventing/pkg/apis/feature"
 	"knative.dev/eventing/pkg/apis/sinks"
 	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
 	"knative.dev/eventing/pkg/auth"
+	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy"
 	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
 	"knative.dev/eventing/pkg/client/injection/informers/sinks/v1alpha1/jobsink"
 	jobsinkreconciler "knative.dev/eventing/pkg/client/injection/reconciler/sinks/v1alpha1/jobsink"
 	"knative.dev/eventing/pkg/eventingtls"
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/eventing/pkg/resolver"
 )
 
 // NewController initializes the controller and is called by the generated code.
@@ -50,13 +59,29 @@ func NewController(
 	jobSinkInformer := jobsink.Get(ctx)
 	secretInformer := secretinformer.Get(ctx)
 	jobInformer := jobinformer.Get(ctx, sinks.JobSinkJobsLabelSelector)
+	podInformer := podinformer.Get(ctx, sinks.JobSinkJobsLabelSelector)
+	queuedSecretInformer := filteredsecretinformer.Get(ctx, sinks.JobSinkQueuedLabelSelector)
 	eventPolicyInformer := eventpolicy.Get(ctx)
+	clusterEventPolicyInformer := clustereventpolicy.Get(ctx)
+	namespaceInformer := namespaceinformer.Get(ctx)
+
+	oidcServiceaccountInformer := serviceaccountinformer.Get(ctx, auth.OIDCLabelSelector)
+	trustBundleConfigMapLister := configmapinformer.Get(ctx, eventingtls.TrustBundleLabelSelector).Lister().ConfigMaps(system.Namespace())
 
 	r := &Reconciler{
-		systemNamespace:   system.Namespace(),
-		secretLister:      secretInformer.Lister(),
-		jobLister:         jobInformer.Lister(),
-		eventPolicyLister: eventPolicyInformer.Lister(),
+		kubeClientSet:            kubeclient.Get(ctx),
+		systemNamespace:          system.Namespace(),
+		secretLister:             secretInformer.Lister(),
+		queuedSecretLister:       queuedSecretInformer.Lister(),
+		jobLister:                jobInformer.Lister(),
+		podLister:                podInformer.Lister(),
+		eventPolicyLister:        eventPolicyInformer.Lister(),
+		clusterEventPolicyLister: clusterEventPolicyInformer.Lister(),
+		namespaceLister:          namespaceInformer.Lister(),
+		serviceAccountLister:     oidcServiceaccountInformer.Lister(),
+		dispatcher: kncloudevents.NewDispatcher(eventingtls.ClientConfig{
+			TrustBundleConfigMapLister: trustBundleConfigMapLister,
+		}, auth.NewOIDCTokenProvider(ctx)),
 	}
 
 	var globalResync func(obj interface{})
@@ -74,6 +99,8 @@ func NewController(
 		}
 	})
 
+	r.uriResolver = resolver.NewURIResolver(ctx, cmw, impl.Tracker)
+
 	jobSinkInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
 
 	globalResync = func(interface{}) {
@@ -84,7 +111,8 @@ func NewController(
 		Handler:    controller.HandleAll(globalResync),
 	})
 
-	jobInformer.Informer().AddEventHandler(controller.HandleAll(func(i interface{}) {
+	// Enqueue the JobSink of the Jobs and of the queued events.
+	enqueueJobSink := func(i interface{}) {
 		obj, err := kmeta.DeletionHandlingAccessor(i)
 		if err != nil {
 			return
@@ -97,7 +125,14 @@ func NewController(
 			Namespace: obj.GetNamespace(),
 			Name:      name,
 		})
-	}))
+	}
+	jobInformer.Informer().AddEventHandler(controller.HandleAll(enqueueJobSink))
+	queuedSecretInformer.Informer().AddEventHandler(controller.HandleAll(enqueueJobSink))
+
+	oidcServiceaccountInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterController(&sinksv1alpha1.JobSink{}),
+		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+	})
 
 	jobSinkGK := sinksv1alpha1.SchemeGroupVersion.WithKind("JobSink").GroupKind()
 
@@ -109,5 +144,23 @@ func NewController(
 		impl.EnqueueKey,
 	))
 
+	// Enqueue the JobSink, if we have a ClusterEventPolicy which was applying
+	// or got updated and now is applying to the JobSink
+	clusterEventPolicyInformer.Informer().AddEventHandler(auth.ClusterEventPolicyEventHandler(
+		jobSinkInformer.Informer().GetIndexer(),
+		jobSinkGK,
+		namespaceInformer.Lister(),
+		impl.EnqueueKey,
+	))
+
+	// Enqueue the JobSink, if the labels of its namespace got updated and a ClusterEventPolicy
+	// started or stopped applying to the JobSink
+	namespaceInformer.Informer().AddEventHandler(auth.ClusterEventPolicyNamespaceEventHandler(
+		clusterEventPolicyInformer.Lister(),
+		jobSinkInformer.Informer().GetIndexer(),
+		jobSinkGK,
+		impl.EnqueueKey,
+	))
+
 	return impl
 }
@@ -18,30 +18,54 @@ package jobsink
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"go.uber.org/zap"
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	batchlisters "k8s.io/client-go/listers/batch/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/utils/ptr"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	"knative.dev/pkg/network"
 	"knative.dev/pkg/reconciler"
+	"knative.dev/pkg/resolver"
 
 	"knative.dev/eventing/pkg/apis/feature"
+	sinksapi "knative.dev/eventing/pkg/apis/sinks"
 	sinks "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
 	"knative.dev/eventing/pkg/auth"
 	eventingv1alpha1listers "knative.dev/eventing/pkg/client/listers/eventing/v1alpha1"
 	"knative.dev/eventing/pkg/eventingtls"
+	"knative.dev/eventing/pkg/eventsigning"
+	"knative.dev/eventing/pkg/kncloudevents"
 )
 
 type Reconciler struct {
-	jobLister         batchlisters.JobLister
-	secretLister      corev1listers.SecretLister
-	eventPolicyLister eventingv1alpha1listers.EventPolicyLister
-	systemNamespace   string
+	kubeClientSet kubernetes.Interface
+
+	jobLister                batchlisters.JobLister
+	podLister                corev1listers.PodLister
+	secretLister             corev1listers.SecretLister
+	queuedSecretLister       corev1listers.SecretLister
+	eventPolicyLister        eventingv1alpha1listers.EventPolicyLister
+	clusterEventPolicyLister eventingv1alpha1listers.ClusterEventPolicyLister
+	namespaceLister          corev1listers.NamespaceLister
+	serviceAccountLister     corev1listers.ServiceAccountLister
+	uriResolver              *resolver.URIResolver
+	dispatcher               *kncloudevents.Dispatcher
+	systemNamespace          string
 }
 
 func (r *Reconciler) ReconcileKind(ctx context.Context, js *sinks.JobSink) reconciler.Event {
@@ -49,18 +73,207 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, js *sinks.JobSink) recon
 
 	r.reconcileJob(js)
 
+	// The OIDC identity is only used to send the completion events.
+	if featureFlags.IsOIDCAuthentication() {
+		if err := auth.SetupOIDCServiceAccount(ctx, featureFlags, r.serviceAccountLister, r.kubeClientSet, sinks.SchemeGroupVersion.WithKind("JobSink"), js.ObjectMeta, &js.Status, func(as *duckv1.AuthStatus) {
+			js.Status.Auth = as
+		}); err != nil {
+			return err
+		}
+	} else {
+		js.Status.Auth = nil
+		js.Status.MarkOIDCIdentityCreatedSucceededWithReason(fmt.Sprintf("%s feature disabled", feature.OIDCAuthentication), "")
+	}
+
+	if err := r.reconcileCompletionSink(ctx, js); err != nil {
+		return fmt.Errorf("failed to reconcile completion sink: %w", err)
+	}
+
 	if err := r.reconcileAddress(ctx, js); err != nil {
 		return fmt.Errorf("failed to reconcile address: %w", err)
 	}
 
-	err := auth.UpdateStatusWithEventPolicies(featureFlags, &js.Status.AppliedEventPoliciesStatus, &js.Status, r.eventPolicyLister, sinks.SchemeGroupVersion.WithKind("JobSink"), js.ObjectMeta)
+	err := auth.UpdateStatusWithEventPolicies(featureFlags, &js.Status.AppliedEventPoliciesStatus, &js.Status, r.eventPolicyLister, r.clusterEventPolicyLister, r.namespaceLister, sinks.SchemeGroupVersion.WithKind("JobSink"), js.ObjectMeta)
 	if err != nil {
 		return fmt.Errorf("could not update JobSink status with EventPolicies: %v", err)
 	}
 
+	if err := r.reconcileCompletions(ctx, js); err != nil {
+		return fmt.Errorf("failed to send completion events: %w", err)
+	}
+
+	nextBatch, err := r.reconcileQueue(ctx, js)
+	if err != nil {
+		return fmt.Errorf("failed to admit queued events: %w", err)
+	}
+
+	nextExpiry, err := r.reconcileRetention(ctx, js)
+	if err != nil {
+		return fmt.Errorf("failed to garbage collect finished jobs: %w", err)
+	}
+
+	if next := earliest(nextBatch, nextExpiry); next > 0 {
+		return controller.NewRequeueAfter(next)
+	}
+
+	return nil
+}
+
+// earliest returns the shortest of the non-zero durations a and b, or zero.
+func earliest(a, b time.Duration) time.Duration {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+func (r *Reconciler) reconcileCompletionSink(ctx context.Context, js *sinks.JobSink) error {
+	if js.Spec.Completion == nil || js.Spec.Completion.Sink == nil {
+		js.Status.MarkCompletionSinkNotConfigured()
+		return nil
+	}
+
+	addr, err := r.uriResolver.AddressableFromDestinationV1(ctx, *js.Spec.Completion.Sink, js)
+	if err != nil {
+		logging.FromContext(ctx).Errorw("Unable to get the completion sink's URI", zap.Error(err))
+		js.Status.MarkCompletionSinkFailed("Unable to get the completion sink's URI", "%v", err)
+		return err
+	}
+	js.Status.MarkCompletionSinkResolved(addr)
 	return nil
 }
 
+// reconcileCompletions sends the completion events of the Jobs of js which
+// finished since the last reconciliation, and records them in the
+// annotations of the Jobs, from which the ingress replies with them.
+func (r *Reconciler) reconcileCompletions(ctx context.Context, js *sinks.JobSink) error {
+	if js.Spec.Completion == nil {
+		return nil
+	}
+
+	jobs, err := r.listJobs(js)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, job := range jobs {
+		if err := r.reconcileCompletion(ctx, js, job); err != nil {
+			errs = append(errs, fmt.Errorf("job %s: %w", job.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// reconcileCompletion records the completion event of job, once it
+// finished, and sends it to the completion sink of js, if any, recording
+// that it was sent. The recorded event is resent until the sending is
+// recorded.
+func (r *Reconciler) reconcileCompletion(ctx context.Context, js *sinks.JobSink, job *batchv1.Job) error {
+	finished := finishedCondition(job)
+	if finished == nil {
+		return nil
+	}
+
+	var event cloudevents.Event
+	if data, ok := job.Annotations[sinksapi.JobSinkCompletionEventAnnotation]; ok {
+		if err := event.UnmarshalJSON([]byte(data)); err != nil {
+			return fmt.Errorf("failed to parse recorded completion event: %w", err)
+		}
+	} else {
+		pods, err := r.podLister.Pods(job.Namespace).List(labels.SelectorFromSet(labels.Set{batchv1.JobNameLabel: job.Name}))
+		if err != nil {
+			return fmt.Errorf("failed to list pods: %w", err)
+		}
+
+		event, err = completionEvent(js, job, finished, pods)
+		if err != nil {
+			return fmt.Errorf("failed to create completion event: %w", err)
+		}
+
+		eventBytes, err := event.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		if err := r.annotateJob(ctx, job, sinksapi.JobSinkCompletionEventAnnotation, string(eventBytes)); err != nil {
+			return fmt.Errorf("failed to record completion event: %w", err)
+		}
+	}
+
+	if !completionSinkConfigured(js) {
+		return nil
+	}
+	if _, ok := job.Annotations[sinksapi.JobSinkCompletionSentAnnotation]; ok {
+		return nil
+	}
+
+	sink := duckv1.Addressable{
+		URL:      js.Status.Completion.SinkURI,
+		CACerts:  js.Status.Completion.SinkCACerts,
+		Audience: js.Status.Completion.SinkAudience,
+	}
+
+	var opts []kncloudevents.SendOption
+	if feature.FromContext(ctx).IsOIDCAuthentication() && js.Status.Auth != nil && js.Status.Auth.ServiceAccountName != nil {
+		opts = append(opts, kncloudevents.WithOIDCAuthentication(&types.NamespacedName{
+			Namespace: js.Namespace,
+			Name:      *js.Status.Auth.ServiceAccountName,
+		}))
+	}
+	signer, err := r.completionSigner()
+	if err != nil {
+		return err
+	}
+	if signer != nil {
+		opts = append(opts, kncloudevents.WithEventSigner(signer))
+	}
+
+	// A slow completion sink mustn't hold the reconciliation of the JobSink.
+	sendCtx, cancel := context.WithTimeout(ctx, completionSendTimeout)
+	defer cancel()
+	if _, err := r.dispatcher.SendEvent(sendCtx, event, sink, opts...); err != nil {
+		return fmt.Errorf("failed to send completion event: %w", err)
+	}
+
+	if err := r.annotateJob(ctx, job, sinksapi.JobSinkCompletionSentAnnotation, time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return fmt.Errorf("failed to record sent completion event: %w", err)
+	}
+	return nil
+}
+
+// annotateJob sets the annotation key of job to value.
+func (r *Reconciler) annotateJob(ctx context.Context, job *batchv1.Job, key, value string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				key: value,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = r.kubeClientSet.BatchV1().Jobs(job.Namespace).Patch(ctx, job.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// completionSigner returns the Signer for the completion events, or nil when
+// the signing key Secret doesn't exist.
+func (r *Reconciler) completionSigner() (*eventsigning.Signer, error) {
+	secret, err := r.secretLister.Secrets(r.systemNamespace).Get(sinksapi.JobSinkCompletionSigningKeySecretName)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get signing key from %s/%s: %w", r.systemNamespace, sinksapi.JobSinkCompletionSigningKeySecretName, err)
+	}
+	signer, err := eventsigning.NewSignerFromSecret(secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load signing key from %s/%s: %w", r.systemNamespace, sinksapi.JobSinkCompletionSigningKeySecretName, err)
+	}
+	return signer, nil
+}
+
 func (r *Reconciler) getCaCerts() (*string, error) {
 	// Getting the secret called "job-sink-server-tls" from system namespace
 	secret, err := r.secretLister.Secrets(r.systemNamespace).Get(eventingtls.JobSinkDispatcherServerTLSSecretName)
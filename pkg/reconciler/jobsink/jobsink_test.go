@@ -18,28 +18,38 @@ package jobsink
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilrand "k8s.io/apimachinery/pkg/util/rand"
 	clientgotesting "k8s.io/client-go/testing"
 	"k8s.io/utils/ptr"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	v1 "knative.dev/pkg/client/injection/ducks/duck/v1/addressable"
+	fakekubeclient "knative.dev/pkg/client/injection/kube/client/fake"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	logtesting "knative.dev/pkg/logging/testing"
 	"knative.dev/pkg/network"
 	. "knative.dev/pkg/reconciler/testing"
+	"knative.dev/pkg/resolver"
+	"knative.dev/pkg/tracker"
 
+	sinks "knative.dev/eventing/pkg/apis/sinks"
 	"knative.dev/eventing/pkg/apis/sinks/v1alpha1"
 	fakeeventingclient "knative.dev/eventing/pkg/client/injection/client/fake"
 	jobsinkreconciler "knative.dev/eventing/pkg/client/injection/reconciler/sinks/v1alpha1/jobsink"
+	"knative.dev/eventing/pkg/eventingtls"
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/eventing/pkg/reconciler/jobsink/resources"
 	. "knative.dev/eventing/pkg/reconciler/testing/v1"
 	. "knative.dev/eventing/pkg/reconciler/testing/v1alpha1"
 )
@@ -66,6 +76,10 @@ var (
 		},
 	}
 
+	completionSink = duckv1.Destination{
+		URI: apis.HTTP("completion-sink.test-namespace.svc.cluster.local"),
+	}
+
 	jobSinkGVK = metav1.GroupVersionKind{
 		Group:   "sinks.knative.dev",
 		Version: "v1alpha1",
@@ -109,6 +123,8 @@ func TestReconcile(t *testing.T) {
 						WithJobSinkAddressableReady(),
 						WithJobSinkJobStatusSelector(),
 						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkCompletionSinkNotConfigured(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
 						WithJobSinkEventPoliciesReadyBecauseOIDCDisabled()),
 				},
 			},
@@ -135,6 +151,8 @@ func TestReconcile(t *testing.T) {
 						WithJobSinkAddressableReady(),
 						WithJobSinkJobStatusSelector(),
 						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkCompletionSinkNotConfigured(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
 						WithJobSinkEventPoliciesReady(),
 						WithJobSinkEventPoliciesListed(readyEventPolicyName),
 					),
@@ -163,6 +181,8 @@ func TestReconcile(t *testing.T) {
 						WithJobSinkAddressableReady(),
 						WithJobSinkJobStatusSelector(),
 						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkCompletionSinkNotConfigured(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
 						WithJobSinkEventPoliciesNotReady("EventPoliciesNotReady", fmt.Sprintf("event policies %s are not ready", unreadyEventPolicyName)),
 					),
 				},
@@ -194,6 +214,8 @@ func TestReconcile(t *testing.T) {
 						WithJobSinkAddressableReady(),
 						WithJobSinkJobStatusSelector(),
 						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkCompletionSinkNotConfigured(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
 						WithJobSinkEventPoliciesNotReady("EventPoliciesNotReady", fmt.Sprintf("event policies %s are not ready", unreadyEventPolicyName)),
 						WithJobSinkEventPoliciesListed(readyEventPolicyName),
 					),
@@ -222,6 +244,8 @@ func TestReconcile(t *testing.T) {
 						WithJobSinkAddressableReady(),
 						WithJobSinkJobStatusSelector(),
 						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkCompletionSinkNotConfigured(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
 						func(sink *v1alpha1.JobSink) {
 							sink.Generation = 4242
 							sink.Status.ObservedGeneration = 4242
@@ -230,16 +254,291 @@ func TestReconcile(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Successful reconciliation, completion sink resolved",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewJobSink(jobSinkName, testNamespace,
+					WithJobSinkJob(testJob("")),
+					WithJobSinkCompletionSink(completionSink),
+					WithInitJobSinkConditions),
+			},
+			WantErr: false,
+			WantCreates: []runtime.Object{
+				testJob("test-jobSinkrxg2r"),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewJobSink(jobSinkName, testNamespace,
+						WithJobSinkJob(testJob("")),
+						WithJobSinkCompletionSink(completionSink),
+						WithJobSinkAddressableReady(),
+						WithJobSinkJobStatusSelector(),
+						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkCompletionSinkResolved(&duckv1.Addressable{URL: completionSink.URI}),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithJobSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
+		{
+			Name: "Successful reconciliation, completion of finished Job recorded",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewJobSink(jobSinkName, testNamespace,
+					WithJobSinkJob(testJob("")),
+					func(sink *v1alpha1.JobSink) {
+						sink.Spec.Completion = &v1alpha1.JobSinkCompletion{}
+					},
+					WithInitJobSinkConditions),
+				finishedJob(),
+				finishedJobPod(),
+			},
+			WantErr: false,
+			WantCreates: []runtime.Object{
+				testJob("test-jobSinkkv22d"),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				completionPatch(t),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewJobSink(jobSinkName, testNamespace,
+						WithJobSinkJob(testJob("")),
+						func(sink *v1alpha1.JobSink) {
+							sink.Spec.Completion = &v1alpha1.JobSinkCompletion{}
+						},
+						WithJobSinkAddressableReady(),
+						WithJobSinkJobStatusSelector(),
+						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkCompletionSinkNotConfigured(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithJobSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
+		{
+			Name: "Queued events admitted up to the maximum number of concurrent jobs",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewJobSink(jobSinkName, testNamespace,
+					WithJobSinkJob(testJob("")),
+					WithJobSinkMaxConcurrentJobs(1),
+					WithInitJobSinkConditions),
+				queuedSecret("queued-1", 1),
+				queuedSecret("queued-2", 2),
+			},
+			WantErr: false,
+			WantCreates: []runtime.Object{
+				queuedJob("queued-1"),
+				testJob("test-jobSinkb54mc"),
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				{Object: admittedSecret("queued-1")},
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewJobSink(jobSinkName, testNamespace,
+						WithJobSinkJob(testJob("")),
+						WithJobSinkMaxConcurrentJobs(1),
+						WithJobSinkAddressableReady(),
+						WithJobSinkJobStatusSelector(),
+						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkCompletionSinkNotConfigured(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithJobSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
+		{
+			Name: "Queued events wait for running jobs",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewJobSink(jobSinkName, testNamespace,
+					WithJobSinkJob(testJob("")),
+					WithJobSinkMaxConcurrentJobs(1),
+					WithInitJobSinkConditions),
+				queuedJob("running"),
+				queuedSecret("queued-1", 1),
+			},
+			WantErr: false,
+			WantCreates: []runtime.Object{
+				testJob("test-jobSinkg2j5q"),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewJobSink(jobSinkName, testNamespace,
+						WithJobSinkJob(testJob("")),
+						WithJobSinkMaxConcurrentJobs(1),
+						WithJobSinkAddressableReady(),
+						WithJobSinkJobStatusSelector(),
+						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkCompletionSinkNotConfigured(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithJobSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
+		{
+			Name: "Finished jobs beyond the retention deleted",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewJobSink(jobSinkName, testNamespace,
+					WithJobSinkJob(testJob("")),
+					WithJobSinkRetention(&v1alpha1.JobSinkRetention{KeepLast: ptr.To[int32](1)}),
+					WithInitJobSinkConditions),
+				jobFinishedAt("finished-old", time.Now().Add(-2*time.Hour)),
+				jobFinishedAt("finished-new", time.Now().Add(-time.Hour)),
+			},
+			WantErr: false,
+			WantCreates: []runtime.Object{
+				testJob("test-jobSink7ddzv"),
+			},
+			WantDeletes: []clientgotesting.DeleteActionImpl{
+				deleteJob("finished-old"),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewJobSink(jobSinkName, testNamespace,
+						WithJobSinkJob(testJob("")),
+						WithJobSinkRetention(&v1alpha1.JobSinkRetention{KeepLast: ptr.To[int32](1)}),
+						WithJobSinkAddressableReady(),
+						WithJobSinkJobStatusSelector(),
+						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkCompletionSinkNotConfigured(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithJobSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
+		{
+			Name: "Finished jobs deleted after TTL, requeued for the next one",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewJobSink(jobSinkName, testNamespace,
+					WithJobSinkJob(testJob("")),
+					WithJobSinkRetention(&v1alpha1.JobSinkRetention{TTL: ptr.To("PT1H")}),
+					WithInitJobSinkConditions),
+				jobFinishedAt("finished-old", time.Now().Add(-2*time.Hour)),
+				jobFinishedAt("finished-new", time.Now().Add(-time.Minute)),
+			},
+			// The reconciliation is requeued until finished-new expires.
+			WantErr: true,
+			WantCreates: []runtime.Object{
+				testJob("test-jobSinkdbrc9"),
+			},
+			WantDeletes: []clientgotesting.DeleteActionImpl{
+				deleteJob("finished-old"),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewJobSink(jobSinkName, testNamespace,
+						WithJobSinkJob(testJob("")),
+						WithJobSinkRetention(&v1alpha1.JobSinkRetention{TTL: ptr.To("PT1H")}),
+						WithJobSinkAddressableReady(),
+						WithJobSinkJobStatusSelector(),
+						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkCompletionSinkNotConfigured(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithJobSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
+		{
+			Name: "Queued events collected into batches",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewJobSink(jobSinkName, testNamespace,
+					WithJobSinkJob(testJob("")),
+					WithJobSinkBatching(2, "PT1M"),
+					WithInitJobSinkConditions),
+				queuedSecret("queued-1", 1),
+				queuedSecret("queued-2", 2),
+				queuedSecret("queued-3", 3),
+			},
+			WantErr: false,
+			WantCreates: []runtime.Object{
+				batchJob("queued-1", 2),
+				batchSecret("queued-1", "queued-1", "queued-2"),
+				batchJob("queued-3", 1),
+				batchSecret("queued-3", "queued-3"),
+				testJob("test-jobSinkcf4rt"),
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				{Object: batchedSecret("queued-1", 1, "queued-1", false)},
+				{Object: batchedSecret("queued-2", 2, "queued-1", false)},
+				{Object: batchedSecret("queued-1", 1, "queued-1", true)},
+				{Object: batchedSecret("queued-2", 2, "queued-1", true)},
+				{Object: batchedSecret("queued-3", 3, "queued-3", false)},
+				{Object: batchedSecret("queued-3", 3, "queued-3", true)},
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewJobSink(jobSinkName, testNamespace,
+						WithJobSinkJob(testJob("")),
+						WithJobSinkBatching(2, "PT1M"),
+						WithJobSinkAddressableReady(),
+						WithJobSinkJobStatusSelector(),
+						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkCompletionSinkNotConfigured(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithJobSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
+		{
+			Name: "Collected batch completed",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewJobSink(jobSinkName, testNamespace,
+					WithJobSinkJob(testJob("")),
+					WithJobSinkBatching(2, "PT1M"),
+					WithInitJobSinkConditions),
+				batchedSecret("queued-1", 1, "queued-1", false),
+				batchedSecret("queued-2", 2, "queued-1", false),
+			},
+			WantErr: false,
+			WantCreates: []runtime.Object{
+				batchJob("queued-1", 2),
+				batchSecret("queued-1", "queued-1", "queued-2"),
+				testJob("test-jobSinksd7bx"),
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				{Object: batchedSecret("queued-1", 1, "queued-1", true)},
+				{Object: batchedSecret("queued-2", 2, "queued-1", true)},
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewJobSink(jobSinkName, testNamespace,
+						WithJobSinkJob(testJob("")),
+						WithJobSinkBatching(2, "PT1M"),
+						WithJobSinkAddressableReady(),
+						WithJobSinkJobStatusSelector(),
+						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkCompletionSinkNotConfigured(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithJobSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
 	}
 
 	logger := logtesting.TestLogger(t)
 	table.Test(t, MakeFactory(func(ctx context.Context, listers *Listers, cmw configmap.Watcher) controller.Reconciler {
 		ctx = v1.WithDuck(ctx)
 		r := &Reconciler{
-			jobLister:         listers.GetJobLister(),
-			secretLister:      listers.GetSecretLister(),
-			eventPolicyLister: listers.GetEventPolicyLister(),
-			systemNamespace:   testNamespace,
+			kubeClientSet:            fakekubeclient.Get(ctx),
+			jobLister:                listers.GetJobLister(),
+			podLister:                listers.GetPodLister(),
+			secretLister:             listers.GetSecretLister(),
+			queuedSecretLister:       listers.GetSecretLister(),
+			eventPolicyLister:        listers.GetEventPolicyLister(),
+			clusterEventPolicyLister: listers.GetClusterEventPolicyLister(),
+			namespaceLister:          listers.GetNamespaceLister(),
+			serviceAccountLister:     listers.GetServiceAccountLister(),
+			uriResolver:              resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0)),
+			dispatcher:               kncloudevents.NewDispatcher(eventingtls.ClientConfig{}, nil),
+			systemNamespace:          testNamespace,
 		}
 
 		return jobsinkreconciler.NewReconciler(ctx, logger,
@@ -273,3 +572,147 @@ func testJob(name string) *batchv1.Job {
 		},
 	}
 }
+
+func finishedJob() *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "finished-job",
+			Namespace: testNamespace,
+			UID:       "finished-job-uid",
+			Labels: map[string]string{
+				sinks.JobSinkJobsLabel: "true",
+				sinks.JobSinkNameLabel: jobSinkName,
+			},
+			Annotations: map[string]string{
+				sinks.JobSinkEventIDAnnotation:     "event-id",
+				sinks.JobSinkEventSourceAnnotation: "event-source",
+			},
+		},
+		Status: batchv1.JobStatus{
+			StartTime:      &metav1.Time{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+			CompletionTime: &metav1.Time{Time: time.Date(2026, 1, 1, 0, 1, 30, 0, time.UTC)},
+			Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+}
+
+func finishedJobPod() *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "finished-job-pod",
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				batchv1.JobNameLabel: "finished-job",
+			},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "test-container",
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Message: "done"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func completionPatch(t *testing.T) clientgotesting.PatchActionImpl {
+	job := finishedJob()
+	event, err := completionEvent(NewJobSink(jobSinkName, testNamespace), job, finishedCondition(job), []*corev1.Pod{finishedJobPod()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	eventBytes, err := event.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				sinks.JobSinkCompletionEventAnnotation: string(eventBytes),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	action := clientgotesting.PatchActionImpl{}
+	action.Name = job.Name
+	action.Namespace = job.Namespace
+	action.PatchType = types.MergePatchType
+	action.Patch = patch
+	return action
+}
+
+func queuedSecret(name string, age int) *corev1.Secret {
+	js := NewJobSink(jobSinkName, testNamespace)
+	secret := resources.MakeEventSecret(js, name, resources.EventRef{ID: name, Source: "test-source"}, []byte("{}"), nil)
+	secret.UID = types.UID(name + "-uid")
+	secret.CreationTimestamp = metav1.NewTime(time.Date(2026, 1, 1, 0, 0, age, 0, time.UTC))
+	return secret
+}
+
+func batchedSecret(name string, age int, first string, handedOver bool) *corev1.Secret {
+	secret := queuedSecret(name, age)
+	secret.Labels[sinks.JobSinkBatchLabel] = batchJobName(first)
+	if handedOver {
+		delete(secret.Labels, sinks.JobSinkQueuedLabel)
+		secret.OwnerReferences = []metav1.OwnerReference{resources.JobOwnerReference(batchJob(first, 0))}
+	}
+	return secret
+}
+
+func batchJobName(first string) string {
+	return resources.BatchJobName(NewJobSink(jobSinkName, testNamespace), queuedSecret(first, 0))
+}
+
+func batchJob(first string, size int) *batchv1.Job {
+	js := NewJobSink(jobSinkName, testNamespace, WithJobSinkJob(testJob("")))
+	js.SetDefaults(context.Background())
+	return resources.MakeBatchJob(js, batchJobName(first), size)
+}
+
+func batchSecret(first string, events ...string) *corev1.Secret {
+	secrets := make([]*corev1.Secret, 0, len(events))
+	for _, e := range events {
+		secrets = append(secrets, queuedSecret(e, 0))
+	}
+	return resources.MakeBatchSecret(NewJobSink(jobSinkName, testNamespace), batchJob(first, len(events)), secrets)
+}
+
+func admittedSecret(name string) *corev1.Secret {
+	secret := queuedSecret(name, 1)
+	delete(secret.Labels, sinks.JobSinkQueuedLabel)
+	secret.OwnerReferences = []metav1.OwnerReference{resources.JobOwnerReference(queuedJob(name))}
+	return secret
+}
+
+func queuedJob(name string) *batchv1.Job {
+	js := NewJobSink(jobSinkName, testNamespace, WithJobSinkJob(testJob("")))
+	js.SetDefaults(context.Background())
+	return resources.MakeJob(js, name, resources.EventRef{ID: name, Source: "test-source"})
+}
+
+func jobFinishedAt(name string, finishedAt time.Time) *batchv1.Job {
+	job := queuedJob(name)
+	job.Status.CompletionTime = &metav1.Time{Time: finishedAt}
+	job.Status.Conditions = []batchv1.JobCondition{
+		{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+	}
+	return job
+}
+
+func deleteJob(name string) clientgotesting.DeleteActionImpl {
+	return clientgotesting.DeleteActionImpl{
+		ActionImpl: clientgotesting.ActionImpl{
+			Namespace: testNamespace,
+			Resource:  batchv1.SchemeGroupVersion.WithResource("jobs"),
+		},
+		Name: name,
+	}
+}
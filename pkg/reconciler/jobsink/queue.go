@@ -0,0 +1,315 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobsink
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rickb777/date/period"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/logging"
+
+	sinksapi "knative.dev/eventing/pkg/apis/sinks"
+	sinks "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/reconciler/jobsink/resources"
+)
+
+// reconcileQueue creates the Jobs of the queued events of js, oldest first,
+// as long as js runs fewer Jobs than its maximum number of concurrent Jobs.
+// It returns the time after which the next batch is due, or zero when there
+// is none.
+func (r *Reconciler) reconcileQueue(ctx context.Context, js *sinks.JobSink) (time.Duration, error) {
+	queued, err := r.queuedSecretLister.Secrets(js.Namespace).List(labels.SelectorFromSet(labels.Set{
+		sinksapi.JobSinkNameLabel:   js.Name,
+		sinksapi.JobSinkQueuedLabel: "true",
+	}))
+	if err != nil {
+		return 0, err
+	}
+	if len(queued) == 0 {
+		return 0, nil
+	}
+
+	// Without a limit, which might have been removed since the events were
+	// queued, all of them are admitted.
+	available := len(queued)
+	if js.Spec.MaxConcurrentJobs != nil {
+		// The Jobs are listed from the API server, since the informer might
+		// not know yet about the Jobs created by the previous reconciliation.
+		jobs, err := r.kubeClientSet.BatchV1().Jobs(js.Namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: labels.SelectorFromSet(labels.Set{sinksapi.JobSinkNameLabel: js.Name}).String(),
+		})
+		if err != nil {
+			return 0, err
+		}
+		active := 0
+		for i := range jobs.Items {
+			if finishedCondition(&jobs.Items[i]) == nil {
+				active++
+			}
+		}
+		available = int(*js.Spec.MaxConcurrentJobs) - active
+	}
+
+	sort.Slice(queued, func(i, j int) bool {
+		if queued[i].CreationTimestamp.Equal(&queued[j].CreationTimestamp) {
+			return queued[i].Name < queued[j].Name
+		}
+		return queued[i].CreationTimestamp.Before(&queued[j].CreationTimestamp)
+	})
+
+	js = js.DeepCopy() // Do not modify the reconciled object.
+	js.SetDefaults(ctx)
+
+	if js.Spec.Batching != nil {
+		return r.reconcileBatches(ctx, js, queued, available)
+	}
+
+	if available <= 0 {
+		return 0, nil
+	}
+	if len(queued) > available {
+		queued = queued[:available]
+	}
+	for _, secret := range queued {
+		if err := r.admit(ctx, js, secret); err != nil {
+			return 0, fmt.Errorf("failed to create job %s: %w", secret.Name, err)
+		}
+	}
+	return 0, nil
+}
+
+// reconcileBatches collects the queued events of js into batches and creates
+// their Jobs, as long as available is positive. A batch is created once it is
+// full or once its oldest event waited for the maximum wait time.
+func (r *Reconciler) reconcileBatches(ctx context.Context, js *sinks.JobSink, queued []*corev1.Secret, available int) (time.Duration, error) {
+	// The batches collected by a previous reconciliation are completed
+	// first, regardless of the limit, since their Jobs might already exist.
+	var pending []string
+	batches := make(map[string][]*corev1.Secret)
+	free := make([]*corev1.Secret, 0, len(queued))
+	for _, secret := range queued {
+		name, ok := secret.Labels[sinksapi.JobSinkBatchLabel]
+		if !ok {
+			free = append(free, secret)
+			continue
+		}
+		if _, ok := batches[name]; !ok {
+			pending = append(pending, name)
+		}
+		batches[name] = append(batches[name], secret)
+	}
+	for _, name := range pending {
+		if err := r.admitBatch(ctx, js, name, batches[name]); err != nil {
+			return 0, fmt.Errorf("failed to create batch job %s: %w", name, err)
+		}
+		available--
+	}
+
+	maxEvents := int(*js.Spec.Batching.MaxEvents)
+	p, err := period.Parse(*js.Spec.Batching.MaxWait)
+	if err != nil {
+		return 0, fmt.Errorf("invalid batching max wait %q: %w", *js.Spec.Batching.MaxWait, err)
+	}
+	maxWait, _ := p.Duration()
+
+	for ; available > 0 && len(free) > 0; available-- {
+		n, full := nextBatch(free, maxEvents)
+		if !full {
+			if waited := time.Since(free[0].CreationTimestamp.Time); waited < maxWait {
+				return maxWait - waited, nil
+			}
+		}
+
+		batch := free[:n]
+		free = free[n:]
+		name := resources.BatchJobName(js, batch[0])
+
+		// The events are labeled with their batch before its Job is
+		// created, so that a failed reconciliation completes the same batch.
+		for i, secret := range batch {
+			secret = secret.DeepCopy()
+			secret.Labels[sinksapi.JobSinkBatchLabel] = name
+			updated, err := r.kubeClientSet.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+			if err != nil {
+				return 0, fmt.Errorf("failed to collect event %s into batch %s: %w", secret.Name, name, err)
+			}
+			batch[i] = updated
+		}
+
+		if err := r.admitBatch(ctx, js, name, batch); err != nil {
+			return 0, fmt.Errorf("failed to create batch job %s: %w", name, err)
+		}
+	}
+	return 0, nil
+}
+
+// nextBatch returns the number of the oldest events of free, at most
+// maxEvents, collected into the next batch, and whether the batch is full
+// because it has maxEvents events or because the next event wouldn't fit in
+// the Secret of the batch.
+func nextBatch(free []*corev1.Secret, maxEvents int) (int, bool) {
+	size := 0
+	for i, secret := range free {
+		if i == maxEvents {
+			return i, true
+		}
+		size += resources.BatchEventSize(secret.Data[resources.EventSecretKey])
+		if size > resources.MaxBatchSize {
+			// The ingress rejects the events which don't fit in a batch on
+			// their own, but a single event is batched anyway.
+			return max(i, 1), true
+		}
+	}
+	return len(free), len(free) == maxEvents
+}
+
+// admitBatch creates the Job named name of the batch of the events held by
+// the queued secrets, and hands the secrets over to it.
+func (r *Reconciler) admitBatch(ctx context.Context, js *sinks.JobSink, name string, events []*corev1.Secret) error {
+	job := resources.MakeBatchJob(js, name, len(events))
+
+	created, err := r.kubeClientSet.BatchV1().Jobs(job.Namespace).Create(ctx, job, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		created, err = r.kubeClientSet.BatchV1().Jobs(job.Namespace).Get(ctx, job.Name, metav1.GetOptions{})
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = r.kubeClientSet.CoreV1().Secrets(js.Namespace).Create(ctx, resources.MakeBatchSecret(js, created, events), metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	for _, secret := range events {
+		if err := r.handOver(ctx, secret, created); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// admit creates the Job of the event held by the queued secret and hands the
+// secret over to it.
+func (r *Reconciler) admit(ctx context.Context, js *sinks.JobSink, secret *corev1.Secret) error {
+	job := resources.MakeJob(js, secret.Name, resources.EventRefFromSecret(secret))
+
+	created, err := r.kubeClientSet.BatchV1().Jobs(job.Namespace).Create(ctx, job, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		created, err = r.kubeClientSet.BatchV1().Jobs(job.Namespace).Get(ctx, job.Name, metav1.GetOptions{})
+	}
+	if err != nil {
+		return err
+	}
+
+	return r.handOver(ctx, secret, created)
+}
+
+// handOver makes job the owner of the queued secret, which is no longer
+// queued.
+func (r *Reconciler) handOver(ctx context.Context, secret *corev1.Secret, job *batchv1.Job) error {
+	secret = secret.DeepCopy()
+	delete(secret.Labels, sinksapi.JobSinkQueuedLabel)
+	secret.OwnerReferences = []metav1.OwnerReference{resources.JobOwnerReference(job)}
+	_, err := r.kubeClientSet.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+// reconcileRetention deletes the finished Jobs of js, and with them their
+// events, exceeding its retention policy. It returns the time after which
+// the next finished Job expires, or zero when none does.
+func (r *Reconciler) reconcileRetention(ctx context.Context, js *sinks.JobSink) (time.Duration, error) {
+	retention := js.Spec.Retention
+	if retention == nil || (retention.TTL == nil && retention.KeepLast == nil) {
+		return 0, nil
+	}
+
+	jobs, err := r.listJobs(js)
+	if err != nil {
+		return 0, err
+	}
+
+	type finishedJob struct {
+		job        *batchv1.Job
+		finishedAt time.Time
+	}
+	finished := make([]finishedJob, 0, len(jobs))
+	for _, job := range jobs {
+		c := finishedCondition(job)
+		if c == nil {
+			continue
+		}
+		if completionPending(js, job) {
+			// Keep the Job until its completion event is sent.
+			continue
+		}
+		finished = append(finished, finishedJob{job: job, finishedAt: finishedTime(job, c).Time})
+	}
+	// Most recently finished first.
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[i].finishedAt.After(finished[j].finishedAt)
+	})
+
+	var ttl time.Duration
+	if retention.TTL != nil {
+		p, err := period.Parse(*retention.TTL)
+		if err != nil {
+			return 0, fmt.Errorf("invalid retention TTL %q: %w", *retention.TTL, err)
+		}
+		ttl, _ = p.Duration()
+	}
+
+	now := time.Now()
+	var next time.Duration
+	for i, f := range finished {
+		keep := retention.KeepLast == nil || i < int(*retention.KeepLast)
+		if keep && retention.TTL != nil {
+			expiresIn := f.finishedAt.Add(ttl).Sub(now)
+			keep = expiresIn > 0
+			if keep && (next == 0 || expiresIn < next) {
+				next = expiresIn
+			}
+		}
+		if keep {
+			continue
+		}
+
+		logging.FromContext(ctx).Debugw("Deleting finished job", "job", f.job.Name)
+		err := r.kubeClientSet.BatchV1().Jobs(f.job.Namespace).Delete(ctx, f.job.Name, metav1.DeleteOptions{
+			PropagationPolicy: ptr.To(metav1.DeletePropagationBackground),
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return 0, fmt.Errorf("failed to delete job %s: %w", f.job.Name, err)
+		}
+	}
+	return next, nil
+}
+
+func (r *Reconciler) listJobs(js *sinks.JobSink) ([]*batchv1.Job, error) {
+	return r.jobLister.Jobs(js.Namespace).List(labels.SelectorFromSet(labels.Set{
+		sinksapi.JobSinkNameLabel: js.Name,
+	}))
+}
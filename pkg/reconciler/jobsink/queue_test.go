@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobsink
+
+import (
+	"bytes"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/eventing/pkg/reconciler/jobsink/resources"
+)
+
+func TestNextBatch(t *testing.T) {
+	event := func(size int) *corev1.Secret {
+		// The line holding the event in the batch ends with a line break.
+		return &corev1.Secret{Data: map[string][]byte{resources.EventSecretKey: bytes.Repeat([]byte("x"), size-1)}}
+	}
+	third := resources.MaxBatchSize / 3
+
+	tests := []struct {
+		name      string
+		free      []*corev1.Secret
+		maxEvents int
+		want      int
+		wantFull  bool
+	}{{
+		name:      "not full",
+		free:      []*corev1.Secret{event(10), event(10)},
+		maxEvents: 3,
+		want:      2,
+	}, {
+		name:      "max events",
+		free:      []*corev1.Secret{event(10), event(10), event(10)},
+		maxEvents: 2,
+		want:      2,
+		wantFull:  true,
+	}, {
+		name:      "exactly max events",
+		free:      []*corev1.Secret{event(10), event(10)},
+		maxEvents: 2,
+		want:      2,
+		wantFull:  true,
+	}, {
+		name:      "max size",
+		free:      []*corev1.Secret{event(third), event(third), event(third), event(third)},
+		maxEvents: 10,
+		want:      3,
+		wantFull:  true,
+	}, {
+		name:      "exactly max size",
+		free:      []*corev1.Secret{event(resources.MaxBatchSize), event(10)},
+		maxEvents: 10,
+		want:      1,
+		wantFull:  true,
+	}, {
+		name:      "event larger than max size",
+		free:      []*corev1.Secret{event(resources.MaxBatchSize + 1), event(10)},
+		maxEvents: 10,
+		want:      1,
+		wantFull:  true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, full := nextBatch(tt.free, tt.maxEvents)
+			if got != tt.want || full != tt.wantFull {
+				t.Errorf("nextBatch() = %d, %v, want %d, %v", got, full, tt.want, tt.wantFull)
+			}
+		})
+	}
+}
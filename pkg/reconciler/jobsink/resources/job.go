@@ -0,0 +1,242 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"bytes"
+	"strconv"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/ptr"
+
+	"knative.dev/eventing/pkg/apis/sinks"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+)
+
+const (
+	// EventVolumeName is the name of the volume holding the event Secret.
+	EventVolumeName = "jobsink-event"
+	// EventMountPath is the default path the event Secret is mounted at.
+	EventMountPath = "/etc/jobsink-event"
+	// EventPathEnvVar is the environment variable holding the path the
+	// event Secret is mounted at.
+	EventPathEnvVar = "K_EVENT_PATH"
+	// EventSecretKey is the key of the event in the event Secret.
+	EventSecretKey = "event"
+	// EventsSecretKey is the key of the JSON-lines file holding the events
+	// in the Secret of a batch Job.
+	EventsSecretKey = "events.jsonl"
+	// MaxBatchSize is the maximum size in bytes of the events of a batch,
+	// which are all held by a single Secret, limited to 1 MiB.
+	MaxBatchSize = 1 << 20
+)
+
+// EventRef identifies the event a Job is created for.
+type EventRef struct {
+	ID     string
+	Source string
+}
+
+// MakeJob creates the Job named jobName running the job template of js for
+// the event identified by ref. The event is mounted from the Secret with the
+// same name as the Job.
+func MakeJob(js *sinksv1alpha1.JobSink, jobName string, ref EventRef) *batchv1.Job {
+	job := makeJob(js, jobName)
+	job.Annotations[sinks.JobSinkEventIDAnnotation] = ref.ID
+	job.Annotations[sinks.JobSinkEventSourceAnnotation] = ref.Source
+	return job
+}
+
+// MakeBatchJob creates the Job named jobName running the job template of js
+// for a batch of size events. The events are mounted from the Secret with
+// the same name as the Job.
+func MakeBatchJob(js *sinksv1alpha1.JobSink, jobName string, size int) *batchv1.Job {
+	job := makeJob(js, jobName)
+	job.Labels[sinks.JobSinkBatchLabel] = jobName
+	job.Annotations[sinks.JobSinkBatchSizeAnnotation] = strconv.Itoa(size)
+	return job
+}
+
+func makeJob(js *sinksv1alpha1.JobSink, jobName string) *batchv1.Job {
+	job := js.Spec.Job.DeepCopy()
+	job.Name = jobName
+	job.Namespace = js.Namespace
+	if job.Labels == nil {
+		job.Labels = make(map[string]string, 3)
+	}
+	job.Labels[sinks.JobSinkIDLabel] = jobName
+	job.Labels[sinks.JobSinkNameLabel] = js.Name
+	job.Labels[sinks.JobSinkJobsLabel] = "true"
+	// The pods are labeled too, so the controller can watch them to read
+	// the result of the Job.
+	if job.Spec.Template.Labels == nil {
+		job.Spec.Template.Labels = make(map[string]string, 2)
+	}
+	job.Spec.Template.Labels[sinks.JobSinkNameLabel] = js.Name
+	job.Spec.Template.Labels[sinks.JobSinkJobsLabel] = "true"
+	if job.Annotations == nil {
+		job.Annotations = make(map[string]string, 2)
+	}
+	job.OwnerReferences = append(job.OwnerReferences, jobSinkOwnerReference(js))
+
+	var mountPathName string
+	for i := range job.Spec.Template.Spec.Containers {
+		found := false
+		for j := range job.Spec.Template.Spec.Containers[i].VolumeMounts {
+			if job.Spec.Template.Spec.Containers[i].VolumeMounts[j].Name == EventVolumeName {
+				found = true
+				mountPathName = job.Spec.Template.Spec.Containers[i].VolumeMounts[j].MountPath
+				break
+			}
+		}
+		if !found {
+			job.Spec.Template.Spec.Containers[i].VolumeMounts = append(job.Spec.Template.Spec.Containers[i].VolumeMounts, corev1.VolumeMount{
+				Name:      EventVolumeName,
+				ReadOnly:  true,
+				MountPath: EventMountPath,
+			})
+			mountPathName = EventMountPath
+		}
+		job.Spec.Template.Spec.Containers[i].Env = append(job.Spec.Template.Spec.Containers[i].Env, corev1.EnvVar{
+			Name:  EventPathEnvVar,
+			Value: mountPathName,
+		})
+	}
+
+	found := false
+	for i := range job.Spec.Template.Spec.Volumes {
+		if job.Spec.Template.Spec.Volumes[i].Name == EventVolumeName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, corev1.Volume{
+			Name: EventVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: jobName},
+			},
+		})
+	}
+
+	return job
+}
+
+// MakeEventSecret creates the Secret holding the event of the Job named
+// jobName. The Secret is owned by job, or, when job is nil, it is owned by js
+// and labeled as queued until the Job is created.
+func MakeEventSecret(js *sinksv1alpha1.JobSink, jobName string, ref EventRef, event []byte, job *batchv1.Job) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName,
+			Namespace: js.Namespace,
+			Labels: map[string]string{
+				sinks.JobSinkIDLabel:   jobName,
+				sinks.JobSinkNameLabel: js.Name,
+			},
+			Annotations: map[string]string{
+				sinks.JobSinkEventIDAnnotation:     ref.ID,
+				sinks.JobSinkEventSourceAnnotation: ref.Source,
+			},
+		},
+		Immutable: ptr.Bool(true),
+		Data:      map[string][]byte{EventSecretKey: event},
+		Type:      corev1.SecretTypeOpaque,
+	}
+
+	if job != nil {
+		secret.OwnerReferences = []metav1.OwnerReference{JobOwnerReference(job)}
+	} else {
+		secret.Labels[sinks.JobSinkQueuedLabel] = "true"
+		secret.OwnerReferences = []metav1.OwnerReference{jobSinkOwnerReference(js)}
+	}
+
+	return secret
+}
+
+// BatchJobName returns the name of the batch Job whose oldest event is held
+// by first.
+func BatchJobName(js *sinksv1alpha1.JobSink, first *corev1.Secret) string {
+	return kmeta.ChildName(js.Name+"-batch-", string(first.UID))
+}
+
+// BatchEventSize returns the size in bytes of the line holding event in the
+// Secret of a batch.
+func BatchEventSize(event []byte) int {
+	return len(bytes.TrimSpace(event)) + 1
+}
+
+// MakeBatchSecret creates the Secret, owned by job, holding the events held
+// by the queued Secrets as a JSON-lines file, in the given order.
+func MakeBatchSecret(js *sinksv1alpha1.JobSink, job *batchv1.Job, events []*corev1.Secret) *corev1.Secret {
+	var data bytes.Buffer
+	for _, e := range events {
+		data.Write(bytes.TrimSpace(e.Data[EventSecretKey]))
+		data.WriteByte('\n')
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      job.Name,
+			Namespace: js.Namespace,
+			Labels: map[string]string{
+				sinks.JobSinkIDLabel:    job.Name,
+				sinks.JobSinkNameLabel:  js.Name,
+				sinks.JobSinkBatchLabel: job.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{JobOwnerReference(job)},
+		},
+		Immutable: ptr.Bool(true),
+		Data:      map[string][]byte{EventsSecretKey: data.Bytes()},
+		Type:      corev1.SecretTypeOpaque,
+	}
+}
+
+func jobSinkOwnerReference(js *sinksv1alpha1.JobSink) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion:         sinksv1alpha1.SchemeGroupVersion.String(),
+		Kind:               sinks.JobSinkResource.Resource,
+		Name:               js.GetName(),
+		UID:                js.GetUID(),
+		Controller:         ptr.Bool(true),
+		BlockOwnerDeletion: ptr.Bool(false),
+	}
+}
+
+// JobOwnerReference returns the owner reference making job the owner of its
+// event Secret.
+func JobOwnerReference(job *batchv1.Job) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion:         "batch/v1",
+		Kind:               "Job",
+		Name:               job.Name,
+		UID:                job.UID,
+		Controller:         ptr.Bool(true),
+		BlockOwnerDeletion: ptr.Bool(false),
+	}
+}
+
+// EventRefFromSecret returns the event the queued Secret holds.
+func EventRefFromSecret(secret *corev1.Secret) EventRef {
+	return EventRef{
+		ID:     secret.Annotations[sinks.JobSinkEventIDAnnotation],
+		Source: secret.Annotations[sinks.JobSinkEventSourceAnnotation],
+	}
+}
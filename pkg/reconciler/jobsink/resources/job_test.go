@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/eventing/pkg/apis/sinks"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+)
+
+func testJobSink() *sinksv1alpha1.JobSink {
+	return &sinksv1alpha1.JobSink{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "js",
+			Namespace: "ns",
+			UID:       "js-uid",
+		},
+		Spec: sinksv1alpha1.JobSinkSpec{
+			Job: &batchv1.Job{
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{Name: "default"},
+								{
+									Name: "custom",
+									VolumeMounts: []corev1.VolumeMount{
+										{Name: EventVolumeName, MountPath: "/custom"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestMakeJob(t *testing.T) {
+	js := testJobSink()
+	job := MakeJob(js, "job", EventRef{ID: "id", Source: "source"})
+
+	wantLabels := map[string]string{
+		sinks.JobSinkIDLabel:   "job",
+		sinks.JobSinkNameLabel: "js",
+		sinks.JobSinkJobsLabel: "true",
+	}
+	if diff := cmp.Diff(wantLabels, job.Labels); diff != "" {
+		t.Error("unexpected labels (-want, +got) =", diff)
+	}
+	wantAnnotations := map[string]string{
+		sinks.JobSinkEventIDAnnotation:     "id",
+		sinks.JobSinkEventSourceAnnotation: "source",
+	}
+	if diff := cmp.Diff(wantAnnotations, job.Annotations); diff != "" {
+		t.Error("unexpected annotations (-want, +got) =", diff)
+	}
+	if len(job.OwnerReferences) != 1 || job.OwnerReferences[0].UID != js.UID {
+		t.Errorf("unexpected owner references %v", job.OwnerReferences)
+	}
+
+	wantEnv := map[string]string{"default": EventMountPath, "custom": "/custom"}
+	for _, c := range job.Spec.Template.Spec.Containers {
+		if diff := cmp.Diff([]corev1.EnvVar{{Name: EventPathEnvVar, Value: wantEnv[c.Name]}}, c.Env); diff != "" {
+			t.Errorf("unexpected env of container %s (-want, +got) = %s", c.Name, diff)
+		}
+		if len(c.VolumeMounts) != 1 {
+			t.Errorf("unexpected volume mounts of container %s: %v", c.Name, c.VolumeMounts)
+		}
+	}
+
+	wantVolumes := []corev1.Volume{{
+		Name: EventVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: "job"},
+		},
+	}}
+	if diff := cmp.Diff(wantVolumes, job.Spec.Template.Spec.Volumes); diff != "" {
+		t.Error("unexpected volumes (-want, +got) =", diff)
+	}
+
+	if js.Spec.Job.Name != "" || len(js.Spec.Job.Spec.Template.Spec.Volumes) != 0 {
+		t.Error("MakeJob modified the JobSink")
+	}
+}
+
+func TestMakeEventSecret(t *testing.T) {
+	js := testJobSink()
+	ref := EventRef{ID: "id", Source: "source"}
+
+	queued := MakeEventSecret(js, "job", ref, []byte("{}"), nil)
+	if queued.Labels[sinks.JobSinkQueuedLabel] != "true" {
+		t.Errorf("queued secret not labeled as queued: %v", queued.Labels)
+	}
+	if len(queued.OwnerReferences) != 1 || queued.OwnerReferences[0].UID != js.UID {
+		t.Errorf("queued secret not owned by the JobSink: %v", queued.OwnerReferences)
+	}
+	if got := EventRefFromSecret(queued); got != ref {
+		t.Errorf("EventRefFromSecret() = %v, want %v", got, ref)
+	}
+
+	job := MakeJob(js, "job", ref)
+	job.UID = "job-uid"
+	secret := MakeEventSecret(js, "job", ref, []byte("{}"), job)
+	if _, ok := secret.Labels[sinks.JobSinkQueuedLabel]; ok {
+		t.Errorf("secret labeled as queued: %v", secret.Labels)
+	}
+	if diff := cmp.Diff([]metav1.OwnerReference{JobOwnerReference(job)}, secret.OwnerReferences); diff != "" {
+		t.Error("unexpected owner references (-want, +got) =", diff)
+	}
+	if got := string(secret.Data[EventSecretKey]); got != "{}" {
+		t.Errorf("unexpected event %q", got)
+	}
+}
+
+func TestMakeBatch(t *testing.T) {
+	js := testJobSink()
+	events := []*corev1.Secret{
+		MakeEventSecret(js, "event-1", EventRef{ID: "1", Source: "source"}, []byte(`{"id":"1"}`), nil),
+		MakeEventSecret(js, "event-2", EventRef{ID: "2", Source: "source"}, []byte(`{"id":"2"}`+"\n"), nil),
+	}
+	events[0].UID = "event-1-uid"
+
+	name := BatchJobName(js, events[0])
+	if name != BatchJobName(js, events[0].DeepCopy()) {
+		t.Error("BatchJobName is not deterministic")
+	}
+
+	job := MakeBatchJob(js, name, len(events))
+	if got := job.Labels[sinks.JobSinkBatchLabel]; got != name {
+		t.Errorf("batch label = %q, want %q", got, name)
+	}
+	if got := job.Annotations[sinks.JobSinkBatchSizeAnnotation]; got != "2" {
+		t.Errorf("batch size annotation = %q, want 2", got)
+	}
+	if _, ok := job.Annotations[sinks.JobSinkEventIDAnnotation]; ok {
+		t.Error("batch job has an event ID annotation")
+	}
+
+	secret := MakeBatchSecret(js, job, events)
+	want := `{"id":"1"}` + "\n" + `{"id":"2"}` + "\n"
+	if got := string(secret.Data[EventsSecretKey]); got != want {
+		t.Errorf("events = %q, want %q", got, want)
+	}
+	if secret.Name != job.Name {
+		t.Errorf("secret name = %q, want %q", secret.Name, job.Name)
+	}
+	if diff := cmp.Diff([]metav1.OwnerReference{JobOwnerReference(job)}, secret.OwnerReferences); diff != "" {
+		t.Error("unexpected owner references (-want, +got) =", diff)
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"knative.dev/eventing/pkg/apis/sources/v1alpha1"
+)
+
+func TestNewContainerSource(t *testing.T) {
+	t.Setenv(imageEnvVar, "quay.io/fake-image/mqtt-source")
+
+	secret := func(key string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mqtt"},
+			Key:                  key,
+		}
+	}
+
+	source := &v1alpha1.MQTTSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sensors",
+			Namespace: "ns",
+		},
+		Spec: v1alpha1.MQTTSourceSpec{
+			BrokerURL: "ssl://broker:8883",
+			ClientID:  "ns-sensors",
+			Topics:    []v1alpha1.MQTTTopic{{Filter: "sensors/#", QoS: 1}},
+			Auth: &v1alpha1.MQTTAuth{
+				Username: secret("username"),
+				Password: secret("password"),
+			},
+			TLS: &v1alpha1.MQTTTLS{
+				CACert: secret("ca.crt"),
+			},
+			Reconnect: &v1alpha1.MQTTReconnect{
+				InitialDelay: ptr.To("PT2S"),
+				MaxDelay:     ptr.To("PT5M"),
+			},
+			ServiceAccountName: "mqtt",
+		},
+	}
+
+	cs := NewContainerSource(source)
+
+	if cs.Name != "sensors-containersource" || cs.Namespace != "ns" {
+		t.Errorf("Unexpected ContainerSource %s/%s", cs.Namespace, cs.Name)
+	}
+	if len(cs.OwnerReferences) != 1 || cs.OwnerReferences[0].Kind != "MQTTSource" {
+		t.Errorf("Unexpected owner references %v", cs.OwnerReferences)
+	}
+
+	pod := cs.Spec.Template.Spec
+	if pod.ServiceAccountName != "mqtt" {
+		t.Errorf("ServiceAccountName = %q, want mqtt", pod.ServiceAccountName)
+	}
+	if len(pod.Containers) != 1 || pod.Containers[0].Image != "quay.io/fake-image/mqtt-source" {
+		t.Fatalf("Unexpected containers %v", pod.Containers)
+	}
+
+	want := []corev1.EnvVar{
+		{Name: "NAMESPACE", Value: "ns"},
+		{Name: "NAME", Value: "sensors"},
+		{Name: "MQTT_BROKER_URL", Value: "ssl://broker:8883"},
+		{Name: "MQTT_CLIENT_ID", Value: "ns-sensors"},
+		{Name: "MQTT_TOPICS", Value: `[{"filter":"sensors/#","qos":1}]`},
+		{Name: "MQTT_USERNAME", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secret("username")}},
+		{Name: "MQTT_PASSWORD", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secret("password")}},
+		{Name: "MQTT_TLS_CA_CERT", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secret("ca.crt")}},
+		{Name: "MQTT_RECONNECT_INITIAL_DELAY", Value: "2s"},
+		{Name: "MQTT_RECONNECT_MAX_DELAY", Value: "5m0s"},
+	}
+	if diff := cmp.Diff(want, pod.Containers[0].Env); diff != "" {
+		t.Error("Unexpected env (-want, +got):", diff)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	v1 "knative.dev/eventing/pkg/apis/flows/v1"
 	"knative.dev/eventing/pkg/auth"
 	"knative.dev/eventing/pkg/duck"
+	namespaceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection/clients/dynamicclient"
@@ -31,6 +32,7 @@ import (
 
 	eventingclient "knative.dev/eventing/pkg/client/injection/client"
 	"knative.dev/eventing/pkg/client/injection/ducks/duck/v1/channelable"
+	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy"
 	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
 	"knative.dev/eventing/pkg/client/injection/informers/flows/v1/parallel"
 	"knative.dev/eventing/pkg/client/injection/informers/messaging/v1/subscription"
@@ -47,13 +49,17 @@ func NewController(
 	parallelInformer := parallel.Get(ctx)
 	subscriptionInformer := subscription.Get(ctx)
 	eventPolicyInformer := eventpolicy.Get(ctx)
+	clusterEventPolicyInformer := clustereventpolicy.Get(ctx)
+	namespaceInformer := namespaceinformer.Get(ctx)
 
 	r := &Reconciler{
-		parallelLister:     parallelInformer.Lister(),
-		subscriptionLister: subscriptionInformer.Lister(),
-		dynamicClientSet:   dynamicclient.Get(ctx),
-		eventingClientSet:  eventingclient.Get(ctx),
-		eventPolicyLister:  eventPolicyInformer.Lister(),
+		parallelLister:           parallelInformer.Lister(),
+		subscriptionLister:       subscriptionInformer.Lister(),
+		dynamicClientSet:         dynamicclient.Get(ctx),
+		eventingClientSet:        eventingclient.Get(ctx),
+		eventPolicyLister:        eventPolicyInformer.Lister(),
+		clusterEventPolicyLister: clusterEventPolicyInformer.Lister(),
+		namespaceLister:          namespaceInformer.Lister(),
 	}
 
 	var globalResync func()
@@ -89,5 +95,23 @@ func NewController(
 		impl.EnqueueKey,
 	))
 
+	// Enqueue the Parallel, if we have a ClusterEventPolicy which was applying
+	// or got updated and now is applying to the Parallel
+	clusterEventPolicyInformer.Informer().AddEventHandler(auth.ClusterEventPolicyEventHandler(
+		parallelInformer.Informer().GetIndexer(),
+		parallelGK,
+		namespaceInformer.Lister(),
+		impl.EnqueueKey,
+	))
+
+	// Enqueue the Parallel, if the labels of its namespace got updated and a ClusterEventPolicy
+	// started or stopped applying to the Parallel
+	namespaceInformer.Informer().AddEventHandler(auth.ClusterEventPolicyNamespaceEventHandler(
+		clusterEventPolicyInformer.Lister(),
+		parallelInformer.Informer().GetIndexer(),
+		parallelGK,
+		impl.EnqueueKey,
+	))
+
 	return impl
 }
@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/dynamic"
+	corev1listers "k8s.io/client-go/listers/core/v1"
 	duckapis "knative.dev/pkg/apis/duck"
 
 	"knative.dev/pkg/kmeta"
@@ -64,7 +65,9 @@ type Reconciler struct {
 	// dynamicClientSet allows us to configure pluggable Build objects
 	dynamicClientSet dynamic.Interface
 
-	eventPolicyLister eventingv1alpha1listers.EventPolicyLister
+	eventPolicyLister        eventingv1alpha1listers.EventPolicyLister
+	clusterEventPolicyLister eventingv1alpha1listers.ClusterEventPolicyLister
+	namespaceLister          corev1listers.NamespaceLister
 }
 
 // Check that our Reconciler implements parallelreconciler.Interface
@@ -151,7 +154,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, p *v1.Parallel) pkgrecon
 		return fmt.Errorf("failed to reconcile EventPolicies for Parallel: %w", err)
 	}
 
-	err := auth.UpdateStatusWithEventPolicies(featureFlags, &p.Status.AppliedEventPoliciesStatus, &p.Status, r.eventPolicyLister, v1.SchemeGroupVersion.WithKind("Parallel"), p.ObjectMeta)
+	err := auth.UpdateStatusWithEventPolicies(featureFlags, &p.Status.AppliedEventPoliciesStatus, &p.Status, r.eventPolicyLister, r.clusterEventPolicyLister, r.namespaceLister, v1.SchemeGroupVersion.WithKind("Parallel"), p.ObjectMeta)
 	if err != nil {
 		return fmt.Errorf("could not update parallel status with EventPolicies: %v", err)
 	}
@@ -465,14 +468,22 @@ func (r *Reconciler) prepareIngressChannelEventpolicies(p *v1.Parallel, ingressC
 		return nil, fmt.Errorf("could not get EventPolicies for Parallel %s/%s: %w", p.Namespace, p.Name, err)
 	}
 
-	if len(applyingEventPoliciesForParallel) == 0 {
+	applyingClusterEventPoliciesForParallel, err := auth.GetClusterEventPoliciesForResource(r.clusterEventPolicyLister, r.namespaceLister, v1.SchemeGroupVersion.WithKind("Parallel"), p.ObjectMeta)
+	if err != nil {
+		return nil, fmt.Errorf("could not get ClusterEventPolicies for Parallel %s/%s: %w", p.Namespace, p.Name, err)
+	}
+
+	if len(applyingEventPoliciesForParallel) == 0 && len(applyingClusterEventPoliciesForParallel) == 0 {
 		return nil, nil
 	}
 
-	ingressChannelEventPolicies := make([]*eventingv1alpha1.EventPolicy, 0, len(applyingEventPoliciesForParallel))
+	ingressChannelEventPolicies := make([]*eventingv1alpha1.EventPolicy, 0, len(applyingEventPoliciesForParallel)+len(applyingClusterEventPoliciesForParallel))
 	for _, eventPolicy := range applyingEventPoliciesForParallel {
 		ingressChannelEventPolicies = append(ingressChannelEventPolicies, resources.MakeEventPolicyForParallelIngressChannel(p, ingressChannel, eventPolicy))
 	}
+	for _, clusterEventPolicy := range applyingClusterEventPoliciesForParallel {
+		ingressChannelEventPolicies = append(ingressChannelEventPolicies, resources.MakeEventPolicyForParallelIngressChannelFromClusterEventPolicy(p, ingressChannel, clusterEventPolicy))
+	}
 
 	return ingressChannelEventPolicies, nil
 }
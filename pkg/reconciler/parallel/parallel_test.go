@@ -1375,12 +1375,14 @@ func TestAllBranches(t *testing.T) {
 	table.Test(t, MakeFactory(func(ctx context.Context, listers *Listers, cmw configmap.Watcher) controller.Reconciler {
 		ctx = channelable.WithDuck(ctx)
 		r := &Reconciler{
-			parallelLister:     listers.GetParallelLister(),
-			channelableTracker: duck.NewListableTrackerFromTracker(ctx, channelable.Get, tracker.New(func(types.NamespacedName) {}, 0)),
-			subscriptionLister: listers.GetSubscriptionLister(),
-			eventingClientSet:  fakeeventingclient.Get(ctx),
-			dynamicClientSet:   fakedynamicclient.Get(ctx),
-			eventPolicyLister:  listers.GetEventPolicyLister(),
+			parallelLister:           listers.GetParallelLister(),
+			channelableTracker:       duck.NewListableTrackerFromTracker(ctx, channelable.Get, tracker.New(func(types.NamespacedName) {}, 0)),
+			subscriptionLister:       listers.GetSubscriptionLister(),
+			eventingClientSet:        fakeeventingclient.Get(ctx),
+			dynamicClientSet:         fakedynamicclient.Get(ctx),
+			eventPolicyLister:        listers.GetEventPolicyLister(),
+			clusterEventPolicyLister: listers.GetClusterEventPolicyLister(),
+			namespaceLister:          listers.GetNamespaceLister(),
 		}
 		return parallel.NewReconciler(ctx, logging.FromContext(ctx),
 			fakeeventingclient.Get(ctx), listers.GetParallelLister(),
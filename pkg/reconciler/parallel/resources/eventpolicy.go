@@ -117,3 +117,41 @@ func MakeEventPolicyForParallelIngressChannel(p *flowsv1.Parallel, ingressChanne
 		},
 	}
 }
+
+// MakeEventPolicyForParallelIngressChannelFromClusterEventPolicy creates an EventPolicy for the ingress channel
+// of a Parallel, to which the given ClusterEventPolicy applies.
+func MakeEventPolicyForParallelIngressChannelFromClusterEventPolicy(p *flowsv1.Parallel, ingressChannel *eventingduckv1.Channelable, clusterPolicy *eventingv1alpha1.ClusterEventPolicy) *eventingv1alpha1.EventPolicy {
+	return &eventingv1alpha1.EventPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ingressChannel.Namespace,
+			Name:      ParallelEventPolicyName(p.Name, "cluster-"+clusterPolicy.Name),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: flowsv1.SchemeGroupVersion.String(),
+					Kind:       parallelKind,
+					Name:       p.Name,
+					UID:        p.UID,
+				}, {
+					APIVersion: eventingv1alpha1.SchemeGroupVersion.String(),
+					Kind:       eventingv1alpha1.ClusterEventPolicyKind,
+					Name:       clusterPolicy.Name,
+					UID:        clusterPolicy.UID,
+				},
+			},
+			Labels: LabelsForParallelChannelsEventPolicy(p.Name),
+		},
+		Spec: eventingv1alpha1.EventPolicySpec{
+			To: []eventingv1alpha1.EventPolicySpecTo{
+				{
+					Ref: &eventingv1alpha1.EventPolicyToReference{
+						APIVersion: ingressChannel.APIVersion,
+						Kind:       ingressChannel.Kind,
+						Name:       ingressChannel.Name,
+					},
+				},
+			},
+			From:    clusterPolicy.Spec.From,
+			Filters: clusterPolicy.Spec.Filters,
+		},
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pullsink
+
+import (
+	"context"
+
+	"knative.dev/pkg/logging"
+
+	"k8s.io/client-go/tools/cache"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	serviceaccountinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/serviceaccount/filtered"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/system"
+
+	namespaceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
+	secretinformer "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret"
+
+	"knative.dev/eventing/pkg/apis/feature"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/auth"
+	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy"
+	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
+	"knative.dev/eventing/pkg/client/injection/informers/sinks/v1alpha1/pullsink"
+	pullsinkreconciler "knative.dev/eventing/pkg/client/injection/reconciler/sinks/v1alpha1/pullsink"
+	"knative.dev/eventing/pkg/eventingtls"
+	"knative.dev/eventing/pkg/resolver"
+)
+
+// NewController initializes the controller and is called by the generated code.
+// Registers event handlers to enqueue events.
+func NewController(
+	ctx context.Context,
+	cmw configmap.Watcher,
+) *controller.Impl {
+	pullSinkInformer := pullsink.Get(ctx)
+	secretInformer := secretinformer.Get(ctx)
+	eventPolicyInformer := eventpolicy.Get(ctx)
+	clusterEventPolicyInformer := clustereventpolicy.Get(ctx)
+	namespaceInformer := namespaceinformer.Get(ctx)
+	oidcServiceaccountInformer := serviceaccountinformer.Get(ctx, auth.OIDCLabelSelector)
+
+	r := &Reconciler{
+		kubeClientSet:            kubeclient.Get(ctx),
+		systemNamespace:          system.Namespace(),
+		secretLister:             secretInformer.Lister(),
+		eventPolicyLister:        eventPolicyInformer.Lister(),
+		clusterEventPolicyLister: clusterEventPolicyInformer.Lister(),
+		namespaceLister:          namespaceInformer.Lister(),
+		serviceAccountLister:     oidcServiceaccountInformer.Lister(),
+	}
+
+	var globalResync func(obj interface{})
+
+	featureStore := feature.NewStore(logging.FromContext(ctx).Named("feature-config-store"), func(name string, value interface{}) {
+		if globalResync != nil {
+			globalResync(nil)
+		}
+	})
+	featureStore.WatchConfigs(cmw)
+
+	impl := pullsinkreconciler.NewImpl(ctx, r, func(impl *controller.Impl) controller.Options {
+		return controller.Options{
+			ConfigStore: featureStore,
+		}
+	})
+
+	r.uriResolver = resolver.NewURIResolver(ctx, cmw, impl.Tracker)
+
+	pullSinkInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+
+	globalResync = func(interface{}) {
+		impl.GlobalResync(pullSinkInformer.Informer())
+	}
+	secretInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterWithName(eventingtls.PullSinkDispatcherServerTLSSecretName),
+		Handler:    controller.HandleAll(globalResync),
+	})
+
+	oidcServiceaccountInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterController(&sinksv1alpha1.PullSink{}),
+		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+	})
+
+	pullSinkGK := sinksv1alpha1.SchemeGroupVersion.WithKind("PullSink").GroupKind()
+
+	// Enqueue the PullSink, if we have an EventPolicy which was referencing
+	// or got updated and now is referencing the PullSink.
+	eventPolicyInformer.Informer().AddEventHandler(auth.EventPolicyEventHandler(
+		pullSinkInformer.Informer().GetIndexer(),
+		pullSinkGK,
+		impl.EnqueueKey,
+	))
+
+	// Enqueue the PullSink, if we have a ClusterEventPolicy which was applying
+	// or got updated and now is applying to the PullSink
+	clusterEventPolicyInformer.Informer().AddEventHandler(auth.ClusterEventPolicyEventHandler(
+		pullSinkInformer.Informer().GetIndexer(),
+		pullSinkGK,
+		namespaceInformer.Lister(),
+		impl.EnqueueKey,
+	))
+
+	// Enqueue the PullSink, if the labels of its namespace got updated and a ClusterEventPolicy
+	// started or stopped applying to the PullSink
+	namespaceInformer.Informer().AddEventHandler(auth.ClusterEventPolicyNamespaceEventHandler(
+		clusterEventPolicyInformer.Lister(),
+		pullSinkInformer.Informer().GetIndexer(),
+		pullSinkGK,
+		impl.EnqueueKey,
+	))
+
+	return impl
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pullsink
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/network"
+	"knative.dev/pkg/reconciler"
+	"knative.dev/pkg/resolver"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/apis/feature"
+	sinks "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/auth"
+	eventingv1alpha1listers "knative.dev/eventing/pkg/client/listers/eventing/v1alpha1"
+	"knative.dev/eventing/pkg/eventingtls"
+)
+
+type Reconciler struct {
+	kubeClientSet kubernetes.Interface
+
+	secretLister             corev1listers.SecretLister
+	eventPolicyLister        eventingv1alpha1listers.EventPolicyLister
+	clusterEventPolicyLister eventingv1alpha1listers.ClusterEventPolicyLister
+	namespaceLister          corev1listers.NamespaceLister
+	serviceAccountLister     corev1listers.ServiceAccountLister
+	uriResolver              *resolver.URIResolver
+	systemNamespace          string
+}
+
+func (r *Reconciler) ReconcileKind(ctx context.Context, ps *sinks.PullSink) reconciler.Event {
+	featureFlags := feature.FromContext(ctx)
+
+	if err := auth.SetupOIDCServiceAccount(ctx, featureFlags, r.serviceAccountLister, r.kubeClientSet, sinks.SchemeGroupVersion.WithKind("PullSink"), ps.ObjectMeta, &ps.Status, func(as *duckv1.AuthStatus) {
+		ps.Status.Auth = as
+	}); err != nil {
+		return err
+	}
+
+	if err := r.reconcileDeadLetterSink(ctx, ps); err != nil {
+		return fmt.Errorf("failed to reconcile dead letter sink: %w", err)
+	}
+
+	if err := r.reconcileAddress(ctx, ps); err != nil {
+		return fmt.Errorf("failed to reconcile address: %w", err)
+	}
+
+	err := auth.UpdateStatusWithEventPolicies(featureFlags, &ps.Status.AppliedEventPoliciesStatus, &ps.Status, r.eventPolicyLister, r.clusterEventPolicyLister, r.namespaceLister, sinks.SchemeGroupVersion.WithKind("PullSink"), ps.ObjectMeta)
+	if err != nil {
+		return fmt.Errorf("could not update PullSink status with EventPolicies: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) reconcileDeadLetterSink(ctx context.Context, ps *sinks.PullSink) error {
+	if ps.Spec.Delivery == nil || ps.Spec.Delivery.DeadLetterSink == nil {
+		ps.Status.MarkDeadLetterSinkNotConfigured()
+		return nil
+	}
+
+	deadLetterSinkAddr, err := r.uriResolver.AddressableFromDestinationV1(ctx, *ps.Spec.Delivery.DeadLetterSink, ps)
+	if err != nil {
+		logging.FromContext(ctx).Errorw("Unable to get the dead letter sink's URI", zap.Error(err))
+		ps.Status.MarkDeadLetterSinkResolvedFailed("Unable to get the dead letter sink's URI", "%v", err)
+		return err
+	}
+	ps.Status.MarkDeadLetterSinkResolvedSucceeded(eventingduckv1.NewDeliveryStatusFromAddressable(deadLetterSinkAddr))
+	return nil
+}
+
+func (r *Reconciler) getCaCerts() (*string, error) {
+	// Getting the secret called "pull-sink-server-tls" from system namespace
+	secret, err := r.secretLister.Secrets(r.systemNamespace).Get(eventingtls.PullSinkDispatcherServerTLSSecretName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CA certs from %s/%s: %w", r.systemNamespace, eventingtls.PullSinkDispatcherServerTLSSecretName, err)
+	}
+	caCerts, ok := secret.Data[eventingtls.SecretCACert]
+	if !ok {
+		return nil, nil
+	}
+	return ptr.To(string(caCerts)), nil
+}
+
+func (r *Reconciler) reconcileAddress(ctx context.Context, ps *sinks.PullSink) error {
+
+	featureFlags := feature.FromContext(ctx)
+	if featureFlags.IsPermissiveTransportEncryption() {
+		caCerts, err := r.getCaCerts()
+		if err != nil {
+			return err
+		}
+
+		httpAddress := r.httpAddress(ps)
+		httpsAddress := r.httpsAddress(caCerts, ps)
+		// Permissive mode:
+		// - status.address http address with host-based routing
+		// - status.addresses:
+		//   - https address with path-based routing
+		//   - http address with host-based routing
+		ps.Status.Addresses = []duckv1.Addressable{httpsAddress, httpAddress}
+		ps.Status.Address = &httpAddress
+	} else if featureFlags.IsStrictTransportEncryption() {
+		// Strict mode: (only https addresses)
+		// - status.address https address with path-based routing
+		// - status.addresses:
+		//   - https address with path-based routing
+		caCerts, err := r.getCaCerts()
+		if err != nil {
+			return err
+		}
+
+		httpsAddress := r.httpsAddress(caCerts, ps)
+		ps.Status.Addresses = []duckv1.Addressable{httpsAddress}
+		ps.Status.Address = &httpsAddress
+	} else {
+		httpAddress := r.httpAddress(ps)
+		ps.Status.Address = &httpAddress
+	}
+
+	if featureFlags.IsOIDCAuthentication() {
+		audience := auth.GetAudience(sinks.SchemeGroupVersion.WithKind("PullSink"), ps.ObjectMeta)
+
+		logging.FromContext(ctx).Debugw("Setting the audience", zap.String("audience", audience))
+		ps.Status.Address.Audience = &audience
+		for i := range ps.Status.Addresses {
+			ps.Status.Addresses[i].Audience = &audience
+		}
+	} else {
+		logging.FromContext(ctx).Debug("Clearing the PullSink audience as OIDC is not enabled")
+		ps.Status.Address.Audience = nil
+		for i := range ps.Status.Addresses {
+			ps.Status.Addresses[i].Audience = nil
+		}
+	}
+
+	ps.GetConditionSet().Manage(ps.GetStatus()).MarkTrue(sinks.PullSinkConditionAddressable)
+
+	return nil
+}
+
+func (r *Reconciler) httpAddress(ps *sinks.PullSink) duckv1.Addressable {
+	// http address uses host-based routing
+	httpAddress := duckv1.Addressable{
+		Name: ptr.To("http"),
+		URL: &apis.URL{
+			Scheme: "http",
+			Host:   network.GetServiceHostname("pull-sink", r.systemNamespace),
+			Path:   fmt.Sprintf("/%s/%s", ps.GetNamespace(), ps.GetName()),
+		},
+	}
+	return httpAddress
+}
+
+func (r *Reconciler) httpsAddress(certs *string, ps *sinks.PullSink) duckv1.Addressable {
+	addr := r.httpAddress(ps)
+	addr.URL.Scheme = "https"
+	addr.CACerts = certs
+	return addr
+}
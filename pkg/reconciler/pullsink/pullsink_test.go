@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pullsink
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	v1 "knative.dev/pkg/client/injection/ducks/duck/v1/addressable"
+	fakekubeclient "knative.dev/pkg/client/injection/kube/client/fake"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	logtesting "knative.dev/pkg/logging/testing"
+	"knative.dev/pkg/network"
+	. "knative.dev/pkg/reconciler/testing"
+	"knative.dev/pkg/resolver"
+	"knative.dev/pkg/tracker"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	fakeeventingclient "knative.dev/eventing/pkg/client/injection/client/fake"
+	pullsinkreconciler "knative.dev/eventing/pkg/client/injection/reconciler/sinks/v1alpha1/pullsink"
+	. "knative.dev/eventing/pkg/reconciler/testing/v1"
+	. "knative.dev/eventing/pkg/reconciler/testing/v1alpha1"
+)
+
+const (
+	// testNamespace is the namespace used for testing.
+	testNamespace = "test-namespace"
+	// pullSinkName is the name of PullSink used for testing.
+	pullSinkName = "test-pullSink"
+	// readyEventPolicyName and unreadyEventPolicyName are the names of EventPolicies used for testing.
+	readyEventPolicyName   = "ready-event-policy"
+	unreadyEventPolicyName = "unready-event-policy"
+)
+
+var (
+	testKey = fmt.Sprintf("%s/%s", testNamespace, pullSinkName)
+
+	pullSinkAddressable = duckv1.Addressable{
+		Name: ptr.To("http"),
+		URL: &apis.URL{
+			Scheme: "http",
+			Host:   network.GetServiceHostname("pull-sink", testNamespace),
+			Path:   fmt.Sprintf("/%s/%s", testNamespace, pullSinkName),
+		},
+	}
+
+	deadLetterSinkURI = apis.HTTP("dls.test-namespace.svc.cluster.local")
+
+	pullSinkGVK = metav1.GroupVersionKind{
+		Group:   "sinks.knative.dev",
+		Version: "v1alpha1",
+		Kind:    "PullSink",
+	}
+)
+
+func TestReconcile(t *testing.T) {
+	table := TableTest{
+		{
+			Name: "bad work queue key",
+			Key:  "too/many/parts",
+		},
+		{
+			Name: "key not found",
+			// Make sure Reconcile handles good keys that don't exist.
+			Key: "foo/not-found",
+		}, {
+			Name: "PullSink not found",
+			Key:  testKey,
+		}, {
+			Name: "Successful reconciliation",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewPullSink(pullSinkName, testNamespace,
+					WithPullSinkConsumerSubjects("system:serviceaccount:test-namespace:*"),
+					WithInitPullSinkConditions),
+			},
+			WantErr: false,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewPullSink(pullSinkName, testNamespace,
+						WithPullSinkConsumerSubjects("system:serviceaccount:test-namespace:*"),
+						WithPullSinkAddress(&pullSinkAddressable),
+						WithPullSinkDeadLetterSinkNotConfigured(),
+						WithPullSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithPullSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		}, {
+			Name: "Successful reconciliation, dead letter sink",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewPullSink(pullSinkName, testNamespace,
+					WithPullSinkDelivery(&eventingduckv1.DeliverySpec{
+						DeadLetterSink: &duckv1.Destination{URI: deadLetterSinkURI},
+						Retry:          ptr.To[int32](3),
+					}),
+					WithInitPullSinkConditions),
+			},
+			WantErr: false,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewPullSink(pullSinkName, testNamespace,
+						WithPullSinkDelivery(&eventingduckv1.DeliverySpec{
+							DeadLetterSink: &duckv1.Destination{URI: deadLetterSinkURI},
+							Retry:          ptr.To[int32](3),
+						}),
+						WithPullSinkAddress(&pullSinkAddressable),
+						WithPullSinkDeadLetterSinkResolvedSucceeded(eventingduckv1.DeliveryStatus{DeadLetterSinkURI: deadLetterSinkURI}),
+						WithPullSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithPullSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		}, {
+			Name: "Should provision applying EventPolicies",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewPullSink(pullSinkName, testNamespace,
+					WithInitPullSinkConditions),
+				NewEventPolicy(readyEventPolicyName, testNamespace,
+					WithReadyEventPolicyCondition,
+					WithEventPolicyToRef(pullSinkGVK, pullSinkName),
+				),
+			},
+			WantErr: false,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewPullSink(pullSinkName, testNamespace,
+						WithPullSinkAddress(&pullSinkAddressable),
+						WithPullSinkDeadLetterSinkNotConfigured(),
+						WithPullSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithPullSinkEventPoliciesReady(),
+						WithPullSinkEventPoliciesListed(readyEventPolicyName),
+					),
+				},
+			},
+		}, {
+			Name: "Should mark as NotReady on unready EventPolicies",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewPullSink(pullSinkName, testNamespace,
+					WithInitPullSinkConditions),
+				NewEventPolicy(unreadyEventPolicyName, testNamespace,
+					WithUnreadyEventPolicyCondition("", ""),
+					WithEventPolicyToRef(pullSinkGVK, pullSinkName),
+				),
+			},
+			WantErr: false,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewPullSink(pullSinkName, testNamespace,
+						WithPullSinkAddress(&pullSinkAddressable),
+						WithPullSinkDeadLetterSinkNotConfigured(),
+						WithPullSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithPullSinkEventPoliciesNotReady("EventPoliciesNotReady", fmt.Sprintf("event policies %s are not ready", unreadyEventPolicyName)),
+					),
+				},
+			},
+		}, {
+			Name: "Successful reconciliation, observed generation",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewPullSink(pullSinkName, testNamespace,
+					WithPullSinkGeneration(4242),
+					WithInitPullSinkConditions),
+			},
+			WantErr: false,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewPullSink(pullSinkName, testNamespace,
+						WithPullSinkGeneration(4242),
+						WithPullSinkAddress(&pullSinkAddressable),
+						WithPullSinkDeadLetterSinkNotConfigured(),
+						WithPullSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						func(sink *v1alpha1.PullSink) {
+							sink.Status.ObservedGeneration = 4242
+						},
+						WithPullSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
+	}
+
+	logger := logtesting.TestLogger(t)
+	table.Test(t, MakeFactory(func(ctx context.Context, listers *Listers, cmw configmap.Watcher) controller.Reconciler {
+		ctx = v1.WithDuck(ctx)
+		r := &Reconciler{
+			kubeClientSet:            fakekubeclient.Get(ctx),
+			secretLister:             listers.GetSecretLister(),
+			eventPolicyLister:        listers.GetEventPolicyLister(),
+			clusterEventPolicyLister: listers.GetClusterEventPolicyLister(),
+			namespaceLister:          listers.GetNamespaceLister(),
+			serviceAccountLister:     listers.GetServiceAccountLister(),
+			uriResolver:              resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0)),
+			systemNamespace:          testNamespace,
+		}
+
+		return pullsinkreconciler.NewReconciler(ctx, logger,
+			fakeeventingclient.Get(ctx), listers.GetPullSinkLister(),
+			controller.GetEventRecorder(ctx), r)
+	},
+		false,
+		logger,
+	))
+}
@@ -50,10 +50,12 @@ func NewController(
 	triggerInformer := trigger.Get(ctx)
 	logger := logging.FromContext(ctx)
 
+	secretInformer := secretinformer.Get(ctx, SecretLabelSelector)
+
 	r := &Reconciler{
 		kubeClient:        kubeclient.Get(ctx),
 		eventingClient:    eventingclient.Get(ctx),
-		secretLister:      secretinformer.Get(ctx, SecretLabelSelector).Lister(),
+		secretLister:      secretInformer.Lister(),
 		triggerLister:     triggerInformer.Lister(),
 		brokerLister:      brokerInformer.Lister(),
 		statefulSetLister: statefulSetInformer.Lister(),
@@ -86,6 +88,11 @@ func NewController(
 		Handler: enqueueRequestRepliesForTrigger(requestReplyInformer.Lister(), impl.Enqueue),
 	})
 
+	// recreate or restore the aes secrets when they are deleted or modified
+	secretInformer.Informer().AddEventHandler(controller.HandleAll(
+		impl.EnqueueLabelOfNamespaceScopedResource(triggerNamespaceLabelKey, triggerNameLabelKey),
+	))
+
 	brokerInformer.Informer().AddEventHandler(enqueueRequestRepliesForBroker(requestReplyInformer.Lister(), impl.Enqueue))
 
 	return impl
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestreply
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/system"
+
+	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+	"knative.dev/eventing/pkg/requestreply"
+)
+
+const (
+	// keyRotatedAtAnnotation holds the time the current key of a key secret was created at
+	keyRotatedAtAnnotation = "eventing.knative.dev/RequestReply.keyRotatedAt"
+)
+
+var defaultKeyRotationPeriod = requestreply.ParsePeriod(ptr.To(v1alpha1.DefaultRequestReplyKeyRotationPeriod), 0)
+
+// reconcileKeys ensures that the secret holding the AES keys of the RequestReply exists, and rotates its key once the
+// rotation period elapsed. The previous key is kept for the longer of the timeout and the reply retention of the
+// RequestReply after a rotation, so that the correlation ids signed with it can still be verified. It returns the
+// duration after which the keys have to be reconciled again.
+func (r *Reconciler) reconcileKeys(ctx context.Context, rr *v1alpha1.RequestReply) (time.Duration, error) {
+	retention := max(requestreply.RequestTimeout(rr), requestreply.ReplyRetention(rr))
+	rotationPeriod := max(requestreply.ParsePeriod(rr.Spec.KeyRotationPeriod, defaultKeyRotationPeriod), retention)
+	now := time.Now()
+
+	legacy, err := r.secretLister.Secrets(system.Namespace()).Get(requestreply.LegacyKeySecretName)
+	if err != nil && !apierrs.IsNotFound(err) {
+		return 0, fmt.Errorf("failed to get legacy aes secret: %w", err)
+	}
+
+	secret, err := r.secretLister.Secrets(system.Namespace()).Get(keySecretName(rr))
+	if apierrs.IsNotFound(err) {
+		// carry the keys over from the legacy secret, so that the correlation ids signed with them stay valid
+		data := legacyKeys(legacy, rr)
+		if len(data) == 0 {
+			aesKey, err := createAesSecret()
+			if err != nil {
+				return 0, fmt.Errorf("failed to create new aes secret key: %w", err)
+			}
+			data[aesSecretKey(rr, 0)] = aesKey
+		}
+
+		secret, err = r.kubeClient.CoreV1().Secrets(system.Namespace()).Create(ctx, makeKeySecret(rr, now, data), metav1.CreateOptions{})
+		if err != nil {
+			return 0, fmt.Errorf("failed to create %s secret: %w", keySecretName(rr), err)
+		}
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to get aes secret: %w", err)
+	}
+
+	if err := r.removeLegacyKeys(ctx, legacy, rr); err != nil {
+		return 0, err
+	}
+
+	generation := int64(-1)
+	for keyName := range secret.Data {
+		if g, ok := requestreply.KeyGeneration(keyName); ok && keyIsForRequestReply(keyName, rr) && g > generation {
+			generation = g
+		}
+	}
+
+	rotatedAt, err := time.Parse(time.RFC3339, secret.Annotations[keyRotatedAtAnnotation])
+	if err != nil {
+		rotatedAt = secret.CreationTimestamp.Time
+	}
+
+	desired := secret.DeepCopy()
+	if desired.Data == nil {
+		desired.Data = make(map[string][]byte)
+	}
+	if desired.Annotations == nil {
+		desired.Annotations = make(map[string]string)
+	}
+
+	changed := false
+	if generation < 0 || now.Sub(rotatedAt) >= rotationPeriod {
+		aesKey, err := createAesSecret()
+		if err != nil {
+			return 0, fmt.Errorf("failed to create new aes secret key: %w", err)
+		}
+
+		generation++
+		rotatedAt = now
+		desired.Data[aesSecretKey(rr, int(generation))] = aesKey
+		desired.Annotations[keyRotatedAtAnnotation] = rotatedAt.UTC().Format(time.RFC3339)
+		changed = true
+
+		logging.FromContext(ctx).Infow("Rotated aes key", "generation", generation)
+	}
+
+	// the previous key is only needed until all the correlation ids signed with it timed out and their replies expired
+	keepPrevious := now.Sub(rotatedAt) < retention
+	for keyName := range desired.Data {
+		g, ok := requestreply.KeyGeneration(keyName)
+		if ok && keyIsForRequestReply(keyName, rr) && (g == generation || (g == generation-1 && keepPrevious)) {
+			continue
+		}
+		delete(desired.Data, keyName)
+		changed = true
+	}
+
+	if changed {
+		if _, err := r.kubeClient.CoreV1().Secrets(system.Namespace()).Update(ctx, desired, metav1.UpdateOptions{}); err != nil {
+			return 0, fmt.Errorf("failed to update %s secret: %w", desired.Name, err)
+		}
+	}
+
+	rr.Status.KeyGeneration = ptr.To(generation)
+
+	next := rotatedAt.Add(rotationPeriod).Sub(now)
+	if _, ok := desired.Data[aesSecretKey(rr, int(generation-1))]; ok {
+		next = min(next, rotatedAt.Add(retention).Sub(now))
+	}
+	return next, nil
+}
+
+func makeKeySecret(rr *v1alpha1.RequestReply, rotatedAt time.Time, data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      keySecretName(rr),
+			Namespace: system.Namespace(),
+			Labels: map[string]string{
+				"eventing.knative.dev/part-of": "request-reply",
+				triggerNameLabelKey:            rr.Name,
+				triggerNamespaceLabelKey:       rr.Namespace,
+			},
+			Annotations: map[string]string{
+				keyRotatedAtAnnotation: rotatedAt.UTC().Format(time.RFC3339),
+			},
+		},
+		Data: data,
+	}
+}
+
+// keySecretName returns the name of the secret holding the AES keys of the RequestReply
+func keySecretName(rr *v1alpha1.RequestReply) string {
+	return kmeta.ChildName("request-reply-keys-", string(rr.UID))
+}
+
+func createAesSecret() ([]byte, error) {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+
+	return key, err
+}
+
+func aesSecretKey(rr *v1alpha1.RequestReply, generation int) string {
+	return fmt.Sprintf("%s.%s.key-%d", rr.Namespace, rr.Name, generation)
+}
+
+func keyIsForRequestReply(name string, rr *v1alpha1.RequestReply) bool {
+	return strings.HasPrefix(name, fmt.Sprintf("%s.%s.", rr.Namespace, rr.Name))
+}
+
+// legacyKeys returns the keys of the RequestReply held by the legacy secret shared by all the RequestReply resources
+func legacyKeys(legacy *corev1.Secret, rr *v1alpha1.RequestReply) map[string][]byte {
+	keys := make(map[string][]byte)
+	if legacy == nil {
+		return keys
+	}
+
+	for keyName, keyValue := range legacy.Data {
+		if _, ok := requestreply.KeyGeneration(keyName); ok && keyIsForRequestReply(keyName, rr) {
+			keys[keyName] = keyValue
+		}
+	}
+	return keys
+}
+
+// removeLegacyKeys removes the keys of the RequestReply from the legacy secret once they were carried over, and deletes
+// the legacy secret once it no longer holds any keys
+func (r *Reconciler) removeLegacyKeys(ctx context.Context, legacy *corev1.Secret, rr *v1alpha1.RequestReply) error {
+	if legacy == nil {
+		return nil
+	}
+
+	desired := legacy.DeepCopy()
+	for keyName := range legacyKeys(legacy, rr) {
+		delete(desired.Data, keyName)
+	}
+
+	if len(desired.Data) == 0 {
+		err := r.kubeClient.CoreV1().Secrets(system.Namespace()).Delete(ctx, legacy.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrs.IsNotFound(err) {
+			return fmt.Errorf("failed to delete legacy aes secret: %w", err)
+		}
+		logging.FromContext(ctx).Infow("Deleted legacy aes secret", "secret", legacy.Name)
+		return nil
+	}
+
+	if len(desired.Data) != len(legacy.Data) {
+		if _, err := r.kubeClient.CoreV1().Secrets(system.Namespace()).Update(ctx, desired, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update legacy aes secret: %w", err)
+		}
+	}
+	return nil
+}
@@ -18,17 +18,14 @@ package requestreply
 
 import (
 	"context"
-	"crypto/rand"
 	"errors"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"go.uber.org/zap"
 	appsv1 "k8s.io/api/apps/v1"
-	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -40,8 +37,10 @@ import (
 	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
 	clientset "knative.dev/eventing/pkg/client/clientset/versioned"
 	eventingv1listers "knative.dev/eventing/pkg/client/listers/eventing/v1"
+	"knative.dev/eventing/pkg/requestreply"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/controller"
 	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/kmp"
 	"knative.dev/pkg/logging"
@@ -51,14 +50,13 @@ import (
 )
 
 const (
-	secretName                  = "request-reply-keys" // #nosec G101 -- This is a hardcoded secret name, not a credential
 	statefulSetName             = "request-reply"
 	serviceName                 = "request-reply"
 	triggerNameLabelKey         = "eventing.knative.dev/RequestReply.name"
 	triggerNamespaceLabelKey    = "eventing.knative.dev/RequestReply.namespace"
 	triggerReplicaCount         = "eventing.knative.dev/RequestReply.dataPlaneReplicas"
 	triggerPreviousReplicaCount = "eventing.knative.dev/RequestReply.previousDataPlaneReplicas"
-	SecretLabelSelector         = "eventing.knative.dev/part-of=request-reply"
+	SecretLabelSelector         = requestreply.KeySecretLabelSelector
 )
 
 type Reconciler struct {
@@ -72,11 +70,11 @@ type Reconciler struct {
 }
 
 func (r *Reconciler) ReconcileKind(ctx context.Context, rr *v1alpha1.RequestReply) reconciler.Event {
-	// 1. Ensure AES secret exists
+	// 1. Ensure AES secret exists and rotate its key when due
 	// 2. Check if all triggers to the data plane are created & ready
 	// 4. Set address and ready
 
-	err := r.reconcileAesSecret(ctx, rr)
+	nextKeyRotation, err := r.reconcileKeys(ctx, rr)
 	if err != nil {
 		logging.FromContext(ctx).Errorf("failed to reconcile aes secret for requestreply", zap.Any("ReqestReply", rr), zap.Error(err))
 		return fmt.Errorf("failed to reconcile aes secret: %w", err)
@@ -97,62 +95,20 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, rr *v1alpha1.RequestRepl
 	r.reconcileAddress(ctx, rr)
 
 	rr.Status.MarkEventPoliciesTrueWithReason("NotImplemented", "Event policies not implemented for RequestReply yet")
-	return nil
-}
-
-// reconcileAesSecret ensures that there is a AES secret for the current RequestReply resource
-// TODO: we should rotate these somehow
-func (r *Reconciler) reconcileAesSecret(ctx context.Context, rr *v1alpha1.RequestReply) error {
-	// we want to ensure the secret exists
-	secret, err := r.secretLister.Secrets(system.Namespace()).Get(secretName)
-	if apierrs.IsNotFound(err) {
-		aesKey, err := createAesSecret()
-		if err != nil {
-			return fmt.Errorf("failed to create new aes secret key: %w", err)
-		}
-
-		secret = &corev1.Secret{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      secretName,
-				Namespace: system.Namespace(),
-			},
-			Data: map[string][]byte{
-				aesSecretKey(rr, 0): aesKey,
-			},
-		}
-
-		_, err = r.kubeClient.CoreV1().Secrets(system.Namespace()).Create(ctx, secret, metav1.CreateOptions{})
-		if err != nil {
-			return fmt.Errorf("failed to create %s secret: %w", secretName, err)
-		}
-	}
 
-	foundKey := false
-	for k := range secret.Data {
-		if keyIsForRequestReply(k, rr) {
-			foundKey = true
-			break
-		}
+	if nextKeyRotation > 0 {
+		return controller.NewRequeueAfter(nextKeyRotation)
 	}
+	return nil
+}
 
-	if !foundKey {
-		aesKey, err := createAesSecret()
-		if err != nil {
-			return fmt.Errorf("failed to create new aes secret key: %w", err)
-		}
-
-		if secret.Data == nil {
-			secret.Data = make(map[string][]byte)
-		}
-
-		secret.Data[aesSecretKey(rr, 0)] = aesKey
-
-		_, err = r.kubeClient.CoreV1().Secrets(system.Namespace()).Update(ctx, secret, metav1.UpdateOptions{})
-		if err != nil {
-			return fmt.Errorf("failed to update %s secret: %w", secretName, err)
-		}
+// FinalizeKind deletes the secret holding the AES keys of the RequestReply, which cannot be owned by the RequestReply
+// since it is in the system namespace
+func (r *Reconciler) FinalizeKind(ctx context.Context, rr *v1alpha1.RequestReply) reconciler.Event {
+	err := r.kubeClient.CoreV1().Secrets(system.Namespace()).Delete(ctx, keySecretName(rr), metav1.DeleteOptions{})
+	if err != nil && !apierrs.IsNotFound(err) {
+		return fmt.Errorf("failed to delete aes secret: %w", err)
 	}
-
 	return nil
 }
 
@@ -333,7 +289,7 @@ func (r *Reconciler) createTrigger(ctx context.Context, idx int, rr *v1alpha1.Re
 						rr.Spec.ReplyAttribute,
 						rr.Name,
 						rr.Namespace,
-						secretName,
+						keySecretName(rr),
 						idx,
 						replicaCount,
 					),
@@ -357,21 +313,6 @@ func (r *Reconciler) deleteTrigger(trigger *eventingv1.Trigger) {
 	}
 }
 
-func createAesSecret() ([]byte, error) {
-	key := make([]byte, 32)
-	_, err := rand.Read(key)
-
-	return key, err
-}
-
-func aesSecretKey(rr *v1alpha1.RequestReply, idx int) string {
-	return fmt.Sprintf("%s.%s.key-%d", rr.Namespace, rr.Name, idx)
-}
-
-func keyIsForRequestReply(name string, rr *v1alpha1.RequestReply) bool {
-	return strings.HasPrefix(name, fmt.Sprintf("%s.%s", rr.Namespace, rr.Name))
-}
-
 func triggerName(rr *v1alpha1.RequestReply, idx, totalReplicas int) string {
 	return kmeta.ChildName(rr.Name, fmt.Sprintf("%d-%d", idx, totalReplicas))
 }
@@ -19,11 +19,14 @@ package requestreply
 import (
 	"context"
 	"fmt"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgotesting "k8s.io/client-go/testing"
 	"k8s.io/utils/ptr"
@@ -39,16 +42,20 @@ import (
 	"knative.dev/pkg/system"
 
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
 	fakeeventingclient "knative.dev/eventing/pkg/client/injection/client/fake"
 	requestreplyreconciler "knative.dev/eventing/pkg/client/injection/reconciler/eventing/v1alpha1/requestreply"
 	. "knative.dev/eventing/pkg/reconciler/testing/v1"
 	. "knative.dev/eventing/pkg/reconciler/testing/v1alpha1"
+	"knative.dev/eventing/pkg/requestreply"
 )
 
 const (
 	testNamespace    = "test-namespace"
 	requestReplyName = "test-requestReply"
+	requestReplyUID  = "test-requestReply-uid"
 	brokerName       = "test-broker"
+	finalizerName    = "requestreplies.eventing.knative.dev"
 )
 
 var (
@@ -68,7 +75,18 @@ var (
 		},
 	}
 
-	ignoreSecretData = cmpopts.IgnoreFields(corev1.Secret{}, "Data") // needed as the secrets are rng
+	// the keys are rng, so only their names are compared
+	compareSecretKeys = cmp.Transformer("secretKeys", func(data map[string][]byte) []string {
+		keys := make([]string, 0, len(data))
+		for k := range data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		return keys
+	})
+	ignoreKeyRotatedAt = cmpopts.IgnoreMapEntries(func(k, _ string) bool {
+		return k == keyRotatedAtAnnotation
+	})
 )
 
 func TestReconcile(t *testing.T) {
@@ -89,73 +107,184 @@ func TestReconcile(t *testing.T) {
 		{
 			Name: "Successful reconciliation",
 			Key:  testKey,
-			Objects: []runtime.Object{
+			Objects: append([]runtime.Object{
 				NewRequestReply(requestReplyName, testNamespace,
+					WithRequestReplyUID(requestReplyUID),
 					WithRequestReplyBroker(brokerRef),
 					WithInitRequestReplyConditions),
-				NewBroker(brokerName, testNamespace,
-					WithBrokerReady),
-				NewStatefulSet("request-reply", system.Namespace(),
-					WithStatefulSetReplicas(1)),
-				NewPod("request-reply-0", system.Namespace(),
-					WithPodIP("127.0.0.1"),
-					WithPodReady()),
-				NewTrigger(fmt.Sprintf("%s0-1", requestReplyName), testNamespace,
-					brokerName,
-					WithTriggerFilters(
-						[]eventingv1.SubscriptionsAPIFilter{
-							{
-								CESQL: fmt.Sprintf("KN_VERIFY_CORRELATION_ID(%s, \"%s\", \"%s\", \"%s\", %d, %d)",
-									"replyid",
-									requestReplyName,
-									testNamespace,
-									"request-reply-keys",
-									0,
-									1,
-								),
-							},
-						},
-					),
-					WithTriggerSubscriber(duckv1.Destination{
-						URI: &apis.URL{
-							Scheme: "http",
-							Host:   "127.0.0.1:8080",
-							Path:   fmt.Sprintf("/%s/%s/reply", testNamespace, requestReplyName),
-						},
-					}),
-					WithLabels(map[string]string{
-						"eventing.knative.dev/RequestReply.name":              requestReplyName,
-						"eventing.knative.dev/RequestReply.namespace":         testNamespace,
-						"eventing.knative.dev/RequestReply.dataPlaneReplicas": "1",
-					}),
-					WithTriggerBrokerReady(),
-					WithTriggerDependencyReady(),
-					WithTriggerSubscribed(),
-					WithTriggerSubscriberResolvedSucceeded(),
-					WithTriggerDeadLetterSinkNotConfigured(),
-					WithTriggerOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled()),
-			},
-			WantErr: false,
+			}, dataPlaneObjects()...),
+			WantErr: true, // the key rotation is requeued
 			WantCreates: []runtime.Object{
-				NewSecret("request-reply-keys", system.Namespace(),
-					WithSecretData(map[string][]byte{
-						fmt.Sprintf("%s.%s.key-0", testNamespace, requestReplyName): make([]byte, 32),
-					})),
+				keySecret(time.Now(), 0),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(testNamespace, requestReplyName),
+			},
+			WantEvents: []string{
+				reconcilertesting.Eventf(corev1.EventTypeNormal, "FinalizerUpdate", "Updated %q finalizers", requestReplyName),
 			},
 			SkipNamespaceValidation: true, // needed as secrets are created in a different ns than the requestreply
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
-					Object: NewRequestReply(requestReplyName, testNamespace,
-						WithRequestReplyBroker(brokerRef),
-						WithRequestReplyTriggersReady,
-						WithRequestReplyBrokerReady,
-						WithRequestReplyEventPoliciesReady,
-						WithRequestReplyAddress(requestReplyAddress),
-						WithRequestReplyReplicas(1, 1),
-						WithRequestReplyBrokerAddressAnnotation("http://example.com")),
+					Object: reconciledRequestReply(0),
+				},
+			},
+			CmpOpts: []cmp.Option{compareSecretKeys, ignoreKeyRotatedAt},
+		},
+		{
+			Name: "Key rotation",
+			Key:  testKey,
+			Objects: append([]runtime.Object{
+				NewRequestReply(requestReplyName, testNamespace,
+					WithRequestReplyUID(requestReplyUID),
+					WithRequestReplyBroker(brokerRef),
+					WithRequestReplyFinalizers(finalizerName),
+					WithInitRequestReplyConditions),
+				keySecret(time.Now().Add(-8*24*time.Hour), 0),
+			}, dataPlaneObjects()...),
+			WantErr: true, // the removal of the previous key is requeued
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: keySecret(time.Now(), 0, 1),
+				},
+			},
+			SkipNamespaceValidation: true, // needed as secrets are in a different ns than the requestreply
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: reconciledRequestReply(1, WithRequestReplyFinalizers(finalizerName)),
+				},
+			},
+			CmpOpts: []cmp.Option{compareSecretKeys, ignoreKeyRotatedAt},
+		},
+		{
+			Name: "Previous key removed after timeout",
+			Key:  testKey,
+			Objects: append([]runtime.Object{
+				NewRequestReply(requestReplyName, testNamespace,
+					WithRequestReplyUID(requestReplyUID),
+					WithRequestReplyBroker(brokerRef),
+					WithRequestReplyFinalizers(finalizerName),
+					WithInitRequestReplyConditions),
+				keySecret(time.Now().Add(-2*time.Minute), 0, 1),
+			}, dataPlaneObjects()...),
+			WantErr: true, // the key rotation is requeued
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: keySecret(time.Now(), 1),
+				},
+			},
+			SkipNamespaceValidation: true, // needed as secrets are in a different ns than the requestreply
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: reconciledRequestReply(1, WithRequestReplyFinalizers(finalizerName)),
+				},
+			},
+			CmpOpts: []cmp.Option{compareSecretKeys, ignoreKeyRotatedAt},
+		},
+		{
+			Name: "Previous key kept for the reply retention",
+			Key:  testKey,
+			Objects: append([]runtime.Object{
+				NewRequestReply(requestReplyName, testNamespace,
+					WithRequestReplyUID(requestReplyUID),
+					WithRequestReplyBroker(brokerRef),
+					WithRequestReplyFinalizers(finalizerName),
+					withReplyRetention("PT1H"),
+					WithInitRequestReplyConditions),
+				keySecret(time.Now().Add(-2*time.Minute), 0, 1),
+			}, dataPlaneObjects()...),
+			WantErr:                 true, // the removal of the previous key is requeued
+			SkipNamespaceValidation: true, // needed as secrets are in a different ns than the requestreply
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: reconciledRequestReply(1, WithRequestReplyFinalizers(finalizerName), withReplyRetention("PT1H")),
+				},
+			},
+		},
+		{
+			Name: "Legacy keys carried over",
+			Key:  testKey,
+			Objects: append([]runtime.Object{
+				NewRequestReply(requestReplyName, testNamespace,
+					WithRequestReplyUID(requestReplyUID),
+					WithRequestReplyBroker(brokerRef),
+					WithRequestReplyFinalizers(finalizerName),
+					WithInitRequestReplyConditions),
+				legacyKeySecret(testNamespace+"."+requestReplyName+".key-0", "other-namespace.other.key-0"),
+			}, dataPlaneObjects()...),
+			WantErr: true, // the key rotation is requeued
+			WantCreates: []runtime.Object{
+				keySecret(time.Now(), 0),
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: legacyKeySecret("other-namespace.other.key-0"),
+				},
+			},
+			SkipNamespaceValidation: true, // needed as secrets are in a different ns than the requestreply
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: reconciledRequestReply(0, WithRequestReplyFinalizers(finalizerName)),
+				},
+			},
+			CmpOpts: []cmp.Option{compareSecretKeys, ignoreKeyRotatedAt},
+		},
+		{
+			Name: "Legacy secret deleted once empty",
+			Key:  testKey,
+			Objects: append([]runtime.Object{
+				NewRequestReply(requestReplyName, testNamespace,
+					WithRequestReplyUID(requestReplyUID),
+					WithRequestReplyBroker(brokerRef),
+					WithRequestReplyFinalizers(finalizerName),
+					WithInitRequestReplyConditions),
+				keySecret(time.Now(), 0),
+				legacyKeySecret(testNamespace + "." + requestReplyName + ".key-0"),
+			}, dataPlaneObjects()...),
+			WantErr: true, // the key rotation is requeued
+			WantDeletes: []clientgotesting.DeleteActionImpl{
+				{
+					ActionImpl: clientgotesting.ActionImpl{
+						Namespace: system.Namespace(),
+						Resource:  corev1.SchemeGroupVersion.WithResource("secrets"),
+					},
+					Name: requestreply.LegacyKeySecretName,
 				},
 			},
-			CmpOpts: []cmp.Option{ignoreSecretData},
+			SkipNamespaceValidation: true, // needed as secrets are in a different ns than the requestreply
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: reconciledRequestReply(0, WithRequestReplyFinalizers(finalizerName)),
+				},
+			},
+		},
+		{
+			Name: "Key secret deleted on finalize",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewRequestReply(requestReplyName, testNamespace,
+					WithRequestReplyUID(requestReplyUID),
+					WithRequestReplyBroker(brokerRef),
+					WithRequestReplyFinalizers(finalizerName),
+					WithRequestReplyDeleted),
+				keySecret(time.Now(), 0),
+			},
+			WantDeletes: []clientgotesting.DeleteActionImpl{
+				{
+					ActionImpl: clientgotesting.ActionImpl{
+						Namespace: system.Namespace(),
+						Resource:  corev1.SchemeGroupVersion.WithResource("secrets"),
+					},
+					Name: keySecretName(NewRequestReply(requestReplyName, testNamespace, WithRequestReplyUID(requestReplyUID))),
+				},
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchRemoveFinalizers(testNamespace, requestReplyName),
+			},
+			WantEvents: []string{
+				reconcilertesting.Eventf(corev1.EventTypeNormal, "FinalizerUpdate", "Updated %q finalizers", requestReplyName),
+			},
+			SkipNamespaceValidation: true, // needed as secrets are in a different ns than the requestreply
 		},
 	}
 
@@ -185,3 +314,118 @@ func TestReconcile(t *testing.T) {
 		logger,
 	))
 }
+
+// dataPlaneObjects returns the broker, data plane and ready trigger of the RequestReply under test
+func dataPlaneObjects() []runtime.Object {
+	return []runtime.Object{
+		NewBroker(brokerName, testNamespace,
+			WithBrokerReady),
+		NewStatefulSet("request-reply", system.Namespace(),
+			WithStatefulSetReplicas(1)),
+		NewPod("request-reply-0", system.Namespace(),
+			WithPodIP("127.0.0.1"),
+			WithPodReady()),
+		NewTrigger(fmt.Sprintf("%s0-1", requestReplyName), testNamespace,
+			brokerName,
+			WithTriggerFilters(
+				[]eventingv1.SubscriptionsAPIFilter{
+					{
+						CESQL: fmt.Sprintf("KN_VERIFY_CORRELATION_ID(%s, \"%s\", \"%s\", \"%s\", %d, %d)",
+							"replyid",
+							requestReplyName,
+							testNamespace,
+							"request-reply-keys-"+requestReplyUID,
+							0,
+							1,
+						),
+					},
+				},
+			),
+			WithTriggerSubscriber(duckv1.Destination{
+				URI: &apis.URL{
+					Scheme: "http",
+					Host:   "127.0.0.1:8080",
+					Path:   fmt.Sprintf("/%s/%s/reply", testNamespace, requestReplyName),
+				},
+			}),
+			WithLabels(map[string]string{
+				"eventing.knative.dev/RequestReply.name":              requestReplyName,
+				"eventing.knative.dev/RequestReply.namespace":         testNamespace,
+				"eventing.knative.dev/RequestReply.dataPlaneReplicas": "1",
+			}),
+			WithTriggerBrokerReady(),
+			WithTriggerDependencyReady(),
+			WithTriggerSubscribed(),
+			WithTriggerSubscriberResolvedSucceeded(),
+			WithTriggerDeadLetterSinkNotConfigured(),
+			WithTriggerOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled()),
+	}
+}
+
+func reconciledRequestReply(keyGeneration int64, opts ...RequestReplyOption) *v1alpha1.RequestReply {
+	return NewRequestReply(requestReplyName, testNamespace, append([]RequestReplyOption{
+		WithRequestReplyUID(requestReplyUID),
+		WithRequestReplyBroker(brokerRef),
+		WithRequestReplyTriggersReady,
+		WithRequestReplyBrokerReady,
+		WithRequestReplyEventPoliciesReady,
+		WithRequestReplyAddress(requestReplyAddress),
+		WithRequestReplyReplicas(1, 1),
+		WithRequestReplyKeyGeneration(keyGeneration),
+		WithRequestReplyBrokerAddressAnnotation("http://example.com")}, opts...)...)
+}
+
+// keySecret returns the key secret of the RequestReply under test holding keys of the generations
+func keySecret(rotatedAt time.Time, generations ...int) *corev1.Secret {
+	rr := NewRequestReply(requestReplyName, testNamespace, WithRequestReplyUID(requestReplyUID))
+
+	data := make(map[string][]byte, len(generations))
+	for _, g := range generations {
+		data[aesSecretKey(rr, g)] = make([]byte, 32)
+	}
+
+	return makeKeySecret(rr, rotatedAt, data)
+}
+
+// legacyKeySecret returns the secret which held the keys of all the RequestReply resources
+func legacyKeySecret(keyNames ...string) *corev1.Secret {
+	data := make(map[string][]byte, len(keyNames))
+	for _, keyName := range keyNames {
+		data[keyName] = make([]byte, 32)
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      requestreply.LegacyKeySecretName,
+			Namespace: system.Namespace(),
+			Labels: map[string]string{
+				"eventing.knative.dev/part-of": "request-reply",
+			},
+		},
+		Data: data,
+	}
+}
+
+func withReplyRetention(retention string) RequestReplyOption {
+	return func(rr *v1alpha1.RequestReply) {
+		rr.Spec.Async = &v1alpha1.RequestReplyAsync{ReplyRetention: ptr.To(retention)}
+	}
+}
+
+func patchFinalizers(namespace, name string) clientgotesting.PatchActionImpl {
+	action := clientgotesting.PatchActionImpl{}
+	action.Name = name
+	action.Namespace = namespace
+	patch := `{"metadata":{"finalizers":["` + finalizerName + `"],"resourceVersion":""}}`
+	action.Patch = []byte(patch)
+	return action
+}
+
+func patchRemoveFinalizers(namespace, name string) clientgotesting.PatchActionImpl {
+	action := clientgotesting.PatchActionImpl{}
+	action.Name = name
+	action.Namespace = namespace
+	patch := `{"metadata":{"finalizers":[],"resourceVersion":""}}`
+	action.Patch = []byte(patch)
+	return action
+}
@@ -29,11 +29,13 @@ import (
 	"knative.dev/eventing/pkg/auth"
 	"knative.dev/eventing/pkg/duck"
 
+	namespaceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
 	"knative.dev/pkg/injection/clients/dynamicclient"
 
 	flowsv1 "knative.dev/eventing/pkg/apis/flows/v1"
 	eventingclient "knative.dev/eventing/pkg/client/injection/client"
 	"knative.dev/eventing/pkg/client/injection/ducks/duck/v1/channelable"
+	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy"
 	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
 	"knative.dev/eventing/pkg/client/injection/informers/flows/v1/sequence"
 	"knative.dev/eventing/pkg/client/injection/informers/messaging/v1/subscription"
@@ -50,6 +52,8 @@ func NewController(
 	sequenceInformer := sequence.Get(ctx)
 	subscriptionInformer := subscription.Get(ctx)
 	eventPolicyInformer := eventpolicy.Get(ctx)
+	clusterEventPolicyInformer := clustereventpolicy.Get(ctx)
+	namespaceInformer := namespaceinformer.Get(ctx)
 
 	var globalResync func()
 	store := feature.NewStore(logging.FromContext(ctx), func(name string, value interface{}) {
@@ -60,11 +64,13 @@ func NewController(
 	store.WatchConfigs(cmw)
 
 	r := &Reconciler{
-		sequenceLister:     sequenceInformer.Lister(),
-		subscriptionLister: subscriptionInformer.Lister(),
-		dynamicClientSet:   dynamicclient.Get(ctx),
-		eventingClientSet:  eventingclient.Get(ctx),
-		eventPolicyLister:  eventPolicyInformer.Lister(),
+		sequenceLister:           sequenceInformer.Lister(),
+		subscriptionLister:       subscriptionInformer.Lister(),
+		dynamicClientSet:         dynamicclient.Get(ctx),
+		eventingClientSet:        eventingclient.Get(ctx),
+		eventPolicyLister:        eventPolicyInformer.Lister(),
+		clusterEventPolicyLister: clusterEventPolicyInformer.Lister(),
+		namespaceLister:          namespaceInformer.Lister(),
 	}
 	impl := sequencereconciler.NewImpl(ctx, r, func(impl *controller.Impl) controller.Options {
 		return controller.Options{
@@ -96,5 +102,23 @@ func NewController(
 		impl.EnqueueKey,
 	))
 
+	// Enqueue the Sequence, if we have a ClusterEventPolicy which was applying
+	// or got updated and now is applying to the Sequence
+	clusterEventPolicyInformer.Informer().AddEventHandler(auth.ClusterEventPolicyEventHandler(
+		sequenceInformer.Informer().GetIndexer(),
+		sequenceGK,
+		namespaceInformer.Lister(),
+		impl.EnqueueKey,
+	))
+
+	// Enqueue the Sequence, if the labels of its namespace got updated and a ClusterEventPolicy
+	// started or stopped applying to the Sequence
+	namespaceInformer.Informer().AddEventHandler(auth.ClusterEventPolicyNamespaceEventHandler(
+		clusterEventPolicyInformer.Lister(),
+		sequenceInformer.Informer().GetIndexer(),
+		sequenceGK,
+		impl.EnqueueKey,
+	))
+
 	return impl
 }
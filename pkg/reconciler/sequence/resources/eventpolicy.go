@@ -117,3 +117,36 @@ func MakeEventPolicyForSequenceInputChannel(s *flowsv1.Sequence, inputChannel *e
 		},
 	}
 }
+
+// MakeEventPolicyForSequenceInputChannelFromClusterEventPolicy creates an EventPolicy for the input channel
+// of a Sequence, to which the given ClusterEventPolicy applies.
+func MakeEventPolicyForSequenceInputChannelFromClusterEventPolicy(s *flowsv1.Sequence, inputChannel *eventingduckv1.Channelable, clusterPolicy *eventingv1alpha1.ClusterEventPolicy) *eventingv1alpha1.EventPolicy {
+	return &eventingv1alpha1.EventPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: inputChannel.Namespace,
+			Name:      SequenceEventPolicyName(s.Name, "cluster-"+clusterPolicy.Name),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: eventingv1alpha1.SchemeGroupVersion.String(),
+					Kind:       eventingv1alpha1.ClusterEventPolicyKind,
+					Name:       clusterPolicy.Name,
+					UID:        clusterPolicy.UID,
+				},
+			},
+			Labels: LabelsForSequenceChannelsEventPolicy(s.Name),
+		},
+		Spec: eventingv1alpha1.EventPolicySpec{
+			To: []eventingv1alpha1.EventPolicySpecTo{
+				{
+					Ref: &eventingv1alpha1.EventPolicyToReference{
+						APIVersion: inputChannel.APIVersion,
+						Kind:       inputChannel.Kind,
+						Name:       inputChannel.Name,
+					},
+				},
+			},
+			From:    clusterPolicy.Spec.From,
+			Filters: clusterPolicy.Spec.Filters,
+		},
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/dynamic"
+	corev1listers "k8s.io/client-go/listers/core/v1"
 	"knative.dev/pkg/kmeta"
 
 	duckapis "knative.dev/pkg/apis/duck"
@@ -65,7 +66,9 @@ type Reconciler struct {
 	// dynamicClientSet allows us to configure pluggable Build objects
 	dynamicClientSet dynamic.Interface
 
-	eventPolicyLister eventingv1alpha1listers.EventPolicyLister
+	eventPolicyLister        eventingv1alpha1listers.EventPolicyLister
+	clusterEventPolicyLister eventingv1alpha1listers.ClusterEventPolicyLister
+	namespaceLister          corev1listers.NamespaceLister
 }
 
 // Check that our Reconciler implements sequencereconciler.Interface
@@ -136,7 +139,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, s *v1.Sequence) pkgrecon
 		return fmt.Errorf("failed to reconcile EventPolicies: %w", err)
 	}
 
-	err := auth.UpdateStatusWithEventPolicies(featureFlags, &s.Status.AppliedEventPoliciesStatus, &s.Status, r.eventPolicyLister, v1.SchemeGroupVersion.WithKind("Sequence"), s.ObjectMeta)
+	err := auth.UpdateStatusWithEventPolicies(featureFlags, &s.Status.AppliedEventPoliciesStatus, &s.Status, r.eventPolicyLister, r.clusterEventPolicyLister, r.namespaceLister, v1.SchemeGroupVersion.WithKind("Sequence"), s.ObjectMeta)
 	if err != nil {
 		return fmt.Errorf("could not update Sequence status with EventPolicies: %v", err)
 	}
@@ -431,15 +434,29 @@ func (r *Reconciler) prepareInputChannelEventPolicy(s *v1.Sequence, inputChannel
 		return nil, fmt.Errorf("failed to get matching EventPolicies for Sequence: %w", err)
 	}
 
-	if len(matchingPolicies) == 0 {
+	matchingClusterPolicies, err := auth.GetClusterEventPoliciesForResource(
+		r.clusterEventPolicyLister,
+		r.namespaceLister,
+		v1.SchemeGroupVersion.WithKind("Sequence"),
+		s.ObjectMeta,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matching ClusterEventPolicies for Sequence: %w", err)
+	}
+
+	if len(matchingPolicies) == 0 && len(matchingClusterPolicies) == 0 {
 		return nil, nil
 	}
 
-	inputChannelPolicies := make([]*eventingv1alpha1.EventPolicy, 0, len(matchingPolicies))
+	inputChannelPolicies := make([]*eventingv1alpha1.EventPolicy, 0, len(matchingPolicies)+len(matchingClusterPolicies))
 	for _, policy := range matchingPolicies {
 		inputChannelPolicy := resources.MakeEventPolicyForSequenceInputChannel(s, inputChannel, policy)
 		inputChannelPolicies = append(inputChannelPolicies, inputChannelPolicy)
 	}
+	for _, policy := range matchingClusterPolicies {
+		inputChannelPolicy := resources.MakeEventPolicyForSequenceInputChannelFromClusterEventPolicy(s, inputChannel, policy)
+		inputChannelPolicies = append(inputChannelPolicies, inputChannelPolicy)
+	}
 
 	return inputChannelPolicies, nil
 }
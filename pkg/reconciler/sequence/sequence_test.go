@@ -3682,12 +3682,14 @@ func TestAllCases(t *testing.T) {
 	table.Test(t, MakeFactory(func(ctx context.Context, listers *Listers, cmw configmap.Watcher) controller.Reconciler {
 		ctx = channelable.WithDuck(ctx)
 		r := &Reconciler{
-			sequenceLister:     listers.GetSequenceLister(),
-			channelableTracker: duck.NewListableTrackerFromTracker(ctx, channelable.Get, tracker.New(func(types.NamespacedName) {}, 0)),
-			subscriptionLister: listers.GetSubscriptionLister(),
-			eventingClientSet:  fakeeventingclient.Get(ctx),
-			dynamicClientSet:   fakedynamicclient.Get(ctx),
-			eventPolicyLister:  listers.GetEventPolicyLister(),
+			sequenceLister:           listers.GetSequenceLister(),
+			channelableTracker:       duck.NewListableTrackerFromTracker(ctx, channelable.Get, tracker.New(func(types.NamespacedName) {}, 0)),
+			subscriptionLister:       listers.GetSubscriptionLister(),
+			eventingClientSet:        fakeeventingclient.Get(ctx),
+			dynamicClientSet:         fakedynamicclient.Get(ctx),
+			eventPolicyLister:        listers.GetEventPolicyLister(),
+			clusterEventPolicyLister: listers.GetClusterEventPolicyLister(),
+			namespaceLister:          listers.GetNamespaceLister(),
 		}
 		return sequence.NewReconciler(ctx, logging.FromContext(ctx),
 			fakeeventingclient.Get(ctx), listers.GetSequenceLister(),
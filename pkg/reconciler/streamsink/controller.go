@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamsink
+
+import (
+	"context"
+
+	"knative.dev/pkg/logging"
+
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/system"
+
+	namespaceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
+	secretinformer "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret"
+
+	"knative.dev/eventing/pkg/apis/feature"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/auth"
+	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/clustereventpolicy"
+	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
+	"knative.dev/eventing/pkg/client/injection/informers/sinks/v1alpha1/streamsink"
+	streamsinkreconciler "knative.dev/eventing/pkg/client/injection/reconciler/sinks/v1alpha1/streamsink"
+	"knative.dev/eventing/pkg/eventingtls"
+)
+
+// NewController initializes the controller and is called by the generated code.
+// Registers event handlers to enqueue events.
+func NewController(
+	ctx context.Context,
+	cmw configmap.Watcher,
+) *controller.Impl {
+	streamSinkInformer := streamsink.Get(ctx)
+	secretInformer := secretinformer.Get(ctx)
+	eventPolicyInformer := eventpolicy.Get(ctx)
+	clusterEventPolicyInformer := clustereventpolicy.Get(ctx)
+	namespaceInformer := namespaceinformer.Get(ctx)
+
+	r := &Reconciler{
+		systemNamespace:          system.Namespace(),
+		secretLister:             secretInformer.Lister(),
+		eventPolicyLister:        eventPolicyInformer.Lister(),
+		clusterEventPolicyLister: clusterEventPolicyInformer.Lister(),
+		namespaceLister:          namespaceInformer.Lister(),
+	}
+
+	var globalResync func(obj interface{})
+
+	featureStore := feature.NewStore(logging.FromContext(ctx).Named("feature-config-store"), func(name string, value interface{}) {
+		if globalResync != nil {
+			globalResync(nil)
+		}
+	})
+	featureStore.WatchConfigs(cmw)
+
+	impl := streamsinkreconciler.NewImpl(ctx, r, func(impl *controller.Impl) controller.Options {
+		return controller.Options{
+			ConfigStore: featureStore,
+		}
+	})
+
+	streamSinkInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+
+	globalResync = func(interface{}) {
+		impl.GlobalResync(streamSinkInformer.Informer())
+	}
+	secretInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterWithName(eventingtls.StreamSinkDispatcherServerTLSSecretName),
+		Handler:    controller.HandleAll(globalResync),
+	})
+
+	streamSinkGK := sinksv1alpha1.SchemeGroupVersion.WithKind("StreamSink").GroupKind()
+
+	// Enqueue the StreamSink, if we have an EventPolicy which was referencing
+	// or got updated and now is referencing the StreamSink.
+	eventPolicyInformer.Informer().AddEventHandler(auth.EventPolicyEventHandler(
+		streamSinkInformer.Informer().GetIndexer(),
+		streamSinkGK,
+		impl.EnqueueKey,
+	))
+
+	// Enqueue the StreamSink, if we have a ClusterEventPolicy which was applying
+	// or got updated and now is applying to the StreamSink
+	clusterEventPolicyInformer.Informer().AddEventHandler(auth.ClusterEventPolicyEventHandler(
+		streamSinkInformer.Informer().GetIndexer(),
+		streamSinkGK,
+		namespaceInformer.Lister(),
+		impl.EnqueueKey,
+	))
+
+	// Enqueue the StreamSink, if the labels of its namespace got updated and a ClusterEventPolicy
+	// started or stopped applying to the StreamSink
+	namespaceInformer.Informer().AddEventHandler(auth.ClusterEventPolicyNamespaceEventHandler(
+		clusterEventPolicyInformer.Lister(),
+		streamSinkInformer.Informer().GetIndexer(),
+		streamSinkGK,
+		impl.EnqueueKey,
+	))
+
+	return impl
+}
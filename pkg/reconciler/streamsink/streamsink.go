@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamsink
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/network"
+	"knative.dev/pkg/reconciler"
+
+	"knative.dev/eventing/pkg/apis/feature"
+	sinks "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/auth"
+	eventingv1alpha1listers "knative.dev/eventing/pkg/client/listers/eventing/v1alpha1"
+	"knative.dev/eventing/pkg/eventingtls"
+)
+
+type Reconciler struct {
+	secretLister             corev1listers.SecretLister
+	eventPolicyLister        eventingv1alpha1listers.EventPolicyLister
+	clusterEventPolicyLister eventingv1alpha1listers.ClusterEventPolicyLister
+	namespaceLister          corev1listers.NamespaceLister
+	systemNamespace          string
+}
+
+func (r *Reconciler) ReconcileKind(ctx context.Context, ss *sinks.StreamSink) reconciler.Event {
+	featureFlags := feature.FromContext(ctx)
+
+	if err := r.reconcileAddress(ctx, ss); err != nil {
+		return fmt.Errorf("failed to reconcile address: %w", err)
+	}
+
+	err := auth.UpdateStatusWithEventPolicies(featureFlags, &ss.Status.AppliedEventPoliciesStatus, &ss.Status, r.eventPolicyLister, r.clusterEventPolicyLister, r.namespaceLister, sinks.SchemeGroupVersion.WithKind("StreamSink"), ss.ObjectMeta)
+	if err != nil {
+		return fmt.Errorf("could not update StreamSink status with EventPolicies: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) getCaCerts() (*string, error) {
+	// Getting the secret called "stream-sink-server-tls" from system namespace
+	secret, err := r.secretLister.Secrets(r.systemNamespace).Get(eventingtls.StreamSinkDispatcherServerTLSSecretName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CA certs from %s/%s: %w", r.systemNamespace, eventingtls.StreamSinkDispatcherServerTLSSecretName, err)
+	}
+	caCerts, ok := secret.Data[eventingtls.SecretCACert]
+	if !ok {
+		return nil, nil
+	}
+	return ptr.To(string(caCerts)), nil
+}
+
+func (r *Reconciler) reconcileAddress(ctx context.Context, ss *sinks.StreamSink) error {
+
+	featureFlags := feature.FromContext(ctx)
+	if featureFlags.IsPermissiveTransportEncryption() {
+		caCerts, err := r.getCaCerts()
+		if err != nil {
+			return err
+		}
+
+		httpAddress := r.httpAddress(ss)
+		httpsAddress := r.httpsAddress(caCerts, ss)
+		// Permissive mode:
+		// - status.address http address with host-based routing
+		// - status.addresses:
+		//   - https address with path-based routing
+		//   - http address with host-based routing
+		ss.Status.Addresses = []duckv1.Addressable{httpsAddress, httpAddress}
+		ss.Status.Address = &httpAddress
+	} else if featureFlags.IsStrictTransportEncryption() {
+		// Strict mode: (only https addresses)
+		// - status.address https address with path-based routing
+		// - status.addresses:
+		//   - https address with path-based routing
+		caCerts, err := r.getCaCerts()
+		if err != nil {
+			return err
+		}
+
+		httpsAddress := r.httpsAddress(caCerts, ss)
+		ss.Status.Addresses = []duckv1.Addressable{httpsAddress}
+		ss.Status.Address = &httpsAddress
+	} else {
+		httpAddress := r.httpAddress(ss)
+		ss.Status.Address = &httpAddress
+	}
+
+	if featureFlags.IsOIDCAuthentication() {
+		audience := auth.GetAudience(sinks.SchemeGroupVersion.WithKind("StreamSink"), ss.ObjectMeta)
+
+		logging.FromContext(ctx).Debugw("Setting the audience", zap.String("audience", audience))
+		ss.Status.Address.Audience = &audience
+		for i := range ss.Status.Addresses {
+			ss.Status.Addresses[i].Audience = &audience
+		}
+	} else {
+		logging.FromContext(ctx).Debug("Clearing the StreamSink audience as OIDC is not enabled")
+		ss.Status.Address.Audience = nil
+		for i := range ss.Status.Addresses {
+			ss.Status.Addresses[i].Audience = nil
+		}
+	}
+
+	ss.GetConditionSet().Manage(ss.GetStatus()).MarkTrue(sinks.StreamSinkConditionAddressable)
+
+	return nil
+}
+
+func (r *Reconciler) httpAddress(ss *sinks.StreamSink) duckv1.Addressable {
+	// http address uses host-based routing
+	httpAddress := duckv1.Addressable{
+		Name: ptr.To("http"),
+		URL: &apis.URL{
+			Scheme: "http",
+			Host:   network.GetServiceHostname("stream-sink", r.systemNamespace),
+			Path:   fmt.Sprintf("/%s/%s", ss.GetNamespace(), ss.GetName()),
+		},
+	}
+	return httpAddress
+}
+
+func (r *Reconciler) httpsAddress(certs *string, ss *sinks.StreamSink) duckv1.Addressable {
+	addr := r.httpAddress(ss)
+	addr.URL.Scheme = "https"
+	addr.CACerts = certs
+	return addr
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamsink
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	v1 "knative.dev/pkg/client/injection/ducks/duck/v1/addressable"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	logtesting "knative.dev/pkg/logging/testing"
+	"knative.dev/pkg/network"
+	. "knative.dev/pkg/reconciler/testing"
+
+	"knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	fakeeventingclient "knative.dev/eventing/pkg/client/injection/client/fake"
+	streamsinkreconciler "knative.dev/eventing/pkg/client/injection/reconciler/sinks/v1alpha1/streamsink"
+	. "knative.dev/eventing/pkg/reconciler/testing/v1"
+	. "knative.dev/eventing/pkg/reconciler/testing/v1alpha1"
+)
+
+const (
+	// testNamespace is the namespace used for testing.
+	testNamespace = "test-namespace"
+	// streamSinkName is the name of StreamSink used for testing.
+	streamSinkName = "test-streamSink"
+	// readyEventPolicyName and unreadyEventPolicyName are the names of EventPolicies used for testing.
+	readyEventPolicyName   = "ready-event-policy"
+	unreadyEventPolicyName = "unready-event-policy"
+)
+
+var (
+	testKey = fmt.Sprintf("%s/%s", testNamespace, streamSinkName)
+
+	streamSinkAddressable = duckv1.Addressable{
+		Name: ptr.To("http"),
+		URL: &apis.URL{
+			Scheme: "http",
+			Host:   network.GetServiceHostname("stream-sink", testNamespace),
+			Path:   fmt.Sprintf("/%s/%s", testNamespace, streamSinkName),
+		},
+	}
+
+	streamSinkGVK = metav1.GroupVersionKind{
+		Group:   "sinks.knative.dev",
+		Version: "v1alpha1",
+		Kind:    "StreamSink",
+	}
+)
+
+func TestReconcile(t *testing.T) {
+	table := TableTest{
+		{
+			Name: "bad work queue key",
+			Key:  "too/many/parts",
+		},
+		{
+			Name: "key not found",
+			// Make sure Reconcile handles good keys that don't exist.
+			Key: "foo/not-found",
+		}, {
+			Name: "StreamSink not found",
+			Key:  testKey,
+		}, {
+			Name: "Successful reconciliation",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewStreamSink(streamSinkName, testNamespace,
+					WithStreamSinkClientSubjects("system:serviceaccount:test-namespace:*"),
+					WithInitStreamSinkConditions),
+			},
+			WantErr: false,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewStreamSink(streamSinkName, testNamespace,
+						WithStreamSinkClientSubjects("system:serviceaccount:test-namespace:*"),
+						WithStreamSinkAddress(&streamSinkAddressable),
+						WithStreamSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		}, {
+			Name: "Should provision applying EventPolicies",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewStreamSink(streamSinkName, testNamespace,
+					WithInitStreamSinkConditions),
+				NewEventPolicy(readyEventPolicyName, testNamespace,
+					WithReadyEventPolicyCondition,
+					WithEventPolicyToRef(streamSinkGVK, streamSinkName),
+				),
+			},
+			WantErr: false,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewStreamSink(streamSinkName, testNamespace,
+						WithStreamSinkAddress(&streamSinkAddressable),
+						WithStreamSinkEventPoliciesReady(),
+						WithStreamSinkEventPoliciesListed(readyEventPolicyName),
+					),
+				},
+			},
+		}, {
+			Name: "Should mark as NotReady on unready EventPolicies",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewStreamSink(streamSinkName, testNamespace,
+					WithInitStreamSinkConditions),
+				NewEventPolicy(unreadyEventPolicyName, testNamespace,
+					WithUnreadyEventPolicyCondition("", ""),
+					WithEventPolicyToRef(streamSinkGVK, streamSinkName),
+				),
+			},
+			WantErr: false,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewStreamSink(streamSinkName, testNamespace,
+						WithStreamSinkAddress(&streamSinkAddressable),
+						WithStreamSinkEventPoliciesNotReady("EventPoliciesNotReady", fmt.Sprintf("event policies %s are not ready", unreadyEventPolicyName)),
+					),
+				},
+			},
+		}, {
+			Name: "Successful reconciliation, observed generation",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewStreamSink(streamSinkName, testNamespace,
+					WithStreamSinkGeneration(4242),
+					WithInitStreamSinkConditions),
+			},
+			WantErr: false,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewStreamSink(streamSinkName, testNamespace,
+						WithStreamSinkGeneration(4242),
+						WithStreamSinkAddress(&streamSinkAddressable),
+						func(sink *v1alpha1.StreamSink) {
+							sink.Status.ObservedGeneration = 4242
+						},
+						WithStreamSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
+	}
+
+	logger := logtesting.TestLogger(t)
+	table.Test(t, MakeFactory(func(ctx context.Context, listers *Listers, cmw configmap.Watcher) controller.Reconciler {
+		ctx = v1.WithDuck(ctx)
+		r := &Reconciler{
+			secretLister:             listers.GetSecretLister(),
+			eventPolicyLister:        listers.GetEventPolicyLister(),
+			clusterEventPolicyLister: listers.GetClusterEventPolicyLister(),
+			namespaceLister:          listers.GetNamespaceLister(),
+			systemNamespace:          testNamespace,
+		}
+
+		return streamsinkreconciler.NewReconciler(ctx, logger,
+			fakeeventingclient.Get(ctx), listers.GetStreamSinkLister(),
+			controller.GetEventRecorder(ctx), r)
+	},
+		false,
+		logger,
+	))
+}
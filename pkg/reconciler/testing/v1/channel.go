@@ -184,6 +184,18 @@ func WithChannelEventPoliciesListed(policyNames ...string) ChannelOption {
 	}
 }
 
+func WithChannelClusterEventPoliciesListed(policyNames ...string) ChannelOption {
+	return func(c *messagingv1.Channel) {
+		for _, name := range policyNames {
+			c.Status.Policies = append(c.Status.Policies, eventingduckv1.AppliedEventPolicyRef{
+				APIVersion: v1alpha1.SchemeGroupVersion.String(),
+				Kind:       v1alpha1.ClusterEventPolicyKind,
+				Name:       name,
+			})
+		}
+	}
+}
+
 func WithChannelEventPoliciesReadyBecauseOIDCDisabled() ChannelOption {
 	return func(c *messagingv1.Channel) {
 		c.Status.MarkEventPoliciesTrueWithReason("OIDCDisabled", "Feature %q must be enabled to support Authorization", feature.OIDCAuthentication)
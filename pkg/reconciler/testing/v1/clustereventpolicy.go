@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+)
+
+// ClusterEventPolicyOption enables further configuration of a ClusterEventPolicy.
+type ClusterEventPolicyOption func(*v1alpha1.ClusterEventPolicy)
+
+// NewClusterEventPolicy creates a ClusterEventPolicy with ClusterEventPolicyOptions.
+func NewClusterEventPolicy(name string, o ...ClusterEventPolicyOption) *v1alpha1.ClusterEventPolicy {
+	cep := &v1alpha1.ClusterEventPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+	for _, opt := range o {
+		opt(cep)
+	}
+	cep.SetDefaults(context.Background())
+
+	return cep
+}
+
+func WithInitClusterEventPolicyConditions(cep *v1alpha1.ClusterEventPolicy) {
+	cep.Status.InitializeConditions()
+}
+
+func WithClusterEventPolicyAuthenticationDisabled(cep *v1alpha1.ClusterEventPolicy) {
+	cep.Status.MarkOIDCAuthenticationDisabled("OIDCAuthenticationDisabled", "")
+}
+
+func WithClusterEventPolicySubjectsResolvedFailed(reason, message string) ClusterEventPolicyOption {
+	return func(cep *v1alpha1.ClusterEventPolicy) {
+		cep.Status.MarkOIDCAuthenticationEnabled()
+		cep.Status.MarkSubjectsResolvedFailed(reason, message)
+	}
+}
+
+func WithReadyClusterEventPolicyCondition(cep *v1alpha1.ClusterEventPolicy) {
+	cep.Status.MarkOIDCAuthenticationEnabled()
+	cep.Status.MarkSubjectsResolvedSucceeded()
+}
+
+func WithClusterEventPolicyNamespaceSelector(selector *metav1.LabelSelector) ClusterEventPolicyOption {
+	return func(cep *v1alpha1.ClusterEventPolicy) {
+		cep.Spec.NamespaceSelector = selector
+	}
+}
+
+func WithClusterEventPolicyToSelector(gvk metav1.GroupVersionKind, selector *metav1.LabelSelector) ClusterEventPolicyOption {
+	return func(cep *v1alpha1.ClusterEventPolicy) {
+		cep.Spec.To = append(cep.Spec.To, v1alpha1.EventPolicySpecTo{
+			Selector: &v1alpha1.EventPolicySelector{
+				TypeMeta: &metav1.TypeMeta{
+					APIVersion: apiVersion(gvk),
+					Kind:       gvk.Kind,
+				},
+				LabelSelector: selector,
+			},
+		})
+	}
+}
+
+func WithClusterEventPolicyFrom(gvk metav1.GroupVersionKind, name, namespace string) ClusterEventPolicyOption {
+	return func(cep *v1alpha1.ClusterEventPolicy) {
+		cep.Spec.From = append(cep.Spec.From, v1alpha1.EventPolicySpecFrom{
+			Ref: &v1alpha1.EventPolicyFromReference{
+				APIVersion: apiVersion(gvk),
+				Kind:       gvk.Kind,
+				Name:       name,
+				Namespace:  namespace,
+			},
+		})
+	}
+}
+
+func WithClusterEventPolicyFromSub(sub string) ClusterEventPolicyOption {
+	return func(cep *v1alpha1.ClusterEventPolicy) {
+		cep.Spec.From = append(cep.Spec.From, v1alpha1.EventPolicySpecFrom{
+			Sub: &sub,
+		})
+	}
+}
+
+func WithClusterEventPolicyStatusFromSub(subs []string) ClusterEventPolicyOption {
+	return func(cep *v1alpha1.ClusterEventPolicy) {
+		cep.Status.From = append(cep.Status.From, subs...)
+	}
+}
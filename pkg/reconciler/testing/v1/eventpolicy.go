@@ -148,6 +148,25 @@ func WithEventPolicyFromSub(sub string) EventPolicyOption {
 	}
 }
 
+func WithEventPolicyDenySub(sub string, filters ...eventingv1.SubscriptionsAPIFilter) EventPolicyOption {
+	return func(ep *v1alpha1.EventPolicy) {
+		ep.Spec.Deny = append(ep.Spec.Deny, v1alpha1.EventPolicySpecDeny{
+			From: []v1alpha1.EventPolicySpecFrom{{
+				Sub: &sub,
+			}},
+			Filters: filters,
+		})
+	}
+}
+
+func WithEventPolicyDenyFilter(filters ...eventingv1.SubscriptionsAPIFilter) EventPolicyOption {
+	return func(ep *v1alpha1.EventPolicy) {
+		ep.Spec.Deny = append(ep.Spec.Deny, v1alpha1.EventPolicySpecDeny{
+			Filters: filters,
+		})
+	}
+}
+
 func WithEventPolicyFilter(filter eventingv1.SubscriptionsAPIFilter) EventPolicyOption {
 	return func(ep *v1alpha1.EventPolicy) {
 		ep.Spec.Filters = append(ep.Spec.Filters, filter)
@@ -171,3 +190,9 @@ func WithEventPolicyStatusFromSub(subs []string) EventPolicyOption {
 		ep.Status.From = append(ep.Status.From, subs...)
 	}
 }
+
+func WithEventPolicyStatusDenySub(subs []string) EventPolicyOption {
+	return func(ep *v1alpha1.EventPolicy) {
+		ep.Status.Deny = append(ep.Status.Deny, v1alpha1.EventPolicyStatusDeny{From: subs})
+	}
+}
@@ -131,6 +131,10 @@ func (l *Listers) GetEventPolicyLister() eventingv1alpha1listers.EventPolicyList
 	return eventingv1alpha1listers.NewEventPolicyLister(l.indexerFor(&eventingv1alpha1.EventPolicy{}))
 }
 
+func (l *Listers) GetClusterEventPolicyLister() eventingv1alpha1listers.ClusterEventPolicyLister {
+	return eventingv1alpha1listers.NewClusterEventPolicyLister(l.indexerFor(&eventingv1alpha1.ClusterEventPolicy{}))
+}
+
 func (l *Listers) GetIntegrationSinkLister() sinkslisters.IntegrationSinkLister {
 	return sinkslisters.NewIntegrationSinkLister(l.indexerFor(&sinksv1alpha1.IntegrationSink{}))
 }
@@ -139,6 +143,14 @@ func (l *Listers) GetJobSinkLister() sinkslisters.JobSinkLister {
 	return sinkslisters.NewJobSinkLister(l.indexerFor(&sinksv1alpha1.JobSink{}))
 }
 
+func (l *Listers) GetStreamSinkLister() sinkslisters.StreamSinkLister {
+	return sinkslisters.NewStreamSinkLister(l.indexerFor(&sinksv1alpha1.StreamSink{}))
+}
+
+func (l *Listers) GetPullSinkLister() sinkslisters.PullSinkLister {
+	return sinkslisters.NewPullSinkLister(l.indexerFor(&sinksv1alpha1.PullSink{}))
+}
+
 func (l *Listers) GetEventTransformLister() eventingv1alpha1listers.EventTransformLister {
 	return eventingv1alpha1listers.NewEventTransformLister(l.indexerFor(&eventingv1alpha1.EventTransform{}))
 }
@@ -151,6 +163,14 @@ func (l *Listers) GetIntegrationSourceLister() sourcev1alpha1listers.Integration
 	return sourcev1alpha1listers.NewIntegrationSourceLister(l.indexerFor(&sourcesv1alpha1.IntegrationSource{}))
 }
 
+func (l *Listers) GetMQTTSourceLister() sourcev1alpha1listers.MQTTSourceLister {
+	return sourcev1alpha1listers.NewMQTTSourceLister(l.indexerFor(&sourcesv1alpha1.MQTTSource{}))
+}
+
+func (l *Listers) GetWebSocketSourceLister() sourcev1alpha1listers.WebSocketSourceLister {
+	return sourcev1alpha1listers.NewWebSocketSourceLister(l.indexerFor(&sourcesv1alpha1.WebSocketSource{}))
+}
+
 func (l *Listers) GetPingSourceLister() sourcelisters.PingSourceLister {
 	return sourcelisters.NewPingSourceLister(l.indexerFor(&sourcesv1.PingSource{}))
 }
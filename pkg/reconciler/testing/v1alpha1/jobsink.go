@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
 
 	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -133,3 +134,55 @@ func WithJobSinkAddress(addr *duckv1.Addressable) JobSinkOption {
 		js.Status.SetAddress(addr)
 	}
 }
+
+// WithJobSinkCompletionSink sets the sink the JobSink sends the completion events to.
+func WithJobSinkCompletionSink(sink duckv1.Destination) JobSinkOption {
+	return func(js *sinksv1alpha1.JobSink) {
+		js.Spec.Completion = &sinksv1alpha1.JobSinkCompletion{Sink: &sink}
+	}
+}
+
+// WithJobSinkCompletionSinkResolved sets the JobSink's CompletionSinkResolved condition to true and the resolved completion sink.
+func WithJobSinkCompletionSinkResolved(addr *duckv1.Addressable) JobSinkOption {
+	return func(js *sinksv1alpha1.JobSink) {
+		js.Status.MarkCompletionSinkResolved(addr)
+	}
+}
+
+// WithJobSinkCompletionSinkNotConfigured sets the JobSink's CompletionSinkResolved condition to true with reason.
+func WithJobSinkCompletionSinkNotConfigured() JobSinkOption {
+	return func(js *sinksv1alpha1.JobSink) {
+		js.Status.MarkCompletionSinkNotConfigured()
+	}
+}
+
+// WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled sets the JobSink's OIDCIdentityCreated condition to true with reason.
+func WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled() JobSinkOption {
+	return func(js *sinksv1alpha1.JobSink) {
+		js.Status.MarkOIDCIdentityCreatedSucceededWithReason(fmt.Sprintf("%s feature disabled", feature.OIDCAuthentication), "")
+	}
+}
+
+// WithJobSinkMaxConcurrentJobs sets the maximum number of concurrent Jobs of the JobSink.
+func WithJobSinkMaxConcurrentJobs(max int32) JobSinkOption {
+	return func(js *sinksv1alpha1.JobSink) {
+		js.Spec.MaxConcurrentJobs = &max
+	}
+}
+
+// WithJobSinkRetention sets the retention policy of the finished Jobs of the JobSink.
+func WithJobSinkRetention(retention *sinksv1alpha1.JobSinkRetention) JobSinkOption {
+	return func(js *sinksv1alpha1.JobSink) {
+		js.Spec.Retention = retention
+	}
+}
+
+// WithJobSinkBatching configures the JobSink to collect events into batches.
+func WithJobSinkBatching(maxEvents int32, maxWait string) JobSinkOption {
+	return func(js *sinksv1alpha1.JobSink) {
+		js.Spec.Batching = &sinksv1alpha1.JobSinkBatching{
+			MaxEvents: &maxEvents,
+			MaxWait:   &maxWait,
+		}
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/eventing/pkg/apis/sources/v1alpha1"
+)
+
+// MQTTSourceOption enables further configuration of a MQTTSource.
+type MQTTSourceOption func(source *v1alpha1.MQTTSource)
+
+// NewMQTTSource creates a v1alpha1 MQTTSource with MQTTSourceOptions
+func NewMQTTSource(name, namespace string, o ...MQTTSourceOption) *v1alpha1.MQTTSource {
+	s := &v1alpha1.MQTTSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	for _, opt := range o {
+		opt(s)
+	}
+	s.SetDefaults(context.Background())
+	return s
+}
+
+func WithMQTTSourceUID(uid types.UID) MQTTSourceOption {
+	return func(s *v1alpha1.MQTTSource) {
+		s.UID = uid
+	}
+}
+
+// WithInitMQTTSourceConditions initializes the MQTTSource's conditions.
+func WithInitMQTTSourceConditions(s *v1alpha1.MQTTSource) {
+	s.Status.InitializeConditions()
+}
+
+func WithMQTTSourceStatusObservedGeneration(generation int64) MQTTSourceOption {
+	return func(s *v1alpha1.MQTTSource) {
+		s.Status.ObservedGeneration = generation
+	}
+}
+
+func WithMQTTSourcePropagateContainerSourceStatus(status *v1.ContainerSourceStatus) MQTTSourceOption {
+	return func(s *v1alpha1.MQTTSource) {
+		s.Status.PropagateContainerSourceStatus(status)
+	}
+}
+
+func WithMQTTSourceSpec(spec v1alpha1.MQTTSourceSpec) MQTTSourceOption {
+	return func(s *v1alpha1.MQTTSource) {
+		s.Spec = spec
+	}
+}
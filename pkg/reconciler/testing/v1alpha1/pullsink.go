@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+	"knative.dev/eventing/pkg/apis/feature"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// PullSinkOption enables further configuration of a PullSink.
+type PullSinkOption func(*sinksv1alpha1.PullSink)
+
+// NewPullSink creates a PullSink with PullSinkOptions.
+func NewPullSink(name, namespace string, o ...PullSinkOption) *sinksv1alpha1.PullSink {
+	ps := &sinksv1alpha1.PullSink{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+	}
+	for _, opt := range o {
+		opt(ps)
+	}
+	ps.SetDefaults(context.Background())
+	return ps
+}
+
+// WithInitPullSinkConditions initializes the PullSink's conditions.
+func WithInitPullSinkConditions(ps *sinksv1alpha1.PullSink) {
+	ps.Status.InitializeConditions()
+}
+
+func WithPullSinkFinalizers(finalizers ...string) PullSinkOption {
+	return func(ps *sinksv1alpha1.PullSink) {
+		ps.Finalizers = finalizers
+	}
+}
+
+func WithPullSinkResourceVersion(rv string) PullSinkOption {
+	return func(ps *sinksv1alpha1.PullSink) {
+		ps.ResourceVersion = rv
+	}
+}
+
+func WithPullSinkGeneration(gen int64) PullSinkOption {
+	return func(ps *sinksv1alpha1.PullSink) {
+		ps.Generation = gen
+	}
+}
+
+// WithPullSinkConsumerSubjects sets the subjects of the consumers allowed to pull the events of the PullSink.
+func WithPullSinkConsumerSubjects(subjects ...string) PullSinkOption {
+	return func(ps *sinksv1alpha1.PullSink) {
+		ps.Spec.Consumers = &sinksv1alpha1.PullSinkConsumers{Subjects: subjects}
+	}
+}
+
+// WithPullSinkDelivery sets the delivery spec of the PullSink.
+func WithPullSinkDelivery(delivery *eventingduckv1.DeliverySpec) PullSinkOption {
+	return func(ps *sinksv1alpha1.PullSink) {
+		ps.Spec.Delivery = delivery
+	}
+}
+
+// WithPullSinkEventPoliciesReady sets the PullSink's EventPoliciesReady condition to true.
+func WithPullSinkEventPoliciesReady() PullSinkOption {
+	return func(ps *sinksv1alpha1.PullSink) {
+		ps.Status.MarkEventPoliciesTrue()
+	}
+}
+
+// WithPullSinkEventPoliciesNotReady sets the PullSink's EventPoliciesReady condition to false.
+func WithPullSinkEventPoliciesNotReady(reason, message string) PullSinkOption {
+	return func(ps *sinksv1alpha1.PullSink) {
+		ps.Status.MarkEventPoliciesFailed(reason, message)
+	}
+}
+
+// WithPullSinkEventPoliciesListed adds Ready EventPolicies to the PullSink's status.
+func WithPullSinkEventPoliciesListed(policyNames ...string) PullSinkOption {
+	return func(ps *sinksv1alpha1.PullSink) {
+		for _, policyName := range policyNames {
+			ps.Status.Policies = append(ps.Status.Policies, eventingduckv1.AppliedEventPolicyRef{
+				Name:       policyName,
+				APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			})
+		}
+	}
+}
+
+// WithPullSinkEventPoliciesReadyBecauseNoPolicy() sets the PullSink's EventPoliciesReady condition to true with reason.
+func WithPullSinkEventPoliciesReadyBecauseOIDCDisabled() PullSinkOption {
+	return func(ps *sinksv1alpha1.PullSink) {
+		ps.Status.MarkEventPoliciesTrueWithReason("OIDCDisabled", "Feature %q must be enabled to support Authorization", feature.OIDCAuthentication)
+	}
+}
+
+// WithPullSinkEventPoliciesReadyBecauseNoPolicyAndOIDCEnabled() sets the PullSink's EventPoliciesReady condition to true with reason.
+func WithPullSinkEventPoliciesReadyBecauseNoPolicyAndOIDCEnabled() PullSinkOption {
+	return func(ps *sinksv1alpha1.PullSink) {
+		ps.Status.MarkEventPoliciesTrueWithReason("DefaultAuthorizationMode", "Default authz mode is %q", feature.AuthorizationAllowSameNamespace)
+	}
+}
+
+func WithPullSinkAddress(addr *duckv1.Addressable) PullSinkOption {
+	return func(ps *sinksv1alpha1.PullSink) {
+		ps.Status.SetAddress(addr)
+	}
+}
+
+// WithPullSinkDeadLetterSinkNotConfigured marks the PullSink's DeadLetterSinkResolved condition as true because it has no dead letter sink.
+func WithPullSinkDeadLetterSinkNotConfigured() PullSinkOption {
+	return func(ps *sinksv1alpha1.PullSink) {
+		ps.Status.MarkDeadLetterSinkNotConfigured()
+	}
+}
+
+// WithPullSinkDeadLetterSinkResolvedSucceeded sets the resolved dead letter sink of the PullSink.
+func WithPullSinkDeadLetterSinkResolvedSucceeded(deliveryStatus eventingduckv1.DeliveryStatus) PullSinkOption {
+	return func(ps *sinksv1alpha1.PullSink) {
+		ps.Status.MarkDeadLetterSinkResolvedSucceeded(deliveryStatus)
+	}
+}
+
+// WithPullSinkDeadLetterSinkResolvedFailed marks the PullSink's DeadLetterSinkResolved condition as false.
+func WithPullSinkDeadLetterSinkResolvedFailed(reason, message string) PullSinkOption {
+	return func(ps *sinksv1alpha1.PullSink) {
+		ps.Status.MarkDeadLetterSinkResolvedFailed(reason, message)
+	}
+}
+
+// WithPullSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled marks the PullSink's OIDCIdentityCreated condition as true because OIDC is disabled.
+func WithPullSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled() PullSinkOption {
+	return func(ps *sinksv1alpha1.PullSink) {
+		ps.Status.MarkOIDCIdentityCreatedSucceededWithReason(fmt.Sprintf("%s feature disabled", feature.OIDCAuthentication), "")
+	}
+}
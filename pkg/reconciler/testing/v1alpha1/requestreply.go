@@ -18,8 +18,10 @@ package v1alpha1
 
 import (
 	"context"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	eventing "knative.dev/eventing/pkg/apis/eventing/v1alpha1"
 	"knative.dev/pkg/apis"
@@ -100,3 +102,26 @@ func WithRequestReplyBrokerAddressAnnotation(address string) RequestReplyOption
 		rr.Status.Annotations[eventing.RequestReplyBrokerAddressStatusAnnotationKey] = address
 	}
 }
+
+func WithRequestReplyUID(uid types.UID) RequestReplyOption {
+	return func(rr *eventing.RequestReply) {
+		rr.UID = uid
+	}
+}
+
+func WithRequestReplyKeyGeneration(generation int64) RequestReplyOption {
+	return func(rr *eventing.RequestReply) {
+		rr.Status.KeyGeneration = ptr.To(generation)
+	}
+}
+
+func WithRequestReplyFinalizers(finalizers ...string) RequestReplyOption {
+	return func(rr *eventing.RequestReply) {
+		rr.Finalizers = finalizers
+	}
+}
+
+func WithRequestReplyDeleted(rr *eventing.RequestReply) {
+	t := metav1.NewTime(time.Unix(1e9, 0))
+	rr.SetDeletionTimestamp(&t)
+}
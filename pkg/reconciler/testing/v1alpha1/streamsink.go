@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+	"knative.dev/eventing/pkg/apis/feature"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// StreamSinkOption enables further configuration of a StreamSink.
+type StreamSinkOption func(*sinksv1alpha1.StreamSink)
+
+// NewStreamSink creates a StreamSink with StreamSinkOptions.
+func NewStreamSink(name, namespace string, o ...StreamSinkOption) *sinksv1alpha1.StreamSink {
+	ss := &sinksv1alpha1.StreamSink{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+	}
+	for _, opt := range o {
+		opt(ss)
+	}
+	ss.SetDefaults(context.Background())
+	return ss
+}
+
+// WithInitStreamSinkConditions initializes the StreamSink's conditions.
+func WithInitStreamSinkConditions(ss *sinksv1alpha1.StreamSink) {
+	ss.Status.InitializeConditions()
+}
+
+func WithStreamSinkFinalizers(finalizers ...string) StreamSinkOption {
+	return func(ss *sinksv1alpha1.StreamSink) {
+		ss.Finalizers = finalizers
+	}
+}
+
+func WithStreamSinkResourceVersion(rv string) StreamSinkOption {
+	return func(ss *sinksv1alpha1.StreamSink) {
+		ss.ResourceVersion = rv
+	}
+}
+
+func WithStreamSinkGeneration(gen int64) StreamSinkOption {
+	return func(ss *sinksv1alpha1.StreamSink) {
+		ss.Generation = gen
+	}
+}
+
+// WithStreamSinkClientSubjects sets the subjects of the clients allowed to connect to the StreamSink.
+func WithStreamSinkClientSubjects(subjects ...string) StreamSinkOption {
+	return func(ss *sinksv1alpha1.StreamSink) {
+		ss.Spec.Clients = &sinksv1alpha1.StreamSinkClients{Subjects: subjects}
+	}
+}
+
+// WithStreamSinkEventPoliciesReady sets the StreamSink's EventPoliciesReady condition to true.
+func WithStreamSinkEventPoliciesReady() StreamSinkOption {
+	return func(ss *sinksv1alpha1.StreamSink) {
+		ss.Status.MarkEventPoliciesTrue()
+	}
+}
+
+// WithStreamSinkEventPoliciesNotReady sets the StreamSink's EventPoliciesReady condition to false.
+func WithStreamSinkEventPoliciesNotReady(reason, message string) StreamSinkOption {
+	return func(ss *sinksv1alpha1.StreamSink) {
+		ss.Status.MarkEventPoliciesFailed(reason, message)
+	}
+}
+
+// WithStreamSinkEventPoliciesListed adds Ready EventPolicies to the StreamSink's status.
+func WithStreamSinkEventPoliciesListed(policyNames ...string) StreamSinkOption {
+	return func(ss *sinksv1alpha1.StreamSink) {
+		for _, policyName := range policyNames {
+			ss.Status.Policies = append(ss.Status.Policies, eventingduckv1.AppliedEventPolicyRef{
+				Name:       policyName,
+				APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			})
+		}
+	}
+}
+
+// WithStreamSinkEventPoliciesReadyBecauseNoPolicy() sets the StreamSink's EventPoliciesReady condition to true with reason.
+func WithStreamSinkEventPoliciesReadyBecauseOIDCDisabled() StreamSinkOption {
+	return func(ss *sinksv1alpha1.StreamSink) {
+		ss.Status.MarkEventPoliciesTrueWithReason("OIDCDisabled", "Feature %q must be enabled to support Authorization", feature.OIDCAuthentication)
+	}
+}
+
+// WithStreamSinkEventPoliciesReadyBecauseNoPolicyAndOIDCEnabled() sets the StreamSink's EventPoliciesReady condition to true with reason.
+func WithStreamSinkEventPoliciesReadyBecauseNoPolicyAndOIDCEnabled() StreamSinkOption {
+	return func(ss *sinksv1alpha1.StreamSink) {
+		ss.Status.MarkEventPoliciesTrueWithReason("DefaultAuthorizationMode", "Default authz mode is %q", feature.AuthorizationAllowSameNamespace)
+	}
+}
+
+func WithStreamSinkAddress(addr *duckv1.Addressable) StreamSinkOption {
+	return func(ss *sinksv1alpha1.StreamSink) {
+		ss.Status.SetAddress(addr)
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/eventing/pkg/apis/sources/v1alpha1"
+)
+
+// WebSocketSourceOption enables further configuration of a WebSocketSource.
+type WebSocketSourceOption func(source *v1alpha1.WebSocketSource)
+
+// NewWebSocketSource creates a v1alpha1 WebSocketSource with WebSocketSourceOptions
+func NewWebSocketSource(name, namespace string, o ...WebSocketSourceOption) *v1alpha1.WebSocketSource {
+	s := &v1alpha1.WebSocketSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	for _, opt := range o {
+		opt(s)
+	}
+	s.SetDefaults(context.Background())
+	return s
+}
+
+func WithWebSocketSourceUID(uid types.UID) WebSocketSourceOption {
+	return func(s *v1alpha1.WebSocketSource) {
+		s.UID = uid
+	}
+}
+
+// WithInitWebSocketSourceConditions initializes the WebSocketSource's conditions.
+func WithInitWebSocketSourceConditions(s *v1alpha1.WebSocketSource) {
+	s.Status.InitializeConditions()
+}
+
+func WithWebSocketSourceStatusObservedGeneration(generation int64) WebSocketSourceOption {
+	return func(s *v1alpha1.WebSocketSource) {
+		s.Status.ObservedGeneration = generation
+	}
+}
+
+func WithWebSocketSourcePropagateContainerSourceStatus(status *v1.ContainerSourceStatus) WebSocketSourceOption {
+	return func(s *v1alpha1.WebSocketSource) {
+		s.Status.PropagateContainerSourceStatus(status)
+	}
+}
+
+func WithWebSocketSourceSpec(spec v1alpha1.WebSocketSourceSpec) WebSocketSourceOption {
+	return func(s *v1alpha1.WebSocketSource) {
+		s.Spec = spec
+	}
+}
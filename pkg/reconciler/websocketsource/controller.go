@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocketsource
+
+import (
+	"context"
+
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+
+	"knative.dev/eventing/pkg/apis/sources/v1alpha1"
+	eventingclient "knative.dev/eventing/pkg/client/injection/client"
+	containersourceinformer "knative.dev/eventing/pkg/client/injection/informers/sources/v1/containersource"
+	websocketsourceinformer "knative.dev/eventing/pkg/client/injection/informers/sources/v1alpha1/websocketsource"
+	websocketsourcereconciler "knative.dev/eventing/pkg/client/injection/reconciler/sources/v1alpha1/websocketsource"
+)
+
+// NewController creates a Reconciler for WebSocketSource and returns the result of NewImpl.
+func NewController(
+	ctx context.Context,
+	cmw configmap.Watcher,
+) *controller.Impl {
+	webSocketSourceInformer := websocketsourceinformer.Get(ctx)
+	containerSourceInformer := containersourceinformer.Get(ctx)
+
+	r := &Reconciler{
+		eventingClientSet:     eventingclient.Get(ctx),
+		containerSourceLister: containerSourceInformer.Lister(),
+	}
+
+	impl := websocketsourcereconciler.NewImpl(ctx, r)
+
+	webSocketSourceInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+
+	containerSourceInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterController(&v1alpha1.WebSocketSource{}),
+		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+	})
+
+	return impl
+}
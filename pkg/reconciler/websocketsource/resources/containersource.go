@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rickb777/date/period"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/kmeta"
+
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/eventing/pkg/apis/sources/v1alpha1"
+)
+
+const (
+	// imageEnvVar is the environment variable of the controller holding the
+	// image of the WebSocket receive adapter, injected in
+	// ./config/core/deployments/controller.yaml
+	imageEnvVar = "WEBSOCKET_SOURCE_IMAGE"
+)
+
+// Labels are the labels of the resources created for the WebSocketSource name.
+func Labels(name string) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":      name,
+		"app.kubernetes.io/component": "websocketsource",
+	}
+}
+
+// NewContainerSource returns the ContainerSource running the receive adapter
+// of source.
+func NewContainerSource(source *v1alpha1.WebSocketSource) *sourcesv1.ContainerSource {
+	labels := Labels(source.Name)
+	return &sourcesv1.ContainerSource{
+		ObjectMeta: metav1.ObjectMeta{
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(source),
+			},
+			Name:      ContainerSourceName(source),
+			Namespace: source.Namespace,
+			Labels:    labels,
+		},
+		Spec: sourcesv1.ContainerSourceSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: source.Spec.ServiceAccountName,
+					Containers: []corev1.Container{
+						{
+							Name:            "receive-adapter",
+							Image:           os.Getenv(imageEnvVar),
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Env:             makeEnv(source),
+							// The receive adapter is only ready while it is
+							// connected to the WebSocket server.
+							ReadinessProbe: &corev1.Probe{
+								ProbeHandler: corev1.ProbeHandler{
+									HTTPGet: &corev1.HTTPGetAction{
+										Path: "/readiness",
+										Port: intstr.FromInt32(injection.HealthCheckDefaultPort),
+									},
+								},
+								PeriodSeconds: 5,
+							},
+							SecurityContext: &corev1.SecurityContext{
+								AllowPrivilegeEscalation: ptr.To(false),
+								ReadOnlyRootFilesystem:   ptr.To(true),
+								RunAsNonRoot:             ptr.To(true),
+								Capabilities: &corev1.Capabilities{
+									Drop: []corev1.Capability{"ALL"},
+								},
+								SeccompProfile: &corev1.SeccompProfile{
+									Type: corev1.SeccompProfileTypeRuntimeDefault,
+								},
+							},
+						},
+					},
+				},
+			},
+			SourceSpec: source.Spec.SourceSpec,
+		},
+	}
+}
+
+func makeEnv(source *v1alpha1.WebSocketSource) []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		{Name: "NAMESPACE", Value: source.Namespace},
+		{Name: "NAME", Value: source.Name},
+		{Name: "WEBSOCKET_URL", Value: source.Spec.URL},
+		{Name: "WEBSOCKET_FORMAT", Value: string(source.Spec.Format)},
+		{Name: "WEBSOCKET_EVENT_TYPE", Value: source.Spec.EventType},
+	}
+
+	if len(source.Spec.Subprotocols) > 0 {
+		env = append(env, corev1.EnvVar{Name: "WEBSOCKET_SUBPROTOCOLS", Value: strings.Join(source.Spec.Subprotocols, ",")})
+	}
+
+	if len(source.Spec.Headers) > 0 {
+		names := make([]string, 0, len(source.Spec.Headers))
+		for i, header := range source.Spec.Headers {
+			names = append(names, header.Name)
+			name := fmt.Sprintf("WEBSOCKET_HEADER_%d", i)
+			if header.ValueFromSecret != nil {
+				env = appendSecretEnv(env, name, header.ValueFromSecret)
+			} else {
+				env = append(env, corev1.EnvVar{Name: name, Value: header.Value})
+			}
+		}
+		// Header names are plain strings, marshalling them can't fail.
+		encoded, _ := json.Marshal(names)
+		env = append(env, corev1.EnvVar{Name: "WEBSOCKET_HEADERS", Value: string(encoded)})
+	}
+
+	if reconnect := source.Spec.Reconnect; reconnect != nil {
+		env = appendDurationEnv(env, "WEBSOCKET_RECONNECT_INITIAL_DELAY", reconnect.InitialDelay)
+		env = appendDurationEnv(env, "WEBSOCKET_RECONNECT_MAX_DELAY", reconnect.MaxDelay)
+	}
+
+	return env
+}
+
+func appendSecretEnv(env []corev1.EnvVar, name string, selector *corev1.SecretKeySelector) []corev1.EnvVar {
+	if selector == nil {
+		return env
+	}
+	return append(env, corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: selector.DeepCopy(),
+		},
+	})
+}
+
+// appendDurationEnv converts the ISO-8601 period p to the format of
+// time.ParseDuration expected by the receive adapter.
+func appendDurationEnv(env []corev1.EnvVar, name string, p *string) []corev1.EnvVar {
+	if p == nil {
+		return env
+	}
+	parsed, err := period.Parse(*p)
+	if err != nil {
+		// Periods are validated by the webhook, let the adapter use its default.
+		return env
+	}
+	d, _ := parsed.Duration()
+	return append(env, corev1.EnvVar{Name: name, Value: d.Round(time.Millisecond).String()})
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"knative.dev/eventing/pkg/apis/sources/v1alpha1"
+)
+
+func TestNewContainerSource(t *testing.T) {
+	t.Setenv(imageEnvVar, "quay.io/fake-image/websocket-source")
+
+	token := &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "ticker"},
+		Key:                  "token",
+	}
+
+	source := &v1alpha1.WebSocketSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ticker",
+			Namespace: "ns",
+		},
+		Spec: v1alpha1.WebSocketSourceSpec{
+			URL: "wss://ticker.example.com/events",
+			Headers: []v1alpha1.WebSocketHeader{
+				{Name: "Authorization", ValueFromSecret: token},
+				{Name: "X-Client", Value: "knative"},
+			},
+			Subprotocols: []string{"v1.ticks", "v2.ticks"},
+			Format:       v1alpha1.WebSocketMessageFormatRaw,
+			EventType:    "com.example.tick",
+			Reconnect: &v1alpha1.WebSocketReconnect{
+				InitialDelay: ptr.To("PT2S"),
+				MaxDelay:     ptr.To("PT5M"),
+			},
+			ServiceAccountName: "ticker",
+		},
+	}
+
+	cs := NewContainerSource(source)
+
+	if cs.Name != "ticker-containersource" || cs.Namespace != "ns" {
+		t.Errorf("Unexpected ContainerSource %s/%s", cs.Namespace, cs.Name)
+	}
+	if len(cs.OwnerReferences) != 1 || cs.OwnerReferences[0].Kind != "WebSocketSource" {
+		t.Errorf("Unexpected owner references %v", cs.OwnerReferences)
+	}
+
+	pod := cs.Spec.Template.Spec
+	if pod.ServiceAccountName != "ticker" {
+		t.Errorf("ServiceAccountName = %q, want ticker", pod.ServiceAccountName)
+	}
+	if len(pod.Containers) != 1 || pod.Containers[0].Image != "quay.io/fake-image/websocket-source" {
+		t.Fatalf("Unexpected containers %v", pod.Containers)
+	}
+	if probe := pod.Containers[0].ReadinessProbe; probe == nil || probe.HTTPGet == nil || probe.HTTPGet.Path != "/readiness" {
+		t.Errorf("Unexpected readiness probe %v", probe)
+	}
+
+	want := []corev1.EnvVar{
+		{Name: "NAMESPACE", Value: "ns"},
+		{Name: "NAME", Value: "ticker"},
+		{Name: "WEBSOCKET_URL", Value: "wss://ticker.example.com/events"},
+		{Name: "WEBSOCKET_FORMAT", Value: "Raw"},
+		{Name: "WEBSOCKET_EVENT_TYPE", Value: "com.example.tick"},
+		{Name: "WEBSOCKET_SUBPROTOCOLS", Value: "v1.ticks,v2.ticks"},
+		{Name: "WEBSOCKET_HEADER_0", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: token}},
+		{Name: "WEBSOCKET_HEADER_1", Value: "knative"},
+		{Name: "WEBSOCKET_HEADERS", Value: `["Authorization","X-Client"]`},
+		{Name: "WEBSOCKET_RECONNECT_INITIAL_DELAY", Value: "2s"},
+		{Name: "WEBSOCKET_RECONNECT_MAX_DELAY", Value: "5m0s"},
+	}
+	if diff := cmp.Diff(want, pod.Containers[0].Env); diff != "" {
+		t.Error("Unexpected env (-want, +got):", diff)
+	}
+}
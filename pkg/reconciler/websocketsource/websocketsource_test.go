@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocketsource
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	logtesting "knative.dev/pkg/logging/testing"
+	. "knative.dev/pkg/reconciler/testing"
+
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	sourcesv1alpha1 "knative.dev/eventing/pkg/apis/sources/v1alpha1"
+	fakeeventingclient "knative.dev/eventing/pkg/client/injection/client/fake"
+	"knative.dev/eventing/pkg/client/injection/reconciler/sources/v1alpha1/websocketsource"
+	. "knative.dev/eventing/pkg/reconciler/testing/v1"
+	. "knative.dev/eventing/pkg/reconciler/testing/v1alpha1"
+	"knative.dev/eventing/pkg/reconciler/websocketsource/resources"
+)
+
+const (
+	sourceName = "test-websocket-source"
+	sourceUID  = "1234-5678-90"
+	testNS     = "testnamespace"
+	sinkName   = "testsink"
+	generation = 1
+
+	webSocketSourceImage = "quay.io/fake-image/websocket-source"
+)
+
+var (
+	conditionTrue = corev1.ConditionTrue
+
+	containerSourceName = fmt.Sprintf("%s-containersource", sourceName)
+
+	sinkDest = duckv1.Destination{
+		Ref: &duckv1.KReference{
+			Name:       sinkName,
+			Kind:       "Channel",
+			APIVersion: "messaging.knative.dev/v1",
+		},
+	}
+)
+
+func TestReconcile(t *testing.T) {
+	t.Setenv("WEBSOCKET_SOURCE_IMAGE", webSocketSourceImage)
+
+	table := TableTest{
+		{
+			Name: "bad work queue key",
+			Key:  "too/many/parts",
+		},
+		{
+			Name: "key not found",
+			// Make sure Reconcile handles good keys that don't exist.
+			Key: "foo/not-found",
+		},
+		{
+			Name: "error creating containersource",
+			Objects: []runtime.Object{
+				NewWebSocketSource(sourceName, testNS,
+					WithWebSocketSourceUID(sourceUID),
+					WithWebSocketSourceSpec(makeWebSocketSourceSpec(sinkDest)),
+				),
+			},
+			Key: testNS + "/" + sourceName,
+			WithReactors: []clientgotesting.ReactionFunc{
+				InduceFailure("create", "containersources"),
+			},
+			WantEvents: []string{
+				Eventf(corev1.EventTypeWarning, "InternalError", "creating new ContainerSource: inducing failure for %s %s", "create", "containersources"),
+			},
+			WantErr: true,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+				Object: NewWebSocketSource(sourceName, testNS,
+					WithWebSocketSourceUID(sourceUID),
+					WithWebSocketSourceSpec(makeWebSocketSourceSpec(sinkDest)),
+					WithInitWebSocketSourceConditions,
+				),
+			}},
+			WantCreates: []runtime.Object{
+				makeContainerSource(NewWebSocketSource(sourceName, testNS,
+					WithWebSocketSourceUID(sourceUID),
+					WithWebSocketSourceSpec(makeWebSocketSourceSpec(sinkDest))),
+					nil),
+			},
+		}, {
+			Name: "successfully reconciled and not ready",
+			Objects: []runtime.Object{
+				NewWebSocketSource(sourceName, testNS,
+					WithWebSocketSourceUID(sourceUID),
+					WithWebSocketSourceSpec(makeWebSocketSourceSpec(sinkDest)),
+				),
+			},
+			Key: testNS + "/" + sourceName,
+			WantEvents: []string{
+				Eventf(corev1.EventTypeNormal, containerSourceCreated, "ContainerSource created %q", containerSourceName),
+				Eventf(corev1.EventTypeNormal, sourceReconciled, `WebSocketSource reconciled: "%s/%s"`, testNS, sourceName),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+				Object: NewWebSocketSource(sourceName, testNS,
+					WithWebSocketSourceUID(sourceUID),
+					WithWebSocketSourceSpec(makeWebSocketSourceSpec(sinkDest)),
+					WithInitWebSocketSourceConditions,
+				),
+			}},
+			WantCreates: []runtime.Object{
+				makeContainerSource(NewWebSocketSource(sourceName, testNS,
+					WithWebSocketSourceUID(sourceUID),
+					WithWebSocketSourceSpec(makeWebSocketSourceSpec(sinkDest))),
+					nil),
+			},
+		}, {
+			Name: "successfully reconciled and ready",
+			Objects: []runtime.Object{
+				NewWebSocketSource(sourceName, testNS,
+					WithWebSocketSourceUID(sourceUID),
+					WithWebSocketSourceSpec(makeWebSocketSourceSpec(sinkDest)),
+				),
+				makeContainerSource(NewWebSocketSource(sourceName, testNS,
+					WithWebSocketSourceUID(sourceUID),
+					WithWebSocketSourceSpec(makeWebSocketSourceSpec(sinkDest)),
+				), &conditionTrue),
+			},
+			Key: testNS + "/" + sourceName,
+			WantEvents: []string{
+				Eventf(corev1.EventTypeNormal, sourceReconciled, `WebSocketSource reconciled: "%s/%s"`, testNS, sourceName),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+				Object: NewWebSocketSource(sourceName, testNS,
+					WithWebSocketSourceUID(sourceUID),
+					WithWebSocketSourceSpec(makeWebSocketSourceSpec(sinkDest)),
+					WithInitWebSocketSourceConditions,
+					WithWebSocketSourceStatusObservedGeneration(generation),
+					WithWebSocketSourcePropagateContainerSourceStatus(makeContainerSourceStatus(&conditionTrue)),
+				),
+			}},
+		}, {
+			Name: "containersource owned by another resource",
+			Objects: []runtime.Object{
+				NewWebSocketSource(sourceName, testNS,
+					WithWebSocketSourceUID(sourceUID),
+					WithWebSocketSourceSpec(makeWebSocketSourceSpec(sinkDest)),
+				),
+				makeContainerSource(NewWebSocketSource(sourceName, testNS,
+					WithWebSocketSourceUID("another-uid"),
+					WithWebSocketSourceSpec(makeWebSocketSourceSpec(sinkDest)),
+				), &conditionTrue),
+			},
+			Key: testNS + "/" + sourceName,
+			WantEvents: []string{
+				Eventf(corev1.EventTypeWarning, "InternalError", `ContainerSource %q is not owned by WebSocketSource %q`, containerSourceName, sourceName),
+			},
+			WantErr: true,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+				Object: NewWebSocketSource(sourceName, testNS,
+					WithWebSocketSourceUID(sourceUID),
+					WithWebSocketSourceSpec(makeWebSocketSourceSpec(sinkDest)),
+					WithInitWebSocketSourceConditions,
+				),
+			}},
+		}}
+	logger := logtesting.TestLogger(t)
+
+	table.Test(t, MakeFactory(func(ctx context.Context, listers *Listers, cmw configmap.Watcher) controller.Reconciler {
+		r := &Reconciler{
+			eventingClientSet:     fakeeventingclient.Get(ctx),
+			containerSourceLister: listers.GetContainerSourceLister(),
+		}
+
+		return websocketsource.NewReconciler(ctx, logging.FromContext(ctx), fakeeventingclient.Get(ctx), listers.GetWebSocketSourceLister(), controller.GetEventRecorder(ctx), r)
+	},
+		true,
+		logger,
+	))
+}
+
+func makeContainerSource(source *sourcesv1alpha1.WebSocketSource, ready *corev1.ConditionStatus) *sourcesv1.ContainerSource {
+	cs := resources.NewContainerSource(source)
+	if ready != nil {
+		cs.Status = *makeContainerSourceStatus(ready)
+	}
+	return cs
+}
+
+func makeContainerSourceStatus(ready *corev1.ConditionStatus) *sourcesv1.ContainerSourceStatus {
+	return &sourcesv1.ContainerSourceStatus{
+		SourceStatus: duckv1.SourceStatus{
+			Status: duckv1.Status{
+				Conditions: []apis.Condition{{
+					Type:   apis.ConditionReady,
+					Status: *ready,
+				}, {
+					Type:   sourcesv1.ContainerSourceConditionSinkBindingReady,
+					Status: *ready,
+				}, {
+					Type:   sourcesv1.ContainerSourceConditionReceiveAdapterReady,
+					Status: *ready,
+				}},
+			},
+		},
+	}
+}
+
+func makeWebSocketSourceSpec(sink duckv1.Destination) sourcesv1alpha1.WebSocketSourceSpec {
+	return sourcesv1alpha1.WebSocketSourceSpec{
+		URL: "wss://ticker.example.com/events",
+		SourceSpec: duckv1.SourceSpec{
+			Sink: sink,
+		},
+	}
+}
@@ -0,0 +1,392 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestreply
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/rickb777/date/period"
+	"go.uber.org/zap"
+	"k8s.io/utils/ptr"
+
+	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+/*
+ In the asynchronous mode, the RequestReply responds to a request immediately with 202 Accepted and the location of its reply in the
+ Location header: <RequestReply address>/replies/<correlation id>.
+
+ The client polls the reply location, which responds with 202 Accepted while the reply is pending and with the reply event once it arrived.
+ When the request has the callback attribute set to a URL, the reply event is also sent to that URL once it arrived. Since the URL is set
+ by the client, it must start with one of the allowed callbacks of the RequestReply, so the data plane can't be made to send requests to
+ arbitrary, internal endpoints.
+
+ Since the correlation id contains the index of the replica holding the request, a poll received by another replica is forwarded to it. The
+ requests are bounded per replica, and expire when no reply arrives within the timeout of the RequestReply, or once the reply was retained
+ for the reply retention of the RequestReply.
+*/
+
+// forwardedReplyPollTimeout bounds the time the replica holding a request takes to respond to a forwarded poll
+const forwardedReplyPollTimeout = 10 * time.Second
+
+type asyncRequest struct {
+	expires  time.Time
+	callback *apis.URL
+	reply    *cloudevents.Event
+}
+
+func (h *IngressHandler) handleNewAsyncEvent(ctx context.Context, responseWriter http.ResponseWriter, event *cloudevents.Event, rr *v1alpha1.RequestReply, headers http.Header) {
+	h.logger.Debug("handling new async event")
+
+	async := rr.Spec.Async.DeepCopy()
+	async.SetDefaults(ctx)
+
+	var callback *apis.URL
+	if value, ok := event.Extensions()[async.CallbackAttribute]; ok {
+		callbackString, err := types.ToString(value)
+		if err == nil {
+			callback, err = apis.ParseURL(callbackString)
+		}
+		if err != nil || callback == nil || callback.Host == "" || (callback.Scheme != "http" && callback.Scheme != "https") {
+			h.logger.Warn("invalid callback url set on the event", zap.Any("callback", value))
+			responseWriter.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if !callbackAllowed(callback, async.AllowedCallbacks) {
+			h.logger.Warn("callback url set on the event is not allowed", zap.Stringer("callback", callback))
+			responseWriter.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	brokerAddress, err := h.getBrokerAddress(rr)
+	if err != nil {
+		h.logger.Warn("no broker address annotation set in the request reply resource status", zap.String("name", rr.GetName()), zap.String("namespace", rr.GetNamespace()))
+		responseWriter.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	latestKey, ok := h.keyStore.GetLatestKey(rr.GetNamespacedName())
+	if !ok {
+		h.logger.Warn("no aes key found for requestreply resource", zap.String("name", rr.GetName()), zap.String("namespace", rr.GetNamespace()))
+		responseWriter.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	err = SetCorrelationId(event, rr.Spec.CorrelationAttribute, latestKey, h.podIdx)
+	if err != nil {
+		h.logger.Error("failed to set correlation id on event", zap.Error(err))
+		responseWriter.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	correlationId, _ := types.ToString(event.Extensions()[rr.Spec.CorrelationAttribute])
+
+	if !h.addAsyncEvent(event, rr, callback, int(*async.MaxPending)) {
+		h.logger.Warn("too many pending async requests", zap.String("name", rr.GetName()), zap.String("namespace", rr.GetNamespace()))
+		responseWriter.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	_, err = h.dispatcher.SendEvent(ctx, *event, *brokerAddress, kncloudevents.WithHeader(headers))
+	if err != nil {
+		h.logger.Error("failed to dispatch event", zap.Error(err))
+		h.deleteAsyncEvent(event.ID(), rr)
+		responseWriter.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	responseWriter.Header().Set("Location", replyLocation(rr, correlationId))
+	responseWriter.WriteHeader(http.StatusAccepted)
+}
+
+// callbackAllowed returns whether the callback starts with one of the allowed callbacks, having the same scheme and host and a
+// path below the path of the allowed callback
+func callbackAllowed(callback *apis.URL, allowed []string) bool {
+	if callback.User != nil {
+		return false
+	}
+
+	for _, a := range allowed {
+		prefix, err := apis.ParseURL(a)
+		if err != nil || prefix == nil {
+			continue
+		}
+		if callback.Scheme != prefix.Scheme || !strings.EqualFold(callback.Host, prefix.Host) {
+			continue
+		}
+		dir := strings.TrimSuffix(prefix.Path, "/")
+		if callback.Path == prefix.Path || dir == "" || strings.HasPrefix(callback.Path, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// addAsyncEvent tracks the async request for the event, unless maxPending requests are already tracked for the RequestReply
+func (h *IngressHandler) addAsyncEvent(event *cloudevents.Event, rr *v1alpha1.RequestReply, callback *apis.URL, maxPending int) bool {
+	h.asyncLock.Lock()
+	defer h.asyncLock.Unlock()
+
+	entries := h.asyncEntries[rr.GetNamespacedName()]
+	if entries == nil {
+		entries = make(map[string]*asyncRequest)
+		h.asyncEntries[rr.GetNamespacedName()] = entries
+	}
+
+	now := time.Now()
+	for id, ar := range entries {
+		if now.After(ar.expires) {
+			delete(entries, id)
+		}
+	}
+
+	if len(entries) >= maxPending {
+		return false
+	}
+
+	entries[event.ID()] = &asyncRequest{
+		expires:  now.Add(RequestTimeout(rr)),
+		callback: callback,
+	}
+
+	return true
+}
+
+func (h *IngressHandler) deleteAsyncEvent(id string, rr *v1alpha1.RequestReply) {
+	h.asyncLock.Lock()
+	defer h.asyncLock.Unlock()
+	delete(h.asyncEntries[rr.GetNamespacedName()], id)
+}
+
+// getAsyncEvent returns a copy of the async request with the id, unless it expired
+func (h *IngressHandler) getAsyncEvent(id string, rr *v1alpha1.RequestReply) (asyncRequest, bool) {
+	h.asyncLock.Lock()
+	defer h.asyncLock.Unlock()
+
+	ar, ok := h.asyncEntries[rr.GetNamespacedName()][id]
+	if !ok {
+		return asyncRequest{}, false
+	}
+
+	if time.Now().After(ar.expires) {
+		delete(h.asyncEntries[rr.GetNamespacedName()], id)
+		return asyncRequest{}, false
+	}
+
+	return *ar, true
+}
+
+// replyToAsyncEvent stores the reply event for the async request with the id, and sends it to the callback of the request if it has one
+func (h *IngressHandler) replyToAsyncEvent(rr *v1alpha1.RequestReply, id string, event *cloudevents.Event) bool {
+	h.asyncLock.Lock()
+	ar, ok := h.asyncEntries[rr.GetNamespacedName()][id]
+	if !ok || ar.reply != nil || time.Now().After(ar.expires) {
+		h.asyncLock.Unlock()
+		return ok
+	}
+
+	ar.reply = event
+	ar.expires = time.Now().Add(ReplyRetention(rr))
+	callback := ar.callback
+	h.asyncLock.Unlock()
+
+	if callback != nil {
+		go h.sendReplyToCallback(rr, callback, event)
+	}
+
+	return true
+}
+
+func (h *IngressHandler) sendReplyToCallback(rr *v1alpha1.RequestReply, callback *apis.URL, event *cloudevents.Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), RequestTimeout(rr))
+	defer cancel()
+
+	var opts []kncloudevents.SendOption
+	if rr.Spec.Delivery != nil {
+		retryConfig, err := kncloudevents.RetryConfigFromDeliverySpec(*rr.Spec.Delivery)
+		if err != nil {
+			h.logger.Warn("failed to get retry config from the delivery spec, sending reply without retries", zap.Error(err))
+		} else {
+			opts = append(opts, kncloudevents.WithRetryConfig(&retryConfig))
+		}
+	}
+
+	_, err := h.dispatcher.SendEvent(ctx, *event, duckv1.Addressable{URL: callback}, opts...)
+	if err != nil {
+		h.logger.Warn("failed to send reply event to callback", zap.Stringer("callback", callback), zap.Error(err))
+	}
+}
+
+func (h *IngressHandler) handleReplyPoll(ctx context.Context, responseWriter http.ResponseWriter, rr *v1alpha1.RequestReply, escapedCorrelationId string, forwarded bool) {
+	h.logger.Debug("handling a reply poll")
+
+	correlationId, err := url.PathUnescape(escapedCorrelationId)
+	if err != nil {
+		h.logger.Warn("failed to unescape the correlation id", zap.Error(err))
+		responseWriter.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	allKeys, ok := h.keyStore.GetAllKeys(rr.GetNamespacedName())
+	if !ok {
+		h.logger.Warn("no aes keys found for requestreply resource", zap.String("name", rr.GetName()), zap.String("namespace", rr.GetNamespace()))
+		responseWriter.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !h.verifyReplyId(correlationId, allKeys) {
+		responseWriter.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	owner, err := ReplyIdOwner(correlationId)
+	if err != nil {
+		h.logger.Warn("failed to get the replica owning the request", zap.Error(err))
+		responseWriter.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if owner != h.podIdx && !forwarded {
+		h.forwardReplyPoll(ctx, responseWriter, rr, owner, escapedCorrelationId)
+		return
+	}
+
+	ar, ok := h.getAsyncEvent(strings.Split(correlationId, ":")[0], rr)
+	if !ok {
+		responseWriter.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if ar.reply == nil {
+		responseWriter.Header().Set("Location", replyLocation(rr, correlationId))
+		responseWriter.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	msg := binding.ToMessage(ar.reply)
+	err = cehttp.WriteResponseWriter(ctx, msg, http.StatusOK, responseWriter)
+	if err != nil {
+		h.logger.Error("failed to send reply event", zap.Error(err))
+	}
+	msg.Finish(err)
+}
+
+// forwardReplyPoll forwards the poll to the replica holding the request, and copies its response
+func (h *IngressHandler) forwardReplyPoll(ctx context.Context, responseWriter http.ResponseWriter, rr *v1alpha1.RequestReply, owner int, escapedCorrelationId string) {
+	if h.replicaAddress == nil {
+		h.logger.Warn("received reply poll for another replica, but forwarding is not configured", zap.Int("owner", owner))
+		responseWriter.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	replica := h.replicaAddress(owner)
+	client, err := h.dispatcher.Client(duckv1.Addressable{URL: replica})
+	if err != nil {
+		h.logger.Error("failed to get client for the owning replica", zap.Error(err))
+		responseWriter.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	target := *replica
+	target.Path = fmt.Sprintf("/%s/%s/replies/%s", rr.GetNamespace(), rr.GetName(), escapedCorrelationId)
+
+	ctx, cancel := context.WithTimeout(ctx, forwardedReplyPollTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		h.logger.Error("failed to create forwarded reply poll", zap.Error(err))
+		responseWriter.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	req.Header.Set(ForwardedReplyHeader, "true")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		h.logger.Error("failed to forward reply poll to owning replica", zap.Int("owner", owner), zap.Error(err))
+		responseWriter.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	for k, v := range resp.Header {
+		responseWriter.Header()[k] = v
+	}
+	responseWriter.WriteHeader(resp.StatusCode)
+	if _, err := io.Copy(responseWriter, resp.Body); err != nil {
+		h.logger.Warn("failed to copy forwarded reply poll response", zap.Error(err))
+	}
+}
+
+// replyLocation returns the location the reply to the request with the correlation id can be polled at
+func replyLocation(rr *v1alpha1.RequestReply, correlationId string) string {
+	path := fmt.Sprintf("/%s/%s/replies/%s", rr.GetNamespace(), rr.GetName(), correlationId)
+	rawPath := fmt.Sprintf("/%s/%s/replies/%s", rr.GetNamespace(), rr.GetName(), url.PathEscape(correlationId))
+
+	if rr.Status.Address == nil || rr.Status.Address.URL == nil {
+		return rawPath
+	}
+
+	location := *rr.Status.Address.URL
+	location.Path = path
+	location.RawPath = rawPath
+	return location.String()
+}
+
+// RequestTimeout returns the timeout of the RequestReply, defaulting to one minute
+func RequestTimeout(rr *v1alpha1.RequestReply) time.Duration {
+	return ParsePeriod(rr.Spec.Timeout, time.Minute)
+}
+
+// ReplyRetention returns the duration for which the reply to an async request is retained
+func ReplyRetention(rr *v1alpha1.RequestReply) time.Duration {
+	if rr.Spec.Async == nil {
+		return 0
+	}
+	return ParsePeriod(rr.Spec.Async.ReplyRetention, ParsePeriod(ptr.To(v1alpha1.DefaultRequestReplyReplyRetention), 0))
+}
+
+// ParsePeriod parses the ISO-8601 period, returning the fallback when it is unset, invalid or not positive
+func ParsePeriod(value *string, fallback time.Duration) time.Duration {
+	if value == nil {
+		return fallback
+	}
+
+	p, err := period.Parse(*value)
+	if err != nil {
+		return fallback
+	}
+
+	d, _ := p.Duration()
+	if d <= 0 {
+		return fallback
+	}
+	return d
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestreply
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"k8s.io/utils/ptr"
+
+	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+	requestreplyinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/requestreply/fake"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	configmapinformerfake "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/fake"
+	reconcilertesting "knative.dev/pkg/reconciler/testing"
+)
+
+func TestHandlerAsyncPoll(t *testing.T) {
+	t.Parallel()
+
+	handler, broker := setupAsyncHandler(t, &v1alpha1.RequestReplyAsync{})
+
+	recorder := postEvent(handler, getValidEvent())
+	require.Equal(t, http.StatusAccepted, recorder.Code)
+
+	location := recorder.Header().Get("Location")
+	require.True(t, strings.HasPrefix(location, "http://request-reply.knative-eventing.svc/default/my-request-reply/replies/"), "unexpected location %q", location)
+
+	// the reply is pending until the broker delivers it
+	assert.Equal(t, http.StatusAccepted, pollReply(handler, location).Code)
+
+	broker.reply(t)
+
+	recorder = pollReply(handler, location)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	reply, err := cloudevents.NewEventFromHTTPResponse(recorder.Result())
+	require.NoError(t, err)
+	assert.Equal(t, "reply", reply.Type())
+
+	// the reply can be polled until it expires
+	assert.Equal(t, http.StatusOK, pollReply(handler, location).Code)
+
+	handler.asyncLock.Lock()
+	for _, ar := range handler.asyncEntries[broker.rr.GetNamespacedName()] {
+		ar.expires = time.Now().Add(-time.Second)
+	}
+	handler.asyncLock.Unlock()
+
+	assert.Equal(t, http.StatusNotFound, pollReply(handler, location).Code)
+}
+
+func TestHandlerAsyncCallback(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan *cloudevents.Event, 1)
+	callback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event, err := cloudevents.NewEventFromHTTPRequest(r)
+		assert.NoError(t, err)
+		received <- event
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer callback.Close()
+
+	handler, broker := setupAsyncHandler(t, &v1alpha1.RequestReplyAsync{CallbackAttribute: "callback", AllowedCallbacks: []string{callback.URL + "/replies/"}})
+
+	event := cloudevents.NewEvent()
+	event.SetType("type")
+	event.SetSource("source")
+	event.SetID("1234567890")
+	event.SetExtension("callback", callback.URL+"/replies/1")
+	b, _ := event.MarshalJSON()
+
+	recorder := postEvent(handler, strings.NewReader(string(b)))
+	require.Equal(t, http.StatusAccepted, recorder.Code)
+
+	broker.reply(t)
+
+	select {
+	case reply := <-received:
+		assert.Equal(t, "reply", reply.Type())
+	case <-time.After(10 * time.Second):
+		t.Fatal("reply was not sent to the callback")
+	}
+}
+
+func TestHandlerAsyncInvalidCallback(t *testing.T) {
+	t.Parallel()
+
+	for _, callback := range []string{"/relative", "http://kubernetes.default.svc/api", "https://example.com/other/"} {
+		handler, _ := setupAsyncHandler(t, &v1alpha1.RequestReplyAsync{CallbackAttribute: "callback", AllowedCallbacks: []string{"https://example.com/replies/"}})
+
+		event := cloudevents.NewEvent()
+		event.SetType("type")
+		event.SetSource("source")
+		event.SetID("1234567890")
+		event.SetExtension("callback", callback)
+		b, _ := event.MarshalJSON()
+
+		assert.Equal(t, http.StatusBadRequest, postEvent(handler, strings.NewReader(string(b))).Code, callback)
+	}
+}
+
+func TestCallbackAllowed(t *testing.T) {
+	allowed := []string{"https://example.com/replies/", "http://callbacks.example.com"}
+
+	tests := map[string]bool{
+		"https://example.com/replies/1":          true,
+		"https://EXAMPLE.com/replies/1":          true,
+		"https://example.com/replies":            false,
+		"https://example.com/repliesX":           false,
+		"https://example.com/other":              false,
+		"http://example.com/replies/1":           false,
+		"https://example.com.evil.com/replies/1": false,
+		"https://user@example.com/replies/1":     false,
+		"http://callbacks.example.com":           true,
+		"http://callbacks.example.com/any/path":  true,
+		"http://callbacks.example.com:8080/":     false,
+	}
+	for callback, want := range tests {
+		u, err := apis.ParseURL(callback)
+		require.NoError(t, err)
+		assert.Equal(t, want, callbackAllowed(u, allowed), callback)
+	}
+	u, _ := apis.ParseURL("https://example.com/replies/1")
+	assert.False(t, callbackAllowed(u, nil), "callbacks must not be allowed by default")
+}
+
+func TestHandlerAsyncMaxPending(t *testing.T) {
+	t.Parallel()
+
+	handler, _ := setupAsyncHandler(t, &v1alpha1.RequestReplyAsync{MaxPending: ptr.To(int32(1))})
+
+	assert.Equal(t, http.StatusAccepted, postEvent(handler, getValidEvent()).Code)
+
+	event := cloudevents.NewEvent()
+	event.SetType("type")
+	event.SetSource("source")
+	event.SetID("other")
+	b, _ := event.MarshalJSON()
+
+	assert.Equal(t, http.StatusServiceUnavailable, postEvent(handler, strings.NewReader(string(b))).Code)
+}
+
+func TestHandlerAsyncPollInvalidCorrelationId(t *testing.T) {
+	t.Parallel()
+
+	handler, _ := setupAsyncHandler(t, &v1alpha1.RequestReplyAsync{})
+
+	assert.Equal(t, http.StatusNotFound, pollReply(handler, "/default/my-request-reply/replies/1234567890:invalid:0").Code)
+}
+
+// asyncBroker receives the requests of the handler, and sends the reply to the last request when asked to
+type asyncBroker struct {
+	handler *IngressHandler
+	rr      *v1alpha1.RequestReply
+	events  chan *cloudevents.Event
+}
+
+func (b *asyncBroker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	event, err := cloudevents.NewEventFromHTTPRequest(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	b.events <- event
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (b *asyncBroker) reply(t *testing.T) {
+	var request *cloudevents.Event
+	select {
+	case request = <-b.events:
+	default:
+		t.Fatal("broker did not receive the request")
+	}
+
+	reply := cloudevents.NewEvent()
+	reply.SetType("reply")
+	reply.SetSource("service")
+	reply.SetID("reply-id")
+	reply.SetExtension(b.rr.Spec.ReplyAttribute, request.Extensions()[b.rr.Spec.CorrelationAttribute])
+
+	uri := "/" + b.rr.Namespace + "/" + b.rr.Name + "/reply"
+	req, _ := cloudevents.NewHTTPRequestFromEvent(context.Background(), uri, reply)
+	req.RequestURI = uri
+
+	recorder := httptest.NewRecorder()
+	b.handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusAccepted, recorder.Code)
+}
+
+func setupAsyncHandler(t *testing.T, async *v1alpha1.RequestReplyAsync) (*IngressHandler, *asyncBroker) {
+	ctx, _ := reconcilertesting.SetupFakeContext(t, setupInformerSelector)
+
+	rr := makeRequestReply("my-request-reply", "default", func(rr *v1alpha1.RequestReply) {
+		rr.Spec.Timeout = ptr.To("PT30S")
+		rr.Spec.Async = async
+		rr.Status.SetAddress(&duckv1.Addressable{URL: apis.HTTP("request-reply.knative-eventing.svc")})
+		rr.Status.Address.URL.Path = "/default/my-request-reply"
+	})
+
+	keyStore := &AESKeyStore{}
+	keyStore.addAesKey(rr.GetNamespacedName(), "key", exampleKey)
+
+	handler := NewHandler(zap.NewNop(), requestreplyinformerfake.Get(ctx), configmapinformerfake.Get(ctx).Lister().ConfigMaps("ns"), keyStore, 0, nil)
+
+	broker := &asyncBroker{
+		handler: handler,
+		rr:      rr,
+		events:  make(chan *cloudevents.Event, 10),
+	}
+	server := httptest.NewServer(broker)
+	t.Cleanup(server.Close)
+
+	rr.Status.Annotations[v1alpha1.RequestReplyBrokerAddressStatusAnnotationKey] = server.URL
+	requestreplyinformerfake.Get(ctx).Informer().GetStore().Add(rr)
+
+	return handler, broker
+}
+
+func postEvent(handler http.Handler, body io.Reader) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodPost, "/default/my-request-reply", body)
+	request.Header.Add(cehttp.ContentType, cloudevents.ApplicationCloudEventsJSON)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func pollReply(handler http.Handler, location string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodGet, location, nil)
+	// httptest sets the full location as request uri, while servers receive the path
+	request.RequestURI = request.URL.RequestURI()
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
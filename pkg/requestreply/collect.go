@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestreply
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"go.uber.org/zap"
+
+	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+)
+
+// collectReplies waits for the replies to the request until the expected number of replies arrived, the collection window
+// closed or the context is done, and writes the collected replies as a batch of cloudevents to the response writer of the request
+func (h *IngressHandler) collectReplies(ctx context.Context, pr *proxiedRequest, event *cloudevents.Event, rr *v1alpha1.RequestReply) {
+	defer h.deleteEvent(event, rr)
+
+	expected := expectedReplies(rr)
+
+	var window <-chan time.Time
+	if d := ParsePeriod(rr.Spec.Collect.Window, 0); d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		window = timer.C
+	}
+
+	replies := make([]*cloudevents.Event, 0, expected)
+
+collect:
+	for len(replies) < expected {
+		select {
+		case resp := <-pr.replyEvent:
+			replies = append(replies, resp)
+		case <-window:
+			break collect
+		case <-ctx.Done():
+			h.logger.Warn("context timeout reached before collecting all replies to the event", zap.String("event id", event.ID()), zap.Int("replies", len(replies)))
+			break collect
+		}
+	}
+
+	body, err := json.Marshal(replies)
+	if err != nil {
+		h.logger.Error("failed to marshal the collected replies", zap.Error(err))
+		pr.responseWriter.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	pr.responseWriter.Header().Set(cehttp.ContentType, cloudevents.ApplicationCloudEventsBatchJSON)
+	pr.responseWriter.WriteHeader(http.StatusOK)
+	if _, err := pr.responseWriter.Write(body); err != nil {
+		h.logger.Error("failed to send collected replies back", zap.Error(err))
+	}
+}
+
+// expectedReplies returns the number of replies collected for a request to the RequestReply, which is the maximum when
+// the replies are only collected for a window
+func expectedReplies(rr *v1alpha1.RequestReply) int {
+	if rr.Spec.Collect.Replies != nil {
+		return int(*rr.Spec.Collect.Replies)
+	}
+	return int(v1alpha1.MaxRequestReplyCollectedReplies)
+}
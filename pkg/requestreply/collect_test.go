@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestreply
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+	requestreplyinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/requestreply/fake"
+	configmapinformerfake "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/fake"
+	reconcilertesting "knative.dev/pkg/reconciler/testing"
+)
+
+func TestHandlerCollect(t *testing.T) {
+	t.Parallel()
+
+	tt := map[string]struct {
+		collect     *v1alpha1.RequestReplyCollect
+		replies     int
+		wantReplies int
+	}{
+		"expected number of replies": {
+			collect:     &v1alpha1.RequestReplyCollect{Replies: ptr.To(int32(2))},
+			replies:     3,
+			wantReplies: 2,
+		},
+		"collection window": {
+			collect:     &v1alpha1.RequestReplyCollect{Window: ptr.To("PT0.2S")},
+			replies:     3,
+			wantReplies: 3,
+		},
+		"collection window before expected number of replies": {
+			collect:     &v1alpha1.RequestReplyCollect{Replies: ptr.To(int32(5)), Window: ptr.To("PT0.2S")},
+			replies:     1,
+			wantReplies: 1,
+		},
+		"no replies": {
+			collect:     &v1alpha1.RequestReplyCollect{Window: ptr.To("PT0.2S")},
+			wantReplies: 0,
+		},
+	}
+
+	for testName, testCase := range tt {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, _ := reconcilertesting.SetupFakeContext(t, setupInformerSelector)
+
+			rr := makeRequestReply("my-request-reply", "default", func(rr *v1alpha1.RequestReply) {
+				rr.Spec.Collect = testCase.collect
+			})
+
+			keyStore := &AESKeyStore{}
+			keyStore.addAesKey(rr.GetNamespacedName(), "key", exampleKey)
+
+			handler := NewHandler(zap.NewNop(), requestreplyinformerfake.Get(ctx), configmapinformerfake.Get(ctx).Lister().ConfigMaps("ns"), keyStore, 0, nil)
+
+			broker := httptest.NewServer(&fanOutServerHandler{
+				t:       t,
+				handler: handler,
+				rr:      rr,
+				replies: testCase.replies,
+			})
+			defer broker.Close()
+
+			rr.Status.Annotations[v1alpha1.RequestReplyBrokerAddressStatusAnnotationKey] = broker.URL
+			requestreplyinformerfake.Get(ctx).Informer().GetStore().Add(rr)
+
+			recorder := postEvent(handler, getValidEvent())
+			require.Equal(t, http.StatusOK, recorder.Code)
+			assert.Equal(t, cloudevents.ApplicationCloudEventsBatchJSON, recorder.Header().Get(cehttp.ContentType))
+
+			var replies []cloudevents.Event
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &replies))
+			assert.Len(t, replies, testCase.wantReplies)
+			for i, reply := range replies {
+				assert.Equal(t, fmt.Sprintf("reply-%d", i), reply.ID())
+			}
+		})
+	}
+}
+
+// fanOutServerHandler replies to every request with the given number of replies
+type fanOutServerHandler struct {
+	t       *testing.T
+	handler http.Handler
+	rr      *v1alpha1.RequestReply
+	replies int
+}
+
+func (ts *fanOutServerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	request, err := cloudevents.NewEventFromHTTPRequest(r)
+	assert.NoError(ts.t, err, "should successfully decode event from forwarded request")
+
+	uri := fmt.Sprintf("/%s/%s/reply", ts.rr.Namespace, ts.rr.Name)
+	for i := range ts.replies {
+		reply := cloudevents.NewEvent()
+		reply.SetType("reply")
+		reply.SetSource("service")
+		reply.SetID(fmt.Sprintf("reply-%d", i))
+		reply.SetExtension(ts.rr.Spec.ReplyAttribute, request.Extensions()[ts.rr.Spec.CorrelationAttribute])
+
+		req, _ := cloudevents.NewHTTPRequestFromEvent(context.Background(), uri, reply)
+		req.RequestURI = uri
+
+		recorder := httptest.NewRecorder()
+		ts.handler.ServeHTTP(recorder, req)
+		assert.Equal(ts.t, http.StatusAccepted, recorder.Code)
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func TestAddEventSizesReplyChannel(t *testing.T) {
+	tt := map[string]struct {
+		collect *v1alpha1.RequestReplyCollect
+		want    int
+	}{
+		"no collection": {
+			want: 1,
+		},
+		"expected number of replies": {
+			collect: &v1alpha1.RequestReplyCollect{Replies: ptr.To(int32(5))},
+			want:    5,
+		},
+		"collection window": {
+			collect: &v1alpha1.RequestReplyCollect{Window: ptr.To("PT1S")},
+			want:    int(v1alpha1.MaxRequestReplyCollectedReplies),
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			h := &IngressHandler{entries: make(map[types.NamespacedName]map[string]*proxiedRequest)}
+			rr := &v1alpha1.RequestReply{Spec: v1alpha1.RequestReplySpec{Collect: tc.collect}}
+
+			event := cloudevents.NewEvent()
+			event.SetID("1234567890")
+			pr := h.addEvent(httptest.NewRecorder(), &event, rr)
+
+			assert.Equal(t, tc.want, cap(pr.replyEvent))
+		})
+	}
+}
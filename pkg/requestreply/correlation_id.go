@@ -23,6 +23,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
@@ -38,7 +39,9 @@ import (
  The format of the correlationid/replyid attribute is: <original event id>:<base64 encoding of AES encrypted original event id>:<idx>
 
  The AES encryption of the original id is done to ensure that the correlation id was created by the RequestReply resource, rather than a
- third party. The idx is used for routing to ensure reply events do not overwhelm pods
+ third party. The idx is the index of the replica holding the original request, it is used for routing to ensure reply events do not
+ overwhelm pods, and for forwarding reply events to that replica when they are received by another one. The idx is authenticated as
+ additional data of the AES encryption, so that it cannot be changed without invalidating the correlation id.
 */
 
 // VerifyReplyId takes the reply id from a cloudevent and checks that it is valid for this RequestReply resource, by checking that the decrypted id matches the unencrypted id
@@ -50,6 +53,7 @@ func VerifyReplyId(replyId string, aesKey []byte) (bool, error) {
 
 	originalId := parts[0]
 	encryptedId := parts[1]
+	idx := parts[2]
 
 	encryptedBytes, err := base64.URLEncoding.DecodeString(encryptedId)
 	if err != nil {
@@ -71,7 +75,7 @@ func VerifyReplyId(replyId string, aesKey []byte) (bool, error) {
 	}
 
 	nonce, cipherText := encryptedBytes[:gcm.NonceSize()], encryptedBytes[gcm.NonceSize():]
-	decryptedId, err := gcm.Open(nil, nonce, cipherText, nil)
+	decryptedId, err := gcm.Open(nil, nonce, cipherText, []byte(idx))
 	if err != nil {
 		return false, fmt.Errorf("failed to decrypt the data: %w", err)
 	}
@@ -101,11 +105,29 @@ func SetCorrelationId(ce *cloudevents.Event, correlationIdName string, aesKey []
 		return fmt.Errorf("failed to read random data for gcm nonce: %w", err)
 	}
 
-	encryptedIdBytes := gcm.Seal(nonce, nonce, idBytes, nil)
+	idxString := strconv.Itoa(idx)
+
+	encryptedIdBytes := gcm.Seal(nonce, nonce, idBytes, []byte(idxString))
 
 	encryptedId := base64.URLEncoding.EncodeToString(encryptedIdBytes)
 
-	ce.SetExtension(correlationIdName, fmt.Sprintf("%s:%s:%d", id, encryptedId, idx))
+	ce.SetExtension(correlationIdName, fmt.Sprintf("%s:%s:%s", id, encryptedId, idxString))
 
 	return nil
 }
+
+// ReplyIdOwner returns the index of the replica which set the correlation id the reply id was copied from. The reply id should be verified
+// with VerifyReplyId first, as the index is only authenticated by the encryption
+func ReplyIdOwner(replyId string) (int, error) {
+	parts := strings.Split(replyId, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("expected three parts in the replyid attribute, had %d", len(parts))
+	}
+
+	idx, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse the replica index in replyid: %w", err)
+	}
+
+	return idx, nil
+}
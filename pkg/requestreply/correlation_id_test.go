@@ -72,6 +72,16 @@ func TestCorrelationIdVerification(t *testing.T) {
 			},
 			expectValid: false,
 		},
+		"tampered replica index": {
+			encryptionKey: exampleKey,
+			decryptionKey: exampleKey,
+			transformEvent: func(ce *cloudevents.Event) {
+				correlationId := ce.Extensions()["correlationid"]
+				parts := strings.Split(correlationId.(string), ":")
+				ce.SetExtension("replyid", fmt.Sprintf("%s:%s:1", parts[0], parts[1]))
+			},
+			expectDecryptionError: true,
+		},
 		"different correlationid and replyid attribute names": {
 			encryptionKey:     exampleKey,
 			decryptionKey:     exampleKey,
@@ -123,3 +133,21 @@ func TestCorrelationIdVerification(t *testing.T) {
 	t.Parallel()
 
 }
+
+func TestReplyIdOwner(t *testing.T) {
+	ce := cloudevents.NewEvent()
+	ce.SetID("exampleid")
+
+	err := SetCorrelationId(&ce, "correlationid", exampleKey, 3)
+	assert.NoError(t, err, "setting correlationid should not fail")
+
+	owner, err := ReplyIdOwner(ce.Extensions()["correlationid"].(string))
+	assert.NoError(t, err, "getting the owner should not fail")
+	assert.Equal(t, 3, owner)
+
+	_, err = ReplyIdOwner("exampleid:encrypted:notanumber")
+	assert.Error(t, err, "getting the owner of a non numeric index should fail")
+
+	_, err = ReplyIdOwner("exampleid")
+	assert.Error(t, err, "getting the owner of a malformed replyid should fail")
+}
@@ -40,22 +40,41 @@ import (
 	"knative.dev/eventing/pkg/utils"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/network"
 )
 
 const (
 	defaultMaxIdleConnections        = 1000
 	defaultMaxIdleConnectionsPerHost = 1000
+
+	// ForwardedReplyHeader is set on reply events forwarded by a replica to the replica holding the original request
+	ForwardedReplyHeader = "Knative-RequestReply-Forwarded"
 )
 
+// ReplicaAddressFunc returns the address of the data plane replica with the given index
+type ReplicaAddressFunc func(idx int) *apis.URL
+
+// StatefulSetReplicaAddress returns a ReplicaAddressFunc which addresses the pods of the statefulset through the headless service governing it
+func StatefulSetReplicaAddress(statefulSetName, serviceName, namespace string, port int) ReplicaAddressFunc {
+	return func(idx int) *apis.URL {
+		host := network.GetServiceHostname(fmt.Sprintf("%s-%d.%s", statefulSetName, idx, serviceName), namespace)
+		return apis.HTTP(fmt.Sprintf("%s:%d", host, port))
+	}
+}
+
 type IngressHandler struct {
 	dispatcher         *kncloudevents.Dispatcher
 	logger             *zap.Logger
 	requestReplyLister eventingv1alpha1listers.RequestReplyLister
 	podIdx             int
+	replicaAddress     ReplicaAddressFunc
 	keyStore           *AESKeyStore
 
 	requestLock sync.RWMutex
 	entries     map[types.NamespacedName]map[string]*proxiedRequest
+
+	asyncLock    sync.Mutex
+	asyncEntries map[types.NamespacedName]map[string]*asyncRequest
 }
 
 type proxiedRequest struct {
@@ -64,7 +83,7 @@ type proxiedRequest struct {
 	replyEvent     chan *cloudevents.Event
 }
 
-func NewHandler(logger *zap.Logger, requestReplyInformer eventingv1alpha1informers.RequestReplyInformer, trustBundleConfigMapLister corev1listers.ConfigMapNamespaceLister, keyStore *AESKeyStore, podIdx int) *IngressHandler {
+func NewHandler(logger *zap.Logger, requestReplyInformer eventingv1alpha1informers.RequestReplyInformer, trustBundleConfigMapLister corev1listers.ConfigMapNamespaceLister, keyStore *AESKeyStore, podIdx int, replicaAddress ReplicaAddressFunc) *IngressHandler {
 	connectionArgs := kncloudevents.ConnectionArgs{
 		MaxIdleConns:        defaultMaxIdleConnections,
 		MaxIdleConnsPerHost: defaultMaxIdleConnectionsPerHost,
@@ -94,15 +113,17 @@ func NewHandler(logger *zap.Logger, requestReplyInformer eventingv1alpha1informe
 		dispatcher:         kncloudevents.NewDispatcher(clientConfig, nil),
 		requestReplyLister: requestReplyInformer.Lister(),
 		podIdx:             podIdx,
+		replicaAddress:     replicaAddress,
 		keyStore:           keyStore,
 
-		entries: make(map[types.NamespacedName]map[string]*proxiedRequest),
+		entries:      make(map[types.NamespacedName]map[string]*proxiedRequest),
+		asyncEntries: make(map[types.NamespacedName]map[string]*asyncRequest),
 	}
 
 }
 
 func (h *IngressHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
-	w.Header().Set("Allow", "POST, OPTIONS")
+	w.Header().Set("Allow", "GET, POST, OPTIONS")
 	// validate request method
 	if req.Method == http.MethodOptions {
 		w.Header().Set("WebHook-Allowed-Origin", "*") // Accept from any Origin
@@ -110,7 +131,7 @@ func (h *IngressHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		return
 	}
-	if req.Method != http.MethodPost {
+	if req.Method != http.MethodPost && req.Method != http.MethodGet {
 		h.logger.Warn("unexpected request method", zap.String("method", req.Method))
 		w.WriteHeader(http.StatusMethodNotAllowed)
 		return
@@ -122,13 +143,40 @@ func (h *IngressHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 	nsRequestReplyName := strings.Split(strings.TrimSuffix(req.RequestURI, "/"), "/")
-	if len(nsRequestReplyName) < 3 || len(nsRequestReplyName) > 4 {
+	if len(nsRequestReplyName) < 3 || len(nsRequestReplyName) > 5 {
 		h.logger.Info("Malformed uri", zap.String("uri", req.RequestURI))
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
 
 	isReplyEvent := len(nsRequestReplyName) == 4 && nsRequestReplyName[3] == "reply"
+	isReplyPoll := len(nsRequestReplyName) == 5 && nsRequestReplyName[3] == "replies"
+
+	// only the replies of asynchronous requests can be polled
+	if req.Method == http.MethodGet && !isReplyPoll {
+		h.logger.Warn("unexpected request method", zap.String("method", req.Method))
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if req.Method == http.MethodPost && isReplyPoll {
+		h.logger.Warn("unexpected request method", zap.String("method", req.Method))
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	requestReplyNs, requestReplyName := nsRequestReplyName[1], nsRequestReplyName[2]
+
+	if isReplyPoll {
+		requestReply, err := h.getRequestReply(requestReplyName, requestReplyNs)
+		if err != nil {
+			h.logger.Warn("failed to retrieve RequestReply", zap.Error(err))
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		h.handleReplyPoll(req.Context(), w, requestReply, nsRequestReplyName[4], req.Header.Get(ForwardedReplyHeader) != "")
+		return
+	}
 
 	// extract event from request
 	message := cehttp.NewMessageFromHttpRequest(req)
@@ -150,7 +198,6 @@ func (h *IngressHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	requestReplyNs, requestReplyName := nsRequestReplyName[1], nsRequestReplyName[2]
 	requestReply, err := h.getRequestReply(requestReplyName, requestReplyNs)
 	if err != nil {
 		h.logger.Warn("failed to retrieve RequestReply", zap.Error(err))
@@ -162,7 +209,9 @@ func (h *IngressHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	defer cancel()
 
 	if isReplyEvent {
-		h.handleReplyEvent(w, event, requestReply)
+		h.handleReplyEvent(ctx, w, event, requestReply, req.Header.Get(ForwardedReplyHeader) != "")
+	} else if requestReply.Spec.Async != nil {
+		h.handleNewAsyncEvent(ctx, w, event, requestReply, utils.PassThroughHeaders(req.Header))
 	} else {
 		h.handleNewEvent(ctx, w, event, requestReply, utils.PassThroughHeaders(req.Header))
 	}
@@ -212,10 +261,15 @@ func (h *IngressHandler) addEvent(responseWriter http.ResponseWriter, event *clo
 	defer h.requestLock.Unlock()
 
 	id := event.ID()
+	replies := 1
+	if rr.Spec.Collect != nil {
+		replies = expectedReplies(rr)
+	}
+
 	pr := &proxiedRequest{
 		received:       time.Now(),
 		responseWriter: responseWriter,
-		replyEvent:     make(chan *cloudevents.Event, 1),
+		replyEvent:     make(chan *cloudevents.Event, replies),
 	}
 	if h.entries[rr.GetNamespacedName()] == nil {
 		h.entries[rr.GetNamespacedName()] = make(map[string]*proxiedRequest)
@@ -269,6 +323,11 @@ func (h *IngressHandler) handleNewEvent(ctx context.Context, responseWriter http
 		return
 	}
 
+	if rr.Spec.Collect != nil {
+		h.collectReplies(ctx, pr, event, rr)
+		return
+	}
+
 	for {
 		select {
 		case resp := <-pr.replyEvent:
@@ -288,10 +347,7 @@ func (h *IngressHandler) handleNewEvent(ctx context.Context, responseWriter http
 	}
 }
 
-func (h *IngressHandler) handleReplyEvent(responseWriter http.ResponseWriter, event *cloudevents.Event, rr *v1alpha1.RequestReply) {
-	h.requestLock.RLock()
-	defer h.requestLock.RUnlock()
-
+func (h *IngressHandler) handleReplyEvent(ctx context.Context, responseWriter http.ResponseWriter, event *cloudevents.Event, rr *v1alpha1.RequestReply, forwarded bool) {
 	h.logger.Debug("handling a response event")
 
 	// TODO: with OIDC enabled, we can skip validation of the key if we validate the identity of the trigger making the request
@@ -317,34 +373,92 @@ func (h *IngressHandler) handleReplyEvent(responseWriter http.ResponseWriter, ev
 		return
 	}
 
-	validReply := false
-	for _, key := range allKeys {
-		valid, err := VerifyReplyId(replyIdString, key)
+	validReply := h.verifyReplyId(replyIdString, allKeys)
+	if !validReply {
+		h.logger.Warn("received invalid reply event")
+		responseWriter.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	owner, err := ReplyIdOwner(replyIdString)
+	if err != nil {
+		h.logger.Warn("failed to get the replica owning the reply event", zap.Error(err))
+		responseWriter.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	// the original request is held by another replica, unless the reply event was already forwarded by another replica,
+	// in which case it is not forwarded again to prevent loops
+	if owner != h.podIdx && !forwarded {
+		h.forwardReplyEvent(ctx, responseWriter, event, rr, owner)
+		return
+	}
+
+	responseWriter.WriteHeader(http.StatusAccepted)
+
+	id := strings.Split(replyIdString, ":")[0]
+	if h.replyToEvent(rr, id, event) || h.replyToAsyncEvent(rr, id, event) {
+		return
+	}
+
+	h.logger.Warn("no event found matching the reply id, discarding event", zap.String("reply id", id))
+}
+
+// replyToEvent sends the reply event back to the original response writer of the request with the id, if it is waiting
+func (h *IngressHandler) replyToEvent(rr *v1alpha1.RequestReply, id string, event *cloudevents.Event) bool {
+	h.requestLock.RLock()
+	defer h.requestLock.RUnlock()
+
+	pr, ok := h.entries[rr.GetNamespacedName()][id]
+	if !ok {
+		return false
+	}
+
+	select {
+	case pr.replyEvent <- event:
+	default:
+		h.logger.Warn("the request already received all the replies it can hold, discarding event", zap.String("reply id", id))
+	}
+	return true
+}
+
+// verifyReplyId checks whether the reply id is valid for any of the keys
+func (h *IngressHandler) verifyReplyId(replyId string, keys [][]byte) bool {
+	for _, key := range keys {
+		valid, err := VerifyReplyId(replyId, key)
 		if err != nil {
 			h.logger.Warn("ran into an error validating replyid", zap.Error(err))
 			continue
 		}
 		if valid {
-			validReply = true
-			break
+			return true
 		}
 	}
+	return false
+}
 
-	if !validReply {
-		h.logger.Warn("received invalid reply event")
+// forwardReplyEvent forwards the reply event to the replica holding the original request
+func (h *IngressHandler) forwardReplyEvent(ctx context.Context, responseWriter http.ResponseWriter, event *cloudevents.Event, rr *v1alpha1.RequestReply, owner int) {
+	if h.replicaAddress == nil {
+		h.logger.Warn("received reply event for another replica, but forwarding is not configured", zap.Int("owner", owner))
 		responseWriter.WriteHeader(http.StatusBadRequest)
 		return
 	}
 
-	responseWriter.WriteHeader(http.StatusAccepted)
+	url := h.replicaAddress(owner)
+	url.Path = fmt.Sprintf("/%s/%s/reply", rr.GetNamespace(), rr.GetName())
 
-	id := strings.Split(replyIdString, ":")[0]
-	pr, ok := h.entries[rr.GetNamespacedName()][id]
-	if !ok {
-		h.logger.Warn("no event found matching the reply id, discarding event", zap.String("reply id", id))
+	h.logger.Debug("forwarding reply event to owning replica", zap.Int("owner", owner), zap.Stringer("url", url))
+
+	headers := http.Header{}
+	headers.Set(ForwardedReplyHeader, "true")
+
+	_, err := h.dispatcher.SendEvent(ctx, *event, duckv1.Addressable{URL: url}, kncloudevents.WithHeader(headers))
+	if err != nil {
+		h.logger.Error("failed to forward reply event to owning replica", zap.Int("owner", owner), zap.Error(err))
+		responseWriter.WriteHeader(http.StatusBadGateway)
 		return
 	}
 
-	// send the reply event back to the original response writer
-	pr.replyEvent <- event
+	responseWriter.WriteHeader(http.StatusAccepted)
 }
@@ -35,9 +35,11 @@ import (
 	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
 	requestreplyinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/requestreply/fake"
 	"knative.dev/eventing/pkg/eventingtls"
+	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	configmapinformerfake "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/fake"
 	filteredFactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
+	"knative.dev/pkg/network"
 	reconcilertesting "knative.dev/pkg/reconciler/testing"
 )
 
@@ -198,7 +200,7 @@ func TestHandlerServeHttp(t *testing.T) {
 				}
 			}
 
-			handler := NewHandler(logger, requestreplyinformerfake.Get(ctx), configmapinformerfake.Get(ctx).Lister().ConfigMaps("ns"), keyStore, 0)
+			handler := NewHandler(logger, requestreplyinformerfake.Get(ctx), configmapinformerfake.Get(ctx).Lister().ConfigMaps("ns"), keyStore, 0, nil)
 
 			testHandler.callbackHandler = handler
 
@@ -210,11 +212,98 @@ func TestHandlerServeHttp(t *testing.T) {
 	}
 }
 
+func TestHandlerForwardsReplyToOwner(t *testing.T) {
+	t.Parallel()
+
+	ctx, _ := reconcilertesting.SetupFakeContext(t, setupInformerSelector)
+
+	rr := makeRequestReply("my-request-reply", "default")
+
+	keyStore := &AESKeyStore{}
+	keyStore.addAesKey(rr.GetNamespacedName(), "key", exampleKey)
+
+	owner := NewHandler(zap.NewNop(), requestreplyinformerfake.Get(ctx), configmapinformerfake.Get(ctx).Lister().ConfigMaps("ns"), keyStore, 0, nil)
+	ownerServer := httptest.NewServer(owner)
+	defer ownerServer.Close()
+
+	replicaAddress := func(idx int) *apis.URL {
+		assert.Equal(t, 0, idx, "reply should be forwarded to the owning replica")
+		u, _ := apis.ParseURL(ownerServer.URL)
+		return u
+	}
+	other := NewHandler(zap.NewNop(), requestreplyinformerfake.Get(ctx), configmapinformerfake.Get(ctx).Lister().ConfigMaps("ns"), keyStore, 1, replicaAddress)
+
+	// the broker delivers the reply to the replica which is not holding the original request
+	broker := &testServerHandler{
+		makeReplyEvent:  copyCorrelationIdToReplyID("correlationid", "replyid"),
+		callbackHandler: other,
+		uri:             "/default/my-request-reply",
+		t:               t,
+	}
+	brokerServer := httptest.NewServer(broker)
+	defer brokerServer.Close()
+
+	rr.Status.Annotations[v1alpha1.RequestReplyBrokerAddressStatusAnnotationKey] = brokerServer.URL
+	requestreplyinformerfake.Get(ctx).Informer().GetStore().Add(rr)
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/default/my-request-reply", getValidEvent())
+	request.Header.Add(cehttp.ContentType, cloudevents.ApplicationCloudEventsJSON)
+
+	owner.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+	assert.Equal(t, http.StatusAccepted, broker.replyStatusCode)
+}
+
+func TestHandlerDoesNotForwardForwardedReply(t *testing.T) {
+	t.Parallel()
+
+	ctx, _ := reconcilertesting.SetupFakeContext(t, setupInformerSelector)
+
+	rr := makeRequestReply("my-request-reply", "default")
+	requestreplyinformerfake.Get(ctx).Informer().GetStore().Add(rr)
+
+	keyStore := &AESKeyStore{}
+	keyStore.addAesKey(rr.GetNamespacedName(), "key", exampleKey)
+
+	replicaAddress := func(idx int) *apis.URL {
+		t.Errorf("forwarded reply should not be forwarded again to replica %d", idx)
+		return apis.HTTP("localhost")
+	}
+	handler := NewHandler(zap.NewNop(), requestreplyinformerfake.Get(ctx), configmapinformerfake.Get(ctx).Lister().ConfigMaps("ns"), keyStore, 1, replicaAddress)
+
+	reply := cloudevents.NewEvent()
+	reply.SetType("type")
+	reply.SetSource("source")
+	reply.SetID("1234567890")
+	assert.NoError(t, SetCorrelationId(&reply, "replyid", exampleKey, 0))
+
+	request, _ := cloudevents.NewHTTPRequestFromEvent(context.Background(), "/default/my-request-reply/reply", reply)
+	request.RequestURI = "/default/my-request-reply/reply"
+	request.Header.Set(ForwardedReplyHeader, "true")
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusAccepted, recorder.Result().StatusCode)
+}
+
+func TestStatefulSetReplicaAddress(t *testing.T) {
+	address := StatefulSetReplicaAddress("request-reply", "request-reply-replicas", "knative-eventing", 8080)
+
+	got := address(2).String()
+	want := "http://request-reply-2.request-reply-replicas.knative-eventing.svc." + network.GetClusterDomainName() + ":8080"
+	assert.Equal(t, want, got)
+}
+
 type testServerHandler struct {
 	makeReplyEvent  func(e *cloudevents.Event) *cloudevents.Event
 	callbackHandler http.Handler
 	uri             string
 	t               *testing.T
+
+	replyStatusCode int
 }
 
 func (ts *testServerHandler) ServeHTTP(response http.ResponseWriter, request *http.Request) {
@@ -231,6 +320,7 @@ func (ts *testServerHandler) ServeHTTP(response http.ResponseWriter, request *ht
 	replyRequest.RequestURI = ts.uri + "/reply"
 	recorder := httptest.NewRecorder()
 	ts.callbackHandler.ServeHTTP(recorder, replyRequest)
+	ts.replyStatusCode = recorder.Code
 }
 
 func setupInformerSelector(ctx context.Context) context.Context {
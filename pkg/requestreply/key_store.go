@@ -20,14 +20,41 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 
 	"github.com/fsnotify/fsnotify"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
 )
 
+// KeySecretLabelSelector selects the secrets holding the AES keys of the RequestReply resources
+const KeySecretLabelSelector = "eventing.knative.dev/part-of=request-reply"
+
+// LegacyKeySecretName is the name of the secret which held the AES keys of all the RequestReply resources before they
+// got a secret each. Its keys are migrated by the controller and ignored by the data plane, as they share their names
+// with the migrated keys.
+const LegacyKeySecretName = "request-reply-keys" // #nosec G101 -- This is a hardcoded secret name, not a credential
+
+// KeyGeneration returns the generation of the AES key with the name, which is either <namespace>.<name>.key-<generation>
+// or key-<generation>
+func KeyGeneration(keyName string) (int64, bool) {
+	keyName = keyName[strings.LastIndex(keyName, ".")+1:]
+	if !strings.HasPrefix(keyName, "key-") {
+		return 0, false
+	}
+
+	generation, err := strconv.ParseInt(strings.TrimPrefix(keyName, "key-"), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return generation, true
+}
+
 type requestReplyAesKeyStore struct {
 	lock      sync.RWMutex
 	newestKey []byte
@@ -38,14 +65,12 @@ func (ks *requestReplyAesKeyStore) addAesKey(keyName string, keyValue []byte) {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
 
-	ks.newestKey = keyValue
-
 	if ks.entries == nil {
-		ks.entries = map[string][]byte{keyName: keyValue}
-		return
+		ks.entries = make(map[string][]byte)
 	}
 
 	ks.entries[keyName] = keyValue
+	ks.updateNewestKey()
 }
 
 func (ks *requestReplyAesKeyStore) removeAesKey(keyName string) {
@@ -53,6 +78,25 @@ func (ks *requestReplyAesKeyStore) removeAesKey(keyName string) {
 	defer ks.lock.Unlock()
 
 	delete(ks.entries, keyName)
+	ks.updateNewestKey()
+}
+
+// updateNewestKey sets the newest key to the key with the highest generation, or to the only key if the keys have no generation
+func (ks *requestReplyAesKeyStore) updateNewestKey() {
+	ks.newestKey = nil
+
+	newestGeneration := int64(-1)
+	for keyName, keyValue := range ks.entries {
+		generation, ok := KeyGeneration(keyName)
+		if !ok {
+			generation = -1
+		}
+
+		if ks.newestKey == nil || generation > newestGeneration {
+			ks.newestKey = keyValue
+			newestGeneration = generation
+		}
+	}
 }
 
 func (ks *requestReplyAesKeyStore) getLatestKey() []byte {
@@ -126,6 +170,71 @@ func (ks *AESKeyStore) GetAllKeys(rrName types.NamespacedName) ([][]byte, bool)
 	return ks.keyStores[rrName].getAllKeys(), true
 }
 
+// SecretEventHandler returns an event handler which keeps the keys in sync with the keys held by the secrets, whose keys
+// are named <namespace>.<name>.key-<generation> after the RequestReply resource they belong to
+func (ks *AESKeyStore) SecretEventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			if secret, ok := obj.(*corev1.Secret); ok {
+				ks.updateSecret(nil, secret)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			oldSecret, _ := oldObj.(*corev1.Secret)
+			if secret, ok := newObj.(*corev1.Secret); ok {
+				ks.updateSecret(oldSecret, secret)
+			}
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if secret, ok := obj.(*corev1.Secret); ok {
+				ks.updateSecret(secret, nil)
+			}
+		},
+	}
+}
+
+// updateSecret adds the keys of the new secret, and removes the keys of the old secret which the new secret no longer holds
+func (ks *AESKeyStore) updateSecret(oldSecret, newSecret *corev1.Secret) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+
+	if oldSecret != nil && oldSecret.Name == LegacyKeySecretName {
+		oldSecret = nil
+	}
+	if newSecret != nil && newSecret.Name == LegacyKeySecretName {
+		newSecret = nil
+	}
+
+	if newSecret != nil {
+		for fileName, keyValue := range newSecret.Data {
+			rrNsName, keyName, err := parseFileName(fileName)
+			if err != nil {
+				continue
+			}
+			ks.addAesKey(rrNsName, keyName, keyValue)
+		}
+	}
+
+	if oldSecret != nil {
+		for fileName := range oldSecret.Data {
+			if newSecret != nil {
+				if _, ok := newSecret.Data[fileName]; ok {
+					continue
+				}
+			}
+
+			rrNsName, keyName, err := parseFileName(fileName)
+			if err != nil {
+				continue
+			}
+			ks.removeAesKey(rrNsName, keyName)
+		}
+	}
+}
+
 func (ks *AESKeyStore) StopWatch() {
 	ks.done <- true
 	close(ks.done)
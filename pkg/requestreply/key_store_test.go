@@ -24,6 +24,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -188,3 +190,26 @@ func TestKeyStoreWatch(t *testing.T) {
 	}
 
 }
+
+func TestKeyStoreIgnoresLegacySecret(t *testing.T) {
+	ks := &AESKeyStore{Logger: zap.NewNop().Sugar()}
+	handler := ks.SecretEventHandler()
+	rr := types.NamespacedName{Namespace: "default", Name: "request-reply"}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "request-reply-keys-uid"},
+		Data:       map[string][]byte{"default.request-reply.key-0": exampleKey},
+	}
+	legacy := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: LegacyKeySecretName},
+		Data:       map[string][]byte{"default.request-reply.key-0": otherKey},
+	}
+
+	handler.OnAdd(secret, false)
+	handler.OnAdd(legacy, false)
+	handler.OnDelete(legacy)
+
+	got, ok := ks.GetAllKeys(rr)
+	assert.True(t, ok, "should have secrets in the store")
+	assert.ElementsMatch(t, [][]byte{exampleKey}, got)
+}
@@ -0,0 +1,421 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamsink
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	ws "github.com/gorilla/websocket"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/logging"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/apis/feature"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/auth"
+	sinkslister "knative.dev/eventing/pkg/client/listers/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/eventfilter"
+	"knative.dev/eventing/pkg/eventfilter/subscriptionsapi"
+	"knative.dev/eventing/pkg/observability"
+	eventingtracing "knative.dev/eventing/pkg/tracing"
+)
+
+const (
+	// WebSocketSubprotocol is the WebSocket subprotocol of the StreamSinks,
+	// sending the events in the JSON event format.
+	WebSocketSubprotocol = "cloudevents.json"
+
+	// TokenSubprotocolPrefix prefixes the WebSocket subprotocol holding the
+	// base64url encoded token of the clients which can't set the
+	// Authorization header, like browsers. These clients request both this
+	// subprotocol and WebSocketSubprotocol, which is the one selected.
+	TokenSubprotocolPrefix = "base64url.bearer.authorization.knative.dev."
+
+	// FilterQueryParameter is the query parameter holding a CESQL expression
+	// the events sent to a client must match. It can be repeated, in which
+	// case the events must match all the expressions.
+	FilterQueryParameter = "filter"
+
+	// keepAliveInterval is the interval between the keep-alive messages, SSE
+	// comments or WebSocket pings, sent to the clients.
+	keepAliveInterval = 30 * time.Second
+
+	// writeTimeout is how long writing a message to a WebSocket client can
+	// take.
+	writeTimeout = 10 * time.Second
+)
+
+// Handler handles the requests to the StreamSinks, served at
+// /<namespace>/<name>. Events are sent to a StreamSink with a POST, while
+// clients connect with a GET, either accepting text/event-stream to receive
+// the events as Server-Sent Events or upgrading the connection to a
+// WebSocket. In both cases the events are sent in the JSON event format.
+type Handler struct {
+	lister       sinkslister.StreamSinkLister
+	authVerifier verifier
+	withContext  func(ctx context.Context) context.Context
+	hub          *Hub
+	upgrader     ws.Upgrader
+}
+
+// verifier authenticates and authorizes the senders and the clients of the
+// StreamSinks. It is implemented by auth.Verifier.
+type verifier interface {
+	VerifyRequest(ctx context.Context, features feature.Flags, requiredOIDCAudience *string, resourceNamespace string, policyRefs []eventingduckv1.AppliedEventPolicyRef, req *http.Request, resp http.ResponseWriter) error
+	VerifySubscriber(ctx context.Context, features feature.Flags, requiredOIDCAudience *string, resourceNamespace string, allowedSubjects []string, token string) (int, error)
+}
+
+// NewHandler creates a Handler for the StreamSinks listed by lister.
+func NewHandler(lister sinkslister.StreamSinkLister, authVerifier *auth.Verifier, withContext func(ctx context.Context) context.Context) *Handler {
+	return &Handler{
+		lister:       lister,
+		authVerifier: authVerifier,
+		withContext:  withContext,
+		hub:          NewHub(),
+		upgrader: ws.Upgrader{
+			Subprotocols: []string{WebSocketSubprotocol},
+			CheckOrigin:  sameOrigin,
+		},
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := h.withContext(r.Context())
+	logger := logging.FromContext(ctx).Desugar()
+
+	ctx = observability.WithRequestLabels(ctx, r)
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) != 2 {
+		logger.Info("Malformed uri", zap.String("path", r.URL.Path))
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	ref := types.NamespacedName{
+		Namespace: parts[0],
+		Name:      parts[1],
+	}
+
+	ctx = observability.WithSinkLabels(ctx, ref, "StreamSink")
+
+	ss, err := h.lister.StreamSinks(ref.Namespace).Get(ref.Name)
+	if err != nil {
+		logger.Info("Failed to retrieve streamsink", zap.String("ref", ref.String()), zap.Error(err))
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		h.handleEvent(ctx, w, r, ss)
+	case http.MethodGet:
+		h.handleClient(ctx, w, r, ss)
+	default:
+		logger.Info("Unexpected HTTP method", zap.String("method", r.Method))
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// handleEvent publishes the event sent in r to the clients of ss.
+func (h *Handler) handleEvent(ctx context.Context, w http.ResponseWriter, r *http.Request, ss *sinksv1alpha1.StreamSink) {
+	logger := logging.FromContext(ctx).Desugar()
+
+	err := h.authVerifier.VerifyRequest(ctx, feature.FromContext(ctx), audience(ss), ss.Namespace, ss.Status.Policies, r, w)
+	if err != nil {
+		logger.Warn("Failed to verify AuthN and AuthZ.", zap.Error(err))
+		return
+	}
+
+	message := cehttp.NewMessageFromHttpRequest(r)
+	defer message.Finish(nil)
+
+	event, err := binding.ToEvent(ctx, message, eventingtracing.PopulateCEDistributedTracing(ctx))
+	if err != nil {
+		logger.Warn("failed to extract event from request", zap.Error(err))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err := event.Validate(); err != nil {
+		logger.Info("failed to validate event from request", zap.Error(err))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	sent := h.hub.Publish(ctx, types.NamespacedName{Namespace: ss.Namespace, Name: ss.Name}, event)
+	logger.Debug("Published event", zap.String("id", event.ID()), zap.Int("clients", sent))
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// handleClient authenticates and authorizes the client sending r, then
+// streams the events sent to ss until the client disconnects.
+func (h *Handler) handleClient(ctx context.Context, w http.ResponseWriter, r *http.Request, ss *sinksv1alpha1.StreamSink) {
+	logger := logging.FromContext(ctx).Desugar()
+
+	websocket := ws.IsWebSocketUpgrade(r)
+	if !websocket && !acceptsEventStream(r) {
+		w.WriteHeader(http.StatusNotAcceptable)
+		return
+	}
+
+	if !sameOrigin(r) {
+		logger.Info("Cross-origin request refused", zap.String("origin", r.Header.Get("Origin")))
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	token := auth.GetJWTFromHeader(r.Header)
+	if token == "" && websocket {
+		var err error
+		if token, err = tokenFromSubprotocols(r); err != nil {
+			logger.Info("Invalid token subprotocol", zap.Error(err))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+	}
+
+	var subjects []string
+	if ss.Spec.Clients != nil {
+		subjects = ss.Spec.Clients.Subjects
+	}
+
+	status, err := h.authVerifier.VerifySubscriber(ctx, feature.FromContext(ctx), audience(ss), ss.Namespace, subjects, token)
+	if err != nil {
+		logger.Warn("Failed to verify AuthN and AuthZ.", zap.Error(err))
+		w.WriteHeader(status)
+		return
+	}
+
+	filter, err := parseFilter(ctx, r.URL.Query())
+	if err != nil {
+		logger.Info("Invalid filter", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	bufferSize := sinksv1alpha1.DefaultStreamSinkBufferSize
+	if ss.Spec.BufferSize != nil {
+		bufferSize = *ss.Spec.BufferSize
+	}
+
+	if websocket {
+		conn, err := h.upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// Upgrade already replied to the client.
+			logger.Info("Failed to upgrade connection", zap.Error(err))
+			return
+		}
+		defer conn.Close()
+
+		client := h.hub.Subscribe(types.NamespacedName{Namespace: ss.Namespace, Name: ss.Name}, filter, int(bufferSize))
+		defer h.hub.Unsubscribe(client)
+
+		err = streamWebSocket(ctx, conn, client)
+		logger.Debug("WebSocket client disconnected", zap.Error(err))
+		return
+	}
+
+	client := h.hub.Subscribe(types.NamespacedName{Namespace: ss.Namespace, Name: ss.Name}, filter, int(bufferSize))
+	defer h.hub.Unsubscribe(client)
+
+	err = streamEvents(ctx, w, client)
+	logger.Debug("Server-Sent Events client disconnected", zap.Error(err))
+}
+
+// streamEvents sends the events received by client as Server-Sent Events
+// until ctx is done or the client is disconnected.
+func streamEvents(ctx context.Context, w http.ResponseWriter, client *Client) error {
+	rc := http.NewResponseController(w)
+	// The server read timeout would otherwise end the stream.
+	_ = rc.SetReadDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		return err
+	}
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return err
+			}
+		case event, ok := <-client.Events():
+			if !ok {
+				return fmt.Errorf("client didn't keep up with the events")
+			}
+			data, err := event.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", sseField(event.ID()), sseField(event.Type()), data); err != nil {
+				return err
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return err
+		}
+	}
+}
+
+// streamWebSocket sends the events received by client as text messages on
+// conn until ctx is done, the connection is closed or the client is
+// disconnected.
+func streamWebSocket(ctx context.Context, conn *ws.Conn, client *Client) error {
+	// Messages sent by the client are discarded, but reading them is needed to
+	// process the control messages and to notice when the connection is
+	// closed.
+	closed := make(chan error, 1)
+	go func() {
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				closed <- err
+				return
+			}
+		}
+	}()
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return conn.WriteControl(ws.CloseMessage, ws.FormatCloseMessage(ws.CloseGoingAway, ""), time.Now().Add(writeTimeout))
+		case err := <-closed:
+			return err
+		case <-keepAlive.C:
+			if err := conn.WriteControl(ws.PingMessage, nil, time.Now().Add(writeTimeout)); err != nil {
+				return err
+			}
+		case event, ok := <-client.Events():
+			if !ok {
+				_ = conn.WriteControl(ws.CloseMessage, ws.FormatCloseMessage(ws.ClosePolicyViolation, "too slow"), time.Now().Add(writeTimeout))
+				return fmt.Errorf("client didn't keep up with the events")
+			}
+			data, err := event.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			_ = conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if err := conn.WriteMessage(ws.TextMessage, data); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// parseFilter returns the filter built from the filter query parameters, or
+// nil when there are none.
+func parseFilter(ctx context.Context, query url.Values) (eventfilter.Filter, error) {
+	expressions := query[FilterQueryParameter]
+	if len(expressions) == 0 {
+		return nil, nil
+	}
+
+	filters := make([]eventfilter.Filter, 0, len(expressions))
+	for _, expr := range expressions {
+		// The CESQL parser can panic on invalid expressions, which the
+		// validation recovers from.
+		if err := eventingv1.ValidateCESQLExpression(ctx, expr); err != nil {
+			return nil, err.ViaField(FilterQueryParameter)
+		}
+		f, err := subscriptionsapi.NewCESQLFilter(expr)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+	return subscriptionsapi.NewAllFilter(filters...), nil
+}
+
+// acceptsEventStream returns whether the client sending r accepts Server-Sent
+// Events.
+func acceptsEventStream(r *http.Request) bool {
+	for _, accept := range r.Header.Values("Accept") {
+		for _, mediaType := range strings.Split(accept, ",") {
+			mediaType, _, _ = strings.Cut(mediaType, ";")
+			if strings.TrimSpace(mediaType) == "text/event-stream" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sameOrigin returns whether the request r, when sent by a browser, comes
+// from a page served by the same host as the StreamSinks. Browsers send the
+// Origin header with cross-origin requests, which would otherwise let any
+// page connect using the token of the user.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Host, r.Host)
+}
+
+// tokenFromSubprotocols returns the token of the WebSocket subprotocol
+// prefixed by TokenSubprotocolPrefix requested by the client sending r, or
+// an empty string when there is none.
+func tokenFromSubprotocols(r *http.Request) (string, error) {
+	for _, protocol := range ws.Subprotocols(r) {
+		if encoded, ok := strings.CutPrefix(protocol, TokenSubprotocolPrefix); ok {
+			token, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(encoded, "="))
+			if err != nil {
+				return "", fmt.Errorf("failed to decode token: %w", err)
+			}
+			return string(token), nil
+		}
+	}
+	return "", nil
+}
+
+// sseField strips the line breaks from a Server-Sent Events field value.
+func sseField(value string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(value)
+}
+
+func audience(ss *sinksv1alpha1.StreamSink) *string {
+	if ss.Status.Address == nil {
+		return nil
+	}
+	return ss.Status.Address.Audience
+}
@@ -0,0 +1,335 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamsink
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	ws "github.com/gorilla/websocket"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/apis/feature"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/auth"
+	sinkslister "knative.dev/eventing/pkg/client/listers/sinks/v1alpha1"
+)
+
+var testSink = types.NamespacedName{Namespace: "ns", Name: "sink"}
+
+const testToken = "token"
+
+// testVerifier accepts all the events, and the clients presenting testToken.
+type testVerifier struct{}
+
+func (testVerifier) VerifyRequest(context.Context, feature.Flags, *string, string, []eventingduckv1.AppliedEventPolicyRef, *http.Request, http.ResponseWriter) error {
+	return nil
+}
+
+func (testVerifier) VerifySubscriber(_ context.Context, _ feature.Flags, _ *string, _ string, _ []string, token string) (int, error) {
+	if token != testToken {
+		return http.StatusUnauthorized, fmt.Errorf("unexpected token %q", token)
+	}
+	return http.StatusOK, nil
+}
+
+func TestHandlerServerSentEvents(t *testing.T) {
+	h, server := newTestServer(t)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/ns/sink?"+url.Values{FilterQueryParameter: {"type = 'wanted'"}}.Encode(), nil)
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if got := resp.Header.Get("Content-Type"); got != "text/event-stream" {
+		t.Errorf("Content-Type = %q, want text/event-stream", got)
+	}
+
+	waitForClients(t, h, 1)
+	sendEvent(t, server.URL+"/ns/sink", "1", "unwanted")
+	sendEvent(t, server.URL+"/ns/sink", "2", "wanted")
+
+	reader := bufio.NewReader(resp.Body)
+	var lines []string
+	for len(lines) < 4 {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, strings.TrimSuffix(line, "\n"))
+	}
+
+	if lines[0] != "id: 2" || lines[1] != "event: wanted" || lines[3] != "" {
+		t.Errorf("unexpected event stream %q", lines)
+	}
+	event := cloudevents.NewEvent()
+	if err := event.UnmarshalJSON([]byte(strings.TrimPrefix(lines[2], "data: "))); err != nil {
+		t.Fatalf("invalid data %q: %v", lines[2], err)
+	}
+	if event.ID() != "2" {
+		t.Errorf("event ID = %q, want 2", event.ID())
+	}
+}
+
+func TestHandlerWebSocket(t *testing.T) {
+	h, server := newTestServer(t)
+
+	// Browsers can't set the Authorization header of WebSockets.
+	dialer := *ws.DefaultDialer
+	dialer.Subprotocols = []string{WebSocketSubprotocol, TokenSubprotocolPrefix + base64.RawURLEncoding.EncodeToString([]byte(testToken))}
+	header := http.Header{"Origin": {server.URL}}
+	conn, resp, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/ns/sink", header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if got := resp.Header.Get("Sec-WebSocket-Protocol"); got != WebSocketSubprotocol {
+		t.Errorf("subprotocol = %q, want %q", got, WebSocketSubprotocol)
+	}
+
+	waitForClients(t, h, 1)
+	sendEvent(t, server.URL+"/ns/sink", "1", "type")
+
+	_ = conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	messageType, data, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if messageType != ws.TextMessage {
+		t.Errorf("message type = %d, want %d", messageType, ws.TextMessage)
+	}
+	event := cloudevents.NewEvent()
+	if err := event.UnmarshalJSON(data); err != nil {
+		t.Fatalf("invalid message %q: %v", data, err)
+	}
+	if event.ID() != "1" {
+		t.Errorf("event ID = %q, want 1", event.ID())
+	}
+
+	conn.Close()
+	waitForClients(t, h, 0)
+}
+
+func TestHandlerErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		accept string
+		header http.Header
+		want   int
+	}{{
+		name:   "malformed path",
+		method: http.MethodGet,
+		path:   "/ns",
+		accept: "text/event-stream",
+		want:   http.StatusNotFound,
+	}, {
+		name:   "unknown sink",
+		method: http.MethodGet,
+		path:   "/ns/unknown",
+		accept: "text/event-stream",
+		want:   http.StatusNotFound,
+	}, {
+		name:   "not a stream",
+		method: http.MethodGet,
+		path:   "/ns/sink",
+		accept: "application/json",
+		want:   http.StatusNotAcceptable,
+	}, {
+		name:   "invalid filter",
+		method: http.MethodGet,
+		path:   "/ns/sink?" + url.Values{FilterQueryParameter: {"type = "}}.Encode(),
+		accept: "text/event-stream",
+		want:   http.StatusBadRequest,
+	}, {
+		name:   "no token",
+		method: http.MethodGet,
+		path:   "/ns/sink",
+		accept: "text/event-stream",
+		header: http.Header{"Authorization": nil},
+		want:   http.StatusUnauthorized,
+	}, {
+		name:   "token in query",
+		method: http.MethodGet,
+		path:   "/ns/sink?access_token=" + testToken,
+		accept: "text/event-stream",
+		header: http.Header{"Authorization": nil},
+		want:   http.StatusUnauthorized,
+	}, {
+		name:   "cross-origin",
+		method: http.MethodGet,
+		path:   "/ns/sink",
+		accept: "text/event-stream",
+		header: http.Header{"Origin": {"https://attacker.example.com"}},
+		want:   http.StatusForbidden,
+	}, {
+		name:   "not an event",
+		method: http.MethodPost,
+		path:   "/ns/sink",
+		want:   http.StatusBadRequest,
+	}, {
+		name:   "unsupported method",
+		method: http.MethodPut,
+		path:   "/ns/sink",
+		want:   http.StatusMethodNotAllowed,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHandler(t)
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			req.Header.Set("Authorization", "Bearer "+testToken)
+			for k, v := range tt.header {
+				req.Header[k] = v
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandlerRefusesClientsWithoutOIDC(t *testing.T) {
+	h := newTestHandler(t)
+	// OIDC authentication is disabled by default.
+	h.authVerifier = &auth.Verifier{}
+
+	req := httptest.NewRequest(http.MethodGet, "/ns/sink", nil)
+	req.Header.Set("Accept", "text/event-stream")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+}
+
+func TestSameOrigin(t *testing.T) {
+	tests := map[string]bool{
+		"":                             true,
+		"https://streamsink.example":   true,
+		"http://StreamSink.example":    true,
+		"https://attacker.example":     false,
+		"https://streamsink.example:8": false,
+		"::":                           false,
+	}
+	for origin, want := range tests {
+		r := httptest.NewRequest(http.MethodGet, "http://streamsink.example/ns/sink", nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		if got := sameOrigin(r); got != want {
+			t.Errorf("sameOrigin(%q) = %v, want %v", origin, got, want)
+		}
+	}
+}
+
+func TestAcceptsEventStream(t *testing.T) {
+	tests := map[string]bool{
+		"":                                 false,
+		"application/json":                 false,
+		"text/event-stream":                true,
+		"text/html, text/event-stream;q=1": true,
+	}
+	for accept, want := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/ns/sink", nil)
+		r.Header.Set("Accept", accept)
+		if got := acceptsEventStream(r); got != want {
+			t.Errorf("acceptsEventStream(%q) = %v, want %v", accept, got, want)
+		}
+	}
+}
+
+func newTestHandler(t *testing.T) *Handler {
+	t.Helper()
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	err := indexer.Add(&sinksv1alpha1.StreamSink{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testSink.Namespace,
+			Name:      testSink.Name,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := NewHandler(sinkslister.NewStreamSinkLister(indexer), nil, func(ctx context.Context) context.Context { return ctx })
+	h.authVerifier = testVerifier{}
+	return h
+}
+
+func newTestServer(t *testing.T) (*Handler, *httptest.Server) {
+	t.Helper()
+
+	h := newTestHandler(t)
+	server := httptest.NewServer(h)
+	t.Cleanup(server.Close)
+	return h, server
+}
+
+func waitForClients(t *testing.T, h *Handler, want int) {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for h.hub.Clients(testSink) != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %d clients, got %d", want, h.hub.Clients(testSink))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func sendEvent(t *testing.T, target, id, eventType string) {
+	t.Helper()
+
+	client, err := cloudevents.NewClientHTTP()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := cloudevents.ContextWithTarget(context.Background(), target)
+	if result := client.Send(ctx, *newEvent(id, eventType)); !cloudevents.IsACK(result) {
+		t.Fatalf("failed to send event: %v", result)
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamsink
+
+import (
+	"context"
+	"sync"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"k8s.io/apimachinery/pkg/types"
+
+	"knative.dev/eventing/pkg/eventfilter"
+)
+
+// Hub fans the events received by the StreamSinks out to their connected
+// clients. It is in memory: events are only delivered to the clients
+// connected when they are published, and are never replayed.
+type Hub struct {
+	mu      sync.Mutex
+	clients map[types.NamespacedName]map[*Client]struct{}
+}
+
+// Client is a client connected to a StreamSink.
+type Client struct {
+	sink   types.NamespacedName
+	filter eventfilter.Filter
+	events chan *cloudevents.Event
+}
+
+// NewHub creates a Hub without clients.
+func NewHub() *Hub {
+	return &Hub{
+		clients: make(map[types.NamespacedName]map[*Client]struct{}),
+	}
+}
+
+// Subscribe connects a client to sink. The client receives the events
+// passing filter, buffering up to bufferSize of them.
+func (h *Hub) Subscribe(sink types.NamespacedName, filter eventfilter.Filter, bufferSize int) *Client {
+	c := &Client{
+		sink:   sink,
+		filter: filter,
+		events: make(chan *cloudevents.Event, bufferSize),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.clients[sink] == nil {
+		h.clients[sink] = make(map[*Client]struct{})
+	}
+	h.clients[sink][c] = struct{}{}
+	return c
+}
+
+// Unsubscribe disconnects the client c, closing its events channel if it
+// wasn't already.
+func (h *Hub) Unsubscribe(c *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.remove(c)
+}
+
+// Publish sends event to the clients of sink whose filter it passes and
+// returns how many of them it was sent to. Clients whose buffer is full are
+// disconnected rather than slowing down the others.
+func (h *Hub) Publish(ctx context.Context, sink types.NamespacedName, event *cloudevents.Event) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sent := 0
+	for c := range h.clients[sink] {
+		if c.filter != nil && c.filter.Filter(ctx, *event) == eventfilter.FailFilter {
+			continue
+		}
+		select {
+		case c.events <- event:
+			sent++
+		default:
+			h.remove(c)
+		}
+	}
+	return sent
+}
+
+// Clients returns the number of clients connected to sink.
+func (h *Hub) Clients(sink types.NamespacedName) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return len(h.clients[sink])
+}
+
+// remove removes c from the clients and closes its events channel. It must
+// be called with h.mu held.
+func (h *Hub) remove(c *Client) {
+	clients, ok := h.clients[c.sink]
+	if !ok {
+		return
+	}
+	if _, ok := clients[c]; !ok {
+		return
+	}
+
+	delete(clients, c)
+	if len(clients) == 0 {
+		delete(h.clients, c.sink)
+	}
+	close(c.events)
+}
+
+// Events returns the channel of the events received by the client. It is
+// closed when the client is disconnected, either by Unsubscribe or because
+// it didn't keep up with the events.
+func (c *Client) Events() <-chan *cloudevents.Event {
+	return c.events
+}
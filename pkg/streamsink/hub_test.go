@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamsink
+
+import (
+	"context"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"k8s.io/apimachinery/pkg/types"
+
+	"knative.dev/eventing/pkg/eventfilter/subscriptionsapi"
+)
+
+func TestHubPublish(t *testing.T) {
+	ctx := context.Background()
+	sink := types.NamespacedName{Namespace: "ns", Name: "sink"}
+	other := types.NamespacedName{Namespace: "ns", Name: "other"}
+
+	hub := NewHub()
+
+	all := hub.Subscribe(sink, nil, 10)
+	filter, err := subscriptionsapi.NewCESQLFilter("type = 'wanted'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	filtered := hub.Subscribe(sink, filter, 10)
+	otherSink := hub.Subscribe(other, nil, 10)
+
+	if got := hub.Publish(ctx, sink, newEvent("1", "wanted")); got != 2 {
+		t.Errorf("Publish() = %d, want 2", got)
+	}
+	if got := hub.Publish(ctx, sink, newEvent("2", "unwanted")); got != 1 {
+		t.Errorf("Publish() = %d, want 1", got)
+	}
+
+	for _, want := range []string{"1", "2"} {
+		if got := (<-all.Events()).ID(); got != want {
+			t.Errorf("unfiltered client received event %q, want %q", got, want)
+		}
+	}
+	if got := (<-filtered.Events()).ID(); got != "1" {
+		t.Errorf("filtered client received event %q, want %q", got, "1")
+	}
+	if got := len(filtered.Events()); got != 0 {
+		t.Errorf("filtered client received %d unexpected events", got)
+	}
+	if got := len(otherSink.Events()); got != 0 {
+		t.Errorf("client of another sink received %d events", got)
+	}
+}
+
+func TestHubDisconnectsSlowClients(t *testing.T) {
+	ctx := context.Background()
+	sink := types.NamespacedName{Namespace: "ns", Name: "sink"}
+
+	hub := NewHub()
+	slow := hub.Subscribe(sink, nil, 1)
+	fast := hub.Subscribe(sink, nil, 2)
+
+	hub.Publish(ctx, sink, newEvent("1", "type"))
+	if got := hub.Publish(ctx, sink, newEvent("2", "type")); got != 1 {
+		t.Errorf("Publish() = %d, want 1", got)
+	}
+	if got := hub.Clients(sink); got != 1 {
+		t.Errorf("Clients() = %d, want 1", got)
+	}
+
+	// The buffered event is still delivered before the channel is closed.
+	if _, ok := <-slow.Events(); !ok {
+		t.Error("slow client events closed before the buffered event")
+	}
+	if _, ok := <-slow.Events(); ok {
+		t.Error("slow client events not closed")
+	}
+	if got := len(fast.Events()); got != 2 {
+		t.Errorf("fast client received %d events, want 2", got)
+	}
+}
+
+func TestHubUnsubscribe(t *testing.T) {
+	sink := types.NamespacedName{Namespace: "ns", Name: "sink"}
+
+	hub := NewHub()
+	c := hub.Subscribe(sink, nil, 1)
+	hub.Unsubscribe(c)
+	// Unsubscribing twice is a noop.
+	hub.Unsubscribe(c)
+
+	if _, ok := <-c.Events(); ok {
+		t.Error("events not closed")
+	}
+	if got := hub.Clients(sink); got != 0 {
+		t.Errorf("Clients() = %d, want 0", got)
+	}
+	if got := hub.Publish(context.Background(), sink, newEvent("1", "type")); got != 0 {
+		t.Errorf("Publish() = %d, want 0", got)
+	}
+}
+
+func newEvent(id, eventType string) *cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID(id)
+	event.SetType(eventType)
+	event.SetSource("test")
+	return &event
+}
@@ -118,9 +118,11 @@ func CopyRequest(req *http.Request) (*http.Request, error) {
 
 	// return a new request with a readable body and same headers as the original
 	// we don't need to set any other fields as cloudevents only uses the headers
-	// and body to construct the Message/Event.
+	// and body to construct the Message/Event. The TLS state is kept, as the
+	// client certificate can be the identity of the sender.
 	return &http.Request{
 		Header: req.Header,
 		Body:   io.NopCloser(bytes.NewReader(buf.Bytes())),
+		TLS:    req.TLS,
 	}, nil
 }